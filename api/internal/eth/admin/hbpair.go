@@ -2,7 +2,6 @@ package admin
 
 import (
 	"sfilter/api/utils"
-	"sfilter/config"
 
 	wiser "sfilter/handler/wiser"
 
@@ -10,14 +9,12 @@ import (
 )
 
 func GetHotBigPairs(c *gin.Context) {
-	set := wiser.NewSetting("", config.DatabaseName, false)
-	hndl := &wiser.Handler{
-		Hbpair: &wiser.HBPair{
-			Set: set,
-		},
+	set := wiser.NewSetting(utils.GetChainService(c.Param("chain")), "", false)
+	hbpair := &wiser.HBPair{
+		Set: set,
 	}
 
-	h1, h4, h24 := hndl.Hbpair.HotPairSearch()
+	h1, h4, h24 := hbpair.HotPairSearch()
 
 	data := struct {
 		H1  []string `json:"h1"`
@@ -0,0 +1,158 @@
+package admin
+
+import (
+	"errors"
+	"sfilter/api/utils"
+	"sfilter/config"
+	"sfilter/schema"
+	"sfilter/services/wiser"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// 资金曲线默认查询最近7天
+const paperEquityDefaultRange = 7 * 24 * time.Hour
+
+// 所有策略的模拟盘账户
+func AdminGetPaperAccounts(c *gin.Context) {
+	db := utils.GetChainDatabase(c.Param("chain"))
+
+	filter := bson.M{}
+	if strategy := c.DefaultQuery("strategy", ""); strategy != "" {
+		filter["strategy"] = strategy
+	}
+
+	info, err := wiser.GetPaperAccounts(&filter, db)
+	if err != nil {
+		utils.ResFailure(c, 500, err.Error())
+		return
+	}
+
+	data := struct {
+		List []schema.PaperAccount `json:"list"`
+	}{
+		List: info,
+	}
+
+	resEncrypted(c, data)
+}
+
+// 未平仓的持仓, 按买入时间倒序
+func AdminGetPaperPositions(c *gin.Context) {
+	getPaperPositions(c, schema.PAPER_POSITION_OPEN, "entryTime")
+}
+
+// 已平仓的交易, 按卖出时间倒序
+func AdminGetPaperTrades(c *gin.Context) {
+	getPaperPositions(c, schema.PAPER_POSITION_CLOSED, "exitTime")
+}
+
+func getPaperPositions(c *gin.Context, status int, sortBy string) {
+	db := utils.GetChainDatabase(c.Param("chain"))
+
+	page, limit, err := utils.ParsePageLimitParams(c)
+	if err != nil {
+		utils.ResFailure(c, 400, err.Error())
+		return
+	}
+
+	skip := int64(page*limit - limit)
+	options := &options.FindOptions{Limit: &limit, Skip: &skip}
+	options = options.SetSort(bson.D{{Key: sortBy, Value: -1}})
+
+	filter := bson.M{}
+	filter["status"] = status
+	if strategy := c.DefaultQuery("strategy", ""); strategy != "" {
+		filter["strategy"] = strategy
+	}
+
+	info, count, err := wiser.GetPaperPositions(options, &filter, db)
+	if err != nil {
+		utils.ResFailure(c, 500, err.Error())
+		return
+	}
+
+	data := struct {
+		List  []schema.PaperPosition `json:"list"`
+		Count int64                  `json:"count"`
+	}{
+		List:  info,
+		Count: count,
+	}
+
+	resEncrypted(c, data)
+}
+
+// 某个策略的资金曲线, from/to 为秒级时间戳, 默认最近7天
+func AdminGetPaperEquity(c *gin.Context) {
+	db := utils.GetChainDatabase(c.Param("chain"))
+
+	strategy := c.DefaultQuery("strategy", "")
+	if strategy == "" {
+		utils.ResFailure(c, 400, "strategy is required")
+		return
+	}
+
+	to, err := parseUnixParam(c, "to", time.Now())
+	if err != nil {
+		utils.ResFailure(c, 400, err.Error())
+		return
+	}
+
+	from, err := parseUnixParam(c, "from", to.Add(-paperEquityDefaultRange))
+	if err != nil {
+		utils.ResFailure(c, 400, err.Error())
+		return
+	}
+
+	filter := bson.M{}
+	filter["strategy"] = strategy
+	filter["time"] = bson.M{
+		"$gte": from,
+		"$lte": to,
+	}
+
+	options := options.Find().SetSort(bson.D{{Key: "time", Value: 1}})
+
+	info, err := wiser.GetPaperEquities(options, &filter, db)
+	if err != nil {
+		utils.ResFailure(c, 500, err.Error())
+		return
+	}
+
+	data := struct {
+		List []schema.PaperEquity `json:"list"`
+	}{
+		List: info,
+	}
+
+	resEncrypted(c, data)
+}
+
+func parseUnixParam(c *gin.Context, key string, def time.Time) (time.Time, error) {
+	value := c.DefaultQuery(key, "")
+	if value == "" {
+		return def, nil
+	}
+
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return def, errors.New("invalid " + key + " parameter")
+	}
+
+	return time.Unix(seconds, 0), nil
+}
+
+func resEncrypted(c *gin.Context, data interface{}) {
+	enc, err := utils.AesEncrypt(data, config.API_AES_DATA_KEY)
+	if err != nil {
+		utils.ResFailure(c, 500, err.Error())
+		return
+	}
+
+	utils.ResSuccess(c, enc)
+}
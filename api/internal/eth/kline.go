@@ -0,0 +1,126 @@
+package eth
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"sfilter/api/utils"
+	"sfilter/config"
+	"sfilter/schema"
+	"sfilter/services/kline"
+
+	"github.com/gin-gonic/gin"
+)
+
+// 标准 OHLCV 柱子, time 为周期开始时间(秒)
+type KlineBar struct {
+	Time   int64   `json:"time"`
+	Open   float64 `json:"open"`
+	High   float64 `json:"high"`
+	Low    float64 `json:"low"`
+	Close  float64 `json:"close"`
+	Volume float64 `json:"volume"`
+
+	VolumeInUsd float64 `json:"volumeInUsd"`
+	PriceInUsd  float64 `json:"priceInUsd"`
+	TxNum       int     `json:"txNum"`
+}
+
+// /klines?pair=&resolution=&from=&to=
+// resolution 支持 1m/5m/15m/1h/4h/1d 及 1/5/15/60/240/1D; from、to 为秒级时间戳
+// 只返回有交易的柱子, 最多返回 KlineMaxBars 根
+func GetKlines(c *gin.Context) {
+	db := utils.GetChainDatabase(c.Param("chain"))
+
+	pair := c.DefaultQuery("pair", "")
+	if !utils.IsValidEthereumAddress(pair) {
+		utils.ResFailure(c, 400, "invalid pair")
+		return
+	}
+
+	timeframe, ok := kline.ParseResolution(c.DefaultQuery("resolution", kline.TIMEFRAME_1MIN))
+	if !ok {
+		utils.ResFailure(c, 400, "invalid resolution")
+		return
+	}
+
+	from, to, err := parseKlineRange(c, timeframe)
+	if err != nil {
+		utils.ResFailure(c, 400, err.Error())
+		return
+	}
+
+	klines, err := kline.GetKlines(pair, timeframe, from, to, db)
+	if err != nil {
+		utils.ResFailure(c, 500, err.Error())
+		return
+	}
+
+	data := struct {
+		Pair       string      `json:"pair"`
+		Resolution string      `json:"resolution"`
+		Bars       []*KlineBar `json:"bars"`
+	}{
+		Pair:       pair,
+		Resolution: timeframe,
+		Bars:       toKlineBars(klines),
+	}
+
+	utils.ResSuccess(c, data)
+}
+
+func toKlineBars(klines []schema.KLine) []*KlineBar {
+	bars := make([]*KlineBar, 0, len(klines))
+	for _, k := range klines {
+		bars = append(bars, &KlineBar{
+			Time:   k.UnixTime,
+			Open:   k.OpenPrice,
+			High:   k.HighPrice,
+			Low:    k.LowPrice,
+			Close:  k.ClosePrice,
+			Volume: k.Volume,
+
+			VolumeInUsd: k.VolumeInUsd,
+			PriceInUsd:  k.PriceInUsd,
+			TxNum:       k.TxNum,
+		})
+	}
+
+	return bars
+}
+
+// to 默认为当前时间, from 默认往前 KlineMaxBars 根柱子; 区间过大时从 to 往前截断
+func parseKlineRange(c *gin.Context, timeframe string) (time.Time, time.Time, error) {
+	interval := kline.TimeframeInterval(timeframe)
+
+	to := time.Now()
+	if s := c.Query("to"); s != "" {
+		sec, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		to = time.Unix(sec, 0)
+	}
+
+	earliest := to.Add(-interval * config.KlineMaxBars)
+
+	from := earliest
+	if s := c.Query("from"); s != "" {
+		sec, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		from = time.Unix(sec, 0)
+	}
+
+	if !from.Before(to) {
+		return time.Time{}, time.Time{}, errors.New("from must be less than to")
+	}
+
+	if from.Before(earliest) {
+		from = earliest
+	}
+
+	return from, to, nil
+}
@@ -0,0 +1,163 @@
+package stream
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"sfilter/api/utils"
+	"sfilter/schema"
+
+	"github.com/gin-gonic/gin"
+)
+
+// 推送的消息类型
+const (
+	MSG_TYPE_SWAP     = "swap"
+	MSG_TYPE_TRANSFER = "transfer"
+	MSG_TYPE_PAIR     = "pair"
+
+	// 链重组, 之前推送的这些区块的数据作废, 不需要订阅, 总是推送
+	MSG_TYPE_REORG = "reorg"
+)
+
+type ReorgData struct {
+	Blocks []int64 `json:"blocks"` // 回滚的区块号, 从高到低
+}
+
+type Message struct {
+	Type    string      `json:"type"`
+	BlockNo int64       `json:"blockNo"`
+	Data    interface{} `json:"data"`
+}
+
+// 订阅条件, 为空的条件不做过滤
+// 地址统一转成小写比较
+type Filter struct {
+	Types map[string]bool
+
+	Pairs      map[string]bool // swap 的 pairAddr, pair 的 address
+	MainTokens map[string]bool // swap 的 mainToken, transfer 的 token, pair 的 token0/token1
+	Traders    map[string]bool // swap 的 trader, transfer 的 from/to
+
+	MinVolumeInUsd float64 // swap 的 volumeInUsd, transfer 的 transferValueInUsd
+	Direction      int     // 只对 swap 生效, 0 为不限
+}
+
+func parseFilter(c *gin.Context) (*Filter, error) {
+	filter := &Filter{
+		Types: make(map[string]bool),
+	}
+
+	for _, t := range splitParam(c.DefaultQuery("types", MSG_TYPE_SWAP)) {
+		if t != MSG_TYPE_SWAP && t != MSG_TYPE_TRANSFER && t != MSG_TYPE_PAIR {
+			return nil, errors.New("invalid types parameter")
+		}
+		filter.Types[t] = true
+	}
+	if len(filter.Types) == 0 {
+		return nil, errors.New("invalid types parameter")
+	}
+
+	var err error
+	if filter.Pairs, err = parseAddresses(c, "pairAddr"); err != nil {
+		return nil, err
+	}
+	if filter.MainTokens, err = parseAddresses(c, "mainToken"); err != nil {
+		return nil, err
+	}
+	if filter.Traders, err = parseAddresses(c, "trader"); err != nil {
+		return nil, err
+	}
+
+	minVolume := c.DefaultQuery("minVolumeInUsd", "")
+	if minVolume != "" {
+		filter.MinVolumeInUsd, err = strconv.ParseFloat(minVolume, 64)
+		if err != nil || filter.MinVolumeInUsd < 0 {
+			return nil, errors.New("invalid minVolumeInUsd parameter")
+		}
+	}
+
+	direction := c.DefaultQuery("direction", "0")
+	filter.Direction, err = strconv.Atoi(direction)
+	if err != nil || filter.Direction < 0 || filter.Direction > 2 {
+		return nil, errors.New("invalid direction parameter")
+	}
+
+	return filter, nil
+}
+
+// 多个值以逗号分隔
+func splitParam(param string) []string {
+	var list []string
+	for _, v := range strings.Split(param, ",") {
+		v = strings.TrimSpace(v)
+		if v != "" {
+			list = append(list, v)
+		}
+	}
+
+	return list
+}
+
+func parseAddresses(c *gin.Context, key string) (map[string]bool, error) {
+	list := splitParam(c.DefaultQuery(key, ""))
+	if len(list) == 0 {
+		return nil, nil
+	}
+
+	addrs := make(map[string]bool)
+	for _, addr := range list {
+		if !utils.IsValidEthereumAddress(addr) {
+			return nil, errors.New("invalid " + key + " parameter")
+		}
+		addrs[strings.ToLower(addr)] = true
+	}
+
+	return addrs, nil
+}
+
+func hasAddress(addrs map[string]bool, list ...string) bool {
+	if addrs == nil {
+		return true
+	}
+
+	for _, addr := range list {
+		if addrs[strings.ToLower(addr)] {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (f *Filter) Match(msg *Message) bool {
+	if msg.Type == MSG_TYPE_REORG {
+		return true
+	}
+
+	if !f.Types[msg.Type] {
+		return false
+	}
+
+	switch data := msg.Data.(type) {
+	case *schema.Swap:
+		return hasAddress(f.Pairs, data.PairAddr) &&
+			hasAddress(f.MainTokens, data.MainToken) &&
+			hasAddress(f.Traders, data.Trader) &&
+			data.VolumeInUsd >= f.MinVolumeInUsd &&
+			(f.Direction == 0 || data.Direction == f.Direction)
+
+	case *schema.Transfer:
+		return f.Pairs == nil &&
+			hasAddress(f.MainTokens, data.Token) &&
+			hasAddress(f.Traders, data.From, data.To) &&
+			data.TransferValueInUsd >= f.MinVolumeInUsd
+
+	case *schema.Pair:
+		return hasAddress(f.Pairs, data.Address) &&
+			hasAddress(f.MainTokens, data.Token0, data.Token1)
+	}
+
+	return false
+}
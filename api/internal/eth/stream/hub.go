@@ -0,0 +1,225 @@
+package stream
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"sfilter/api/utils"
+	"sfilter/config"
+	"sfilter/schema"
+	"sfilter/services/eventbus"
+	gutils "sfilter/utils"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// api 与 sfilter 是不同进程, 通过事件总线获取新处理完成的区块
+// BlockProcessed 在最后才发布, 此时该区块的 swap/transfer/pair 都已写入
+type hub struct {
+	chain string
+	db    *mongo.Database
+
+	mu   sync.Mutex
+	subs map[*subscriber]bool
+}
+
+type subscriber struct {
+	filter *Filter
+	send   chan *Message
+	closed bool
+}
+
+var hubs = make(map[string]*hub)
+var hubsLock sync.Mutex
+
+// 每条链一个 hub, 第一次订阅时创建
+func getHub(chain string) (*hub, error) {
+	hubsLock.Lock()
+	defer hubsLock.Unlock()
+
+	if h, ok := hubs[chain]; ok {
+		return h, nil
+	}
+
+	bus, err := utils.GetEventBus(chain)
+	if err != nil {
+		return nil, err
+	}
+
+	h := &hub{
+		chain: chain,
+		db:    utils.GetChainDatabase(chain),
+		subs:  make(map[*subscriber]bool),
+	}
+	bus.Subscribe(eventbus.TopicBlockProcessed, h.onBlockProcessed)
+	bus.Subscribe(eventbus.TopicBlockReorged, h.onBlockReorged)
+
+	hubs[chain] = h
+
+	return h, nil
+}
+
+func (h *hub) subscribe(filter *Filter) *subscriber {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	sub := &subscriber{
+		filter: filter,
+		send:   make(chan *Message, config.StreamSendBufferSize),
+	}
+	h.subs[sub] = true
+
+	return sub
+}
+
+func (h *hub) unsubscribe(sub *subscriber) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.removeLocked(sub)
+}
+
+func (h *hub) removeLocked(sub *subscriber) {
+	delete(h.subs, sub)
+
+	if !sub.closed {
+		sub.closed = true
+		close(sub.send)
+	}
+}
+
+// 所有订阅者需要的消息类型
+func (h *hub) wantedTypes() map[string]bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	types := make(map[string]bool)
+	for sub := range h.subs {
+		for t := range sub.filter.Types {
+			types[t] = true
+		}
+	}
+
+	return types
+}
+
+func (h *hub) onBlockProcessed(ev *eventbus.Event) {
+	types := h.wantedTypes()
+	if len(types) == 0 {
+		return
+	}
+
+	msgs, err := h.loadBlockMessages(ev.BlockNo, types)
+	if err != nil {
+		gutils.Warnf("[ stream.onBlockProcessed ] chain: %v, load block %v failed: %v", h.chain, ev.BlockNo, err)
+		return
+	}
+
+	h.broadcast(msgs)
+}
+
+// 通知所有订阅者丢弃孤块的数据, 主链上的区块之后会重新推送
+func (h *hub) onBlockReorged(ev *eventbus.Event) {
+	var data eventbus.BlockReorged
+	if err := ev.Decode(&data); err != nil {
+		gutils.Warnf("[ stream.onBlockReorged ] chain: %v, decode event failed: %v, block: %v", h.chain, err, ev.BlockNo)
+		return
+	}
+
+	h.broadcast([]*Message{{Type: MSG_TYPE_REORG, BlockNo: ev.BlockNo, Data: &ReorgData{Blocks: data.Blocks}}})
+}
+
+func (h *hub) loadBlockMessages(blockNo int64, types map[string]bool) ([]*Message, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), config.MONGO_FIND_TIMEOUT*time.Second)
+	defer cancel()
+
+	var msgs []*Message
+
+	var swaps []*schema.Swap
+	if types[MSG_TYPE_SWAP] || types[MSG_TYPE_PAIR] {
+		opts := options.Find().SetSort(bson.D{{Key: "position", Value: 1}})
+		if err := h.find(ctx, config.SwapTableName, bson.M{"blockNo": blockNo}, opts, &swaps); err != nil {
+			return nil, err
+		}
+	}
+
+	if types[MSG_TYPE_SWAP] {
+		for _, swap := range swaps {
+			msgs = append(msgs, &Message{Type: MSG_TYPE_SWAP, BlockNo: blockNo, Data: swap})
+		}
+	}
+
+	if types[MSG_TYPE_TRANSFER] {
+		var transfers []*schema.Transfer
+		opts := options.Find().SetSort(bson.D{{Key: "position", Value: 1}})
+		if err := h.find(ctx, config.TransferTableName, bson.M{"blockNo": blockNo}, opts, &transfers); err != nil {
+			return nil, err
+		}
+
+		for _, transfer := range transfers {
+			msgs = append(msgs, &Message{Type: MSG_TYPE_TRANSFER, BlockNo: blockNo, Data: transfer})
+		}
+	}
+
+	// 本区块新建的 pair, 以及有交易发生、交易数据有更新的 pair
+	if types[MSG_TYPE_PAIR] {
+		pairAddrs := make([]string, 0, len(swaps))
+		for _, swap := range swaps {
+			pairAddrs = append(pairAddrs, swap.PairAddr)
+		}
+
+		filter := bson.M{"$or": []bson.M{
+			{"pairCreatedBlockNo": blockNo},
+			{"address": bson.M{"$in": pairAddrs}},
+		}}
+
+		var pairs []*schema.Pair
+		if err := h.find(ctx, config.PairTableName, filter, options.Find(), &pairs); err != nil {
+			return nil, err
+		}
+
+		for _, pair := range pairs {
+			msgs = append(msgs, &Message{Type: MSG_TYPE_PAIR, BlockNo: blockNo, Data: pair})
+		}
+	}
+
+	return msgs, nil
+}
+
+func (h *hub) find(ctx context.Context, table string, filter bson.M, opts *options.FindOptions, results interface{}) error {
+	cursor, err := h.db.Collection(table).Find(ctx, filter, opts)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	return cursor.All(ctx, results)
+}
+
+// 发送缓冲满了说明客户端消费太慢, 直接断开, 避免拖慢其他订阅者
+func (h *hub) broadcast(msgs []*Message) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for sub := range h.subs {
+		for _, msg := range msgs {
+			if !sub.filter.Match(msg) {
+				continue
+			}
+
+			select {
+			case sub.send <- msg:
+			default:
+				gutils.Warnf("[ stream.broadcast ] chain: %v, subscriber too slow, disconnect it", h.chain)
+				h.removeLocked(sub)
+			}
+
+			if sub.closed {
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package stream
+
+import (
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"sfilter/api/utils"
+	"sfilter/config"
+	"sfilter/user/models"
+	gutils "sfilter/utils"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+var upgrader = websocket.Upgrader{
+	// 与 Cors 保持一致, 允许所有来源
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+// 每个用户当前的连接数
+var connCount = make(map[string]int)
+var connLock sync.Mutex
+
+func acquireConn(username, role string) bool {
+	connLock.Lock()
+	defer connLock.Unlock()
+
+	if connCount[username] >= models.GetRoleStreamCount(role) {
+		return false
+	}
+
+	connCount[username]++
+	return true
+}
+
+func releaseConn(username string) {
+	connLock.Lock()
+	defer connLock.Unlock()
+
+	connCount[username]--
+	if connCount[username] <= 0 {
+		delete(connCount, username)
+	}
+}
+
+// 实时推送 swap/transfer/pair, 带 websocket upgrade 头时使用 websocket, 否则使用 SSE
+func Subscribe(c *gin.Context) {
+	username := c.GetString("user")
+	if username == "" {
+		utils.ResFailure(c, 401, "Wrong ApiKey.")
+		return
+	}
+
+	filter, err := parseFilter(c)
+	if err != nil {
+		utils.ResFailure(c, 400, err.Error())
+		return
+	}
+
+	if !acquireConn(username, c.GetString("role")) {
+		utils.ResFailure(c, 429, "Too many stream connections.")
+		return
+	}
+	defer releaseConn(username)
+
+	h, err := getHub(c.Param("chain"))
+	if err != nil {
+		utils.ResFailure(c, 500, err.Error())
+		return
+	}
+
+	sub := h.subscribe(filter)
+	defer h.unsubscribe(sub)
+
+	if websocket.IsWebSocketUpgrade(c.Request) {
+		serveWs(c, sub)
+	} else {
+		serveSse(c, sub)
+	}
+}
+
+func serveWs(c *gin.Context, sub *subscriber) {
+	conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		gutils.Warnf("[ serveWs ] upgrade failed: %v", err)
+		return
+	}
+	defer conn.Close()
+
+	// 客户端不需要发送数据, 读取只是为了处理 pong 和感知断开
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		conn.SetReadDeadline(time.Now().Add(config.StreamPingInterval * 2))
+		conn.SetPongHandler(func(string) error {
+			return conn.SetReadDeadline(time.Now().Add(config.StreamPingInterval * 2))
+		})
+
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(config.StreamPingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case msg, ok := <-sub.send:
+			if !ok {
+				conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "too slow"))
+				return
+			}
+
+			conn.SetWriteDeadline(time.Now().Add(config.StreamPingInterval))
+			if err := conn.WriteJSON(msg); err != nil {
+				return
+			}
+
+		case <-ticker.C:
+			conn.SetWriteDeadline(time.Now().Add(config.StreamPingInterval))
+			if err := conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+
+		case <-done:
+			return
+		}
+	}
+}
+
+func serveSse(c *gin.Context, sub *subscriber) {
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no") // 禁止 nginx 缓冲
+
+	ticker := time.NewTicker(config.StreamPingInterval)
+	defer ticker.Stop()
+
+	ctx := c.Request.Context()
+
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case msg, ok := <-sub.send:
+			if !ok {
+				return false
+			}
+			c.SSEvent(msg.Type, msg)
+			return true
+
+		case <-ticker.C:
+			c.SSEvent("ping", time.Now().Unix())
+			return true
+
+		case <-ctx.Done():
+			return false
+		}
+	})
+}
@@ -63,7 +63,7 @@ func GetPriceAndTxTrends(c *gin.Context) {
 		t48 = append(t48, elemT)
 	}
 
-	price, err := pair.GetPairInfoForRead(address)
+	price, err := pair.GetPairInfoForApi(address, db)
 	if err != nil {
 		utils.ResFailure(c, 500, "Wrong pair, can not get the price.")
 		return
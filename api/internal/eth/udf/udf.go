@@ -0,0 +1,229 @@
+package udf
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"sfilter/api/utils"
+	"sfilter/config"
+	"sfilter/schema"
+	"sfilter/services/kline"
+	"sfilter/services/pair"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TradingView UDF 数据源, 前端图表直接对接
+// 返回格式由 UDF 协议规定, 不使用 utils.ResSuccess 的包装; symbol 为 pair 地址
+
+const UDF_SEARCH_LIMIT_UPPER = 50
+
+type udfError struct {
+	S      string `json:"s"`
+	ErrMsg string `json:"errmsg"`
+}
+
+func resError(c *gin.Context, msg string) {
+	c.JSON(http.StatusOK, udfError{S: "error", ErrMsg: msg})
+	c.Abort()
+}
+
+// /config
+func GetConfig(c *gin.Context) {
+	data := gin.H{
+		"supports_search":          true,
+		"supports_group_request":   false,
+		"supports_marks":           false,
+		"supports_timescale_marks": false,
+		"supports_time":            true,
+		"supported_resolutions":    kline.SupportedResolutions(),
+		"exchanges": []gin.H{
+			{"value": c.Param("chain"), "name": c.Param("chain"), "desc": c.Param("chain")},
+		},
+		"symbols_types": []gin.H{
+			{"name": "crypto", "value": "crypto"},
+		},
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// /time, 返回服务器当前时间(秒)
+func GetTime(c *gin.Context) {
+	c.String(http.StatusOK, strconv.FormatInt(time.Now().Unix(), 10))
+}
+
+// /symbols?symbol=
+func GetSymbol(c *gin.Context) {
+	db := utils.GetChainDatabase(c.Param("chain"))
+
+	symbol := parseSymbol(c)
+	if !utils.IsValidEthereumAddress(symbol) {
+		resError(c, "invalid symbol")
+		return
+	}
+
+	_pair, err := pair.GetPairInfoForApi(symbol, db)
+	if err != nil {
+		resError(c, "unknown symbol")
+		return
+	}
+
+	c.JSON(http.StatusOK, toSymbolInfo(c.Param("chain"), _pair))
+}
+
+// 前端可能带上交易所前缀, 如 eth:0x...
+func parseSymbol(c *gin.Context) string {
+	symbol := c.Query("symbol")
+	if i := strings.LastIndex(symbol, ":"); i >= 0 {
+		symbol = symbol[i+1:]
+	}
+
+	return symbol
+}
+
+func toSymbolInfo(chain string, _pair *schema.Pair) gin.H {
+	return gin.H{
+		"name":                  _pair.Address,
+		"ticker":                _pair.Address,
+		"description":           _pair.PairName,
+		"type":                  "crypto",
+		"session":               "24x7",
+		"timezone":              kline.Timezone(),
+		"exchange":              chain,
+		"listed_exchange":       chain,
+		"minmov":                1,
+		"pricescale":            priceScale(_pair.Price),
+		"has_intraday":          true,
+		"intraday_multipliers":  []string{"1", "5", "15", "60", "240"},
+		"has_daily":             true,
+		"supported_resolutions": kline.SupportedResolutions(),
+		"volume_precision":      2,
+		"data_status":           "streaming",
+	}
+}
+
+// 小价格的屌丝币也需要显示出至少4位有效数字
+func priceScale(price float64) int64 {
+	digits := 2
+	if price > 0 && price < 1 {
+		digits = int(math.Ceil(-math.Log10(price))) + 4
+	}
+	if digits > 16 {
+		digits = 16
+	}
+
+	return int64(math.Pow10(digits))
+}
+
+// /search?query=&limit=
+func Search(c *gin.Context) {
+	db := utils.GetChainDatabase(c.Param("chain"))
+
+	query := strings.TrimSpace(c.Query("query"))
+	if query == "" {
+		c.JSON(http.StatusOK, []gin.H{})
+		return
+	}
+
+	limit, err := strconv.ParseInt(c.DefaultQuery("limit", "30"), 10, 64)
+	if err != nil || limit <= 0 || limit > UDF_SEARCH_LIMIT_UPPER {
+		limit = UDF_SEARCH_LIMIT_UPPER
+	}
+
+	pairs, err := pair.SearchPairs(query, limit, db)
+	if err != nil {
+		resError(c, err.Error())
+		return
+	}
+
+	result := make([]gin.H, 0, len(pairs))
+	for _, _pair := range pairs {
+		result = append(result, gin.H{
+			"symbol":      _pair.Address,
+			"full_name":   c.Param("chain") + ":" + _pair.Address,
+			"description": _pair.PairName,
+			"exchange":    c.Param("chain"),
+			"ticker":      _pair.Address,
+			"type":        "crypto",
+		})
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+type history struct {
+	S string    `json:"s"`
+	T []int64   `json:"t"`
+	O []float64 `json:"o"`
+	H []float64 `json:"h"`
+	L []float64 `json:"l"`
+	C []float64 `json:"c"`
+	V []float64 `json:"v"`
+}
+
+// /history?symbol=&resolution=&from=&to=&countback=
+// countback 为前端需要的最少柱子数, 区间内不够时往前多取
+// 没有交易的周期用前一根的收盘价补齐, 与 pair trade info 使用的k线一致
+func GetHistory(c *gin.Context) {
+	db := utils.GetChainDatabase(c.Param("chain"))
+
+	symbol := parseSymbol(c)
+	if !utils.IsValidEthereumAddress(symbol) {
+		resError(c, "invalid symbol")
+		return
+	}
+
+	timeframe, ok := kline.ParseResolution(c.Query("resolution"))
+	if !ok {
+		resError(c, "unsupported resolution")
+		return
+	}
+
+	fromSec, err1 := strconv.ParseInt(c.Query("from"), 10, 64)
+	toSec, err2 := strconv.ParseInt(c.Query("to"), 10, 64)
+	if err1 != nil || err2 != nil || fromSec >= toSec {
+		resError(c, "invalid from or to")
+		return
+	}
+
+	interval := kline.TimeframeInterval(timeframe)
+	from, to := time.Unix(fromSec, 0), time.Unix(toSec, 0)
+
+	if countback, err := strconv.Atoi(c.Query("countback")); err == nil && countback > 0 {
+		if start := to.Add(-interval * time.Duration(countback)); start.Before(from) {
+			from = start
+		}
+	}
+
+	if earliest := to.Add(-interval * config.KlineMaxBars); from.Before(earliest) {
+		from = earliest
+	}
+
+	klines, err := kline.GetKlines(symbol, timeframe, from, to, db)
+	if err != nil {
+		resError(c, err.Error())
+		return
+	}
+
+	if len(klines) == 0 {
+		c.JSON(http.StatusOK, gin.H{"s": "no_data"})
+		return
+	}
+	klines = kline.FillKlines(klines, timeframe, to)
+
+	res := &history{S: "ok"}
+	for _, k := range klines {
+		res.T = append(res.T, k.UnixTime)
+		res.O = append(res.O, k.OpenPrice)
+		res.H = append(res.H, k.HighPrice)
+		res.L = append(res.L, k.LowPrice)
+		res.C = append(res.C, k.ClosePrice)
+		res.V = append(res.V, k.Volume)
+	}
+
+	c.JSON(http.StatusOK, res)
+}
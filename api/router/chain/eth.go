@@ -4,6 +4,8 @@ import (
 	"sfilter/api/internal/eth"
 	"sfilter/api/internal/eth/admin"
 	"sfilter/api/internal/eth/encrypt"
+	"sfilter/api/internal/eth/stream"
+	"sfilter/api/internal/eth/udf"
 	"sfilter/api/internal/eth/user"
 	"sfilter/api/utils"
 	guser "sfilter/user"
@@ -44,6 +46,18 @@ func setupEthRoutes(parentGroup *gin.RouterGroup) {
 		// trend
 		{
 			noAuthGroup.GET("/pairtrend", eth.GetPriceAndTxTrends)
+
+			// 任意周期的 OHLCV 柱子
+			noAuthGroup.GET("/klines", eth.GetKlines)
+		}
+
+		// TradingView UDF 数据源
+		{
+			noAuthGroup.GET("/udf/config", udf.GetConfig)
+			noAuthGroup.GET("/udf/time", udf.GetTime)
+			noAuthGroup.GET("/udf/symbols", udf.GetSymbol)
+			noAuthGroup.GET("/udf/search", udf.Search)
+			noAuthGroup.GET("/udf/history", udf.GetHistory)
 		}
 
 		// 简单加密数据
@@ -76,6 +90,12 @@ func setupEthRoutes(parentGroup *gin.RouterGroup) {
 		apiKeyGroup.GET("/hbpair", admin.GetHotBigPairs)
 	}
 
+	// 实时推送 swaps 等, websocket 或 SSE
+	streamGroup := ethGroup.Group("/stream").Use(apiKeyMiddleware)
+	{
+		streamGroup.GET("", stream.Subscribe)
+	}
+
 	// auth user etc..
 	authMiddleware := guser.GetUserAuthMiddleware()
 	authGroup := ethGroup.Use(authMiddleware)
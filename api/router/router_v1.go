@@ -37,6 +37,11 @@ func SetUpV1Router(router *gin.Engine) {
 		// get deals
 		adminGroupWithAuth.GET("/deals", admin.AdminGetDeals)
 
+		// 策略模拟盘
+		adminGroupWithAuth.GET("/paper/accounts", admin.AdminGetPaperAccounts)
+		adminGroupWithAuth.GET("/paper/positions", admin.AdminGetPaperPositions)
+		adminGroupWithAuth.GET("/paper/trades", admin.AdminGetPaperTrades)
+		adminGroupWithAuth.GET("/paper/equity", admin.AdminGetPaperEquity)
 	}
 
 }
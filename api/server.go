@@ -25,7 +25,7 @@ func NewServer(engine *gin.Engine, db *mongo.Client) *Server {
 func (server *Server) Run(port string) {
 	err := server.Engine.Run(port)
 	if err != nil {
-		app_utils.Fatalf("engine run failed. err: ", err)
+		app_utils.Fatalf("engine run failed. err: %v", err)
 		return
 	}
 }
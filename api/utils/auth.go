@@ -4,7 +4,6 @@ import (
 	"log"
 	"net/http"
 	"sfilter/config"
-	gutils "sfilter/utils"
 
 	"github.com/gin-gonic/gin"
 )
@@ -27,7 +26,7 @@ func AuthChainMiddleWare() gin.HandlerFunc {
 		// 获取客户端cookie并校验
 		chain := c.Param("chain")
 		// gutils.Tracef("[ AuthChainMiddleWare ] chain: %v", chain)
-		if gutils.CheckExistString(chain, config.ValidChains) {
+		if _, ok := config.GetChainConfig(chain); ok {
 			c.Next()
 		} else {
 			c.JSON(http.StatusUnauthorized, "chain is wrong")
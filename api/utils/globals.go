@@ -2,6 +2,9 @@ package utils
 
 import (
 	"sfilter/config"
+	chainService "sfilter/services/chain"
+	"sfilter/services/eventbus"
+	"sync"
 
 	"go.mongodb.org/mongo-driver/mongo"
 )
@@ -16,19 +19,52 @@ func InitMongo(db *mongo.Client) {
 // 	return mongodb
 // }
 
+// 每条链使用独立的数据库, 未知的链使用默认数据库
+// 当前链使用 env 覆盖后的数据库
 func GetChainDatabase(chain string) *mongo.Database {
-	var dbName string
+	dbName := config.DatabaseName
 
-	switch chain {
-	case "avax":
-		dbName = "avax"
+	if chain != config.CurrentChain.Name {
+		if cfg, ok := config.GetChainConfig(chain); ok {
+			dbName = cfg.DatabaseName
+		}
+	}
 
-	case "optimism":
-		dbName = "optimism"
+	return mongodb.Database(dbName)
+}
 
-	default:
-		dbName = config.DatabaseName
+// api 不连接节点, 返回的 service 只能用于读取db
+func GetChainService(chain string) *chainService.Service {
+	cfg, ok := config.GetChainConfig(chain)
+	if !ok {
+		cfg = config.CurrentChain
 	}
 
-	return mongodb.Database(dbName)
+	return chainService.NewService(cfg, nil, nil, GetChainDatabase(chain))
+}
+
+var buses = make(map[string]*eventbus.Bus)
+var busesLock sync.Mutex
+
+// 每条链一个事件总线, 第一次使用时连接
+func GetEventBus(chain string) (*eventbus.Bus, error) {
+	busesLock.Lock()
+	defer busesLock.Unlock()
+
+	if bus, ok := buses[chain]; ok {
+		return bus, nil
+	}
+
+	cfg, ok := config.GetChainConfig(chain)
+	if !ok {
+		cfg = config.CurrentChain
+	}
+
+	bus, err := eventbus.Dial(cfg.Name, GetChainDatabase(chain))
+	if err != nil {
+		return nil, err
+	}
+	buses[chain] = bus
+
+	return bus, nil
 }
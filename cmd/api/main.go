@@ -19,7 +19,7 @@ func main() {
 	clientOptions := options.Client().ApplyURI(config.MONGO_ADDR)
 	mongodb, err := mongo.Connect(ctx, clientOptions)
 	if err != nil {
-		utils.Fatalf("connect mongo error: ", err)
+		utils.Fatalf("connect mongo error: %v", err)
 	}
 
 	r := gin.New()
@@ -4,15 +4,15 @@ import (
 	"context"
 	"flag"
 	"log"
+	"strings"
 	"time"
 
-	"github.com/ethereum/go-ethereum/ethclient"
-
-	"github.com/cloudfresco/ethblocks"
-
 	"sfilter/config"
 	handler "sfilter/handler/sfilter"
 	"sfilter/schema"
+	"sfilter/services/chain"
+	"sfilter/services/eventbus"
+	"sfilter/services/rpcpool"
 	userModels "sfilter/user/models"
 	"sfilter/utils"
 
@@ -23,27 +23,79 @@ import (
 func main() {
 	db := flag.String("db", "", "the db want to use")
 	block := flag.Int64("block", 0, "a tool to retrive/test one block")
+
+	// 历史区块回填模式
+	from := flag.Int64("from", 0, "backfill start block, enable backfill mode if set")
+	to := flag.Int64("to", 0, "backfill end block(included), 0 means chain head")
+	workers := flag.Int("workers", config.MaxConcurrentRoutineNums, "backfill worker num")
+
+	// 多链: 每条链一个子进程, 互不影响
+	chainName := flag.String("chain", "", "the chain to run, default is CHAIN env or eth")
+	chains := flag.String("chains", "", "run multi chains in sub processes, eg: eth,bsc")
+
+	trackSwap := flag.Bool("trackswap", false, "whether enable user track swaps")
 	flag.Parse()
 
+	if *chains != "" {
+		// db 等只属于一条链, 不能同时用于多条链
+		if *db != "" {
+			utils.Fatalf("[ main ] -db can not be used with -chains")
+		}
+		superviseChains(strings.Split(*chains, ","))
+		return
+	}
+
+	if *chainName != "" {
+		cfg, ok := config.GetChainConfig(*chainName)
+		if !ok {
+			utils.Fatalf("[ main ] unknown chain: %v, valid chains: %v", *chainName, config.GetChainNames())
+		}
+		if config.HasEnvChainConfig() {
+			utils.Warnf("[ main ] -chain %v ignores WS_ADDR/ARCHIVE_ADDR/DB_NAME in env", *chainName)
+		}
+		config.ApplyChainConfig(cfg)
+	}
+
 	client, mongodb := _init(*db)
 
-	h, err := handler.NewHandler(client, mongodb)
+	svc := chain.NewService(config.CurrentChain, client, nil, mongodb.Database(config.DatabaseName))
+
+	// 回填的历史数据不通知下游
+	var bus *eventbus.Bus
+	var err error
+	if *from <= 0 {
+		bus, err = eventbus.Dial(config.CurrentChain.Name, mongodb.Database(config.DatabaseName))
+		if err != nil {
+			utils.Fatalf("[ main ] dial event bus failed: %v", err)
+		}
+	}
+
+	h, err := handler.NewHandler(svc, client, bus, mongodb)
 	if err != nil {
 		utils.Fatalf("[ loop ] NewHandler failed: %v", err)
 	}
 
-	// go getTrackAddressOnTimer(mongodb)
+	if *trackSwap && bus != nil {
+		getTrackAddressOnTimer(mongodb)
+		h.SubscribeUserTrackSwaps()
+	}
+
+	if *from > 0 {
+		h.Backfill(*from, *to, *workers)
+		return
+	}
 
 	h.Run(*block)
 
 }
 
-func _init(db string) (*ethclient.Client, *mongo.Client) {
+func _init(db string) (*rpcpool.Pool, *mongo.Client) {
 	if db != "" {
 		config.DatabaseName = db
+		config.CurrentChain.DatabaseName = db
 	}
 
-	client, err := ethblocks.GetClient(config.WS_ADDR)
+	client, err := rpcpool.Dial(config.CurrentChain.Name, config.CurrentChain.RPCEndpoints())
 	if err != nil {
 		log.Fatal(err)
 	}
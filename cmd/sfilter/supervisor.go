@@ -0,0 +1,82 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"sfilter/config"
+	"sfilter/utils"
+)
+
+// 子进程退出后的重启间隔
+const chainRestartInterval = 10 * time.Second
+
+// 全局配置是进程级别的, 所以每条链启动一个独立的子进程
+// 子进程异常退出后自动重启
+func superviseChains(names []string) {
+	exe, err := os.Executable()
+	if err != nil {
+		utils.Fatalf("[ superviseChains ] get executable error: %v", err)
+	}
+
+	args := childArgs()
+
+	var wg sync.WaitGroup
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		if _, ok := config.GetChainConfig(name); !ok {
+			utils.Fatalf("[ superviseChains ] unknown chain: %v, valid chains: %v", name, config.GetChainNames())
+		}
+
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+			runChainProcess(exe, name, args)
+		}(name)
+	}
+
+	wg.Wait()
+}
+
+// 只属于一条链的参数, 不传给子进程, 子进程使用各自的链配置
+var chainScopedFlags = map[string]bool{
+	"chains": true,
+	"chain":  true,
+	"db":     true,
+}
+
+// 子进程沿用命令行中设置过的其余参数
+func childArgs() []string {
+	var args []string
+	flag.Visit(func(f *flag.Flag) {
+		if chainScopedFlags[f.Name] {
+			return
+		}
+		args = append(args, fmt.Sprintf("-%v=%v", f.Name, f.Value.String()))
+	})
+
+	return append(args, flag.Args()...)
+}
+
+func runChainProcess(exe, name string, args []string) {
+	for {
+		cmd := exec.Command(exe, append([]string{"-chain", name}, args...)...)
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+
+		utils.Infof("[ runChainProcess ] start chain: %v", name)
+		err := cmd.Run()
+		utils.Errorf("[ runChainProcess ] chain(%v) exited, err: %v, restart after %v", name, err, chainRestartInterval)
+
+		time.Sleep(chainRestartInterval)
+	}
+}
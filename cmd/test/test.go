@@ -1,16 +1,31 @@
 package main
 
 import (
+	"context"
+	"sfilter/config"
 	"sfilter/services/chain"
 	"sfilter/utils"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 func test() {
 	utils.Infof("****** Debug start ******\n\n")
 
-	chain.TEST_POOL()
+	mongodb, err := mongo.Connect(context.Background(), options.Client().ApplyURI(config.MONGO_ADDR))
+	if err != nil {
+		utils.Fatalf("connect mongo error: %v", err)
+	}
+
+	svc, err := chain.Dial(config.CurrentChain, mongodb.Database(config.DatabaseName))
+	if err != nil {
+		utils.Fatalf("connect chain error: %v", err)
+	}
+
+	chain.TEST_POOL(svc)
 
-	// chain.TEST_CHAIN()
+	// chain.TEST_CHAIN(svc)
 	// tutils.TEST_ENCRYPT()
 
 	utils.Infof("****** Debug end  ******\n\n\n")
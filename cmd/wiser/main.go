@@ -1,8 +1,18 @@
 package main
 
 import (
+	"context"
 	"flag"
+	"sfilter/config"
 	handler "sfilter/handler/wiser"
+	"sfilter/services/backtest"
+	"sfilter/services/chain"
+	"sfilter/services/eventbus"
+	"sfilter/utils"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 func main() {
@@ -14,11 +24,80 @@ func main() {
 	deal := flag.Bool("deal", false, "whether enable deal inspect")
 	wiser := flag.Bool("wiser", false, "whether enable wiser inspect")
 
-	hx := flag.String("hx", "", "whether enable hot big or etc")
+	hx := flag.String("hx", "", "hot pair strategies to run, comma separated such as hb,hn, or all")
+
+	bt := flag.String("backtest", "", "backtest a strategy: hb, hs, hn or hsp")
+	from := flag.String("from", "", "backtest start time, as 2006-01-02 or 2006-01-02 15:04")
+	to := flag.String("to", "", "backtest end time, empty for now")
+	params := flag.String("params", "", "strategy params in json to override defaults, e.g. {\"stopLoss\":0.4}")
+	capital := flag.Float64("capital", config.BacktestInitialCapital, "backtest initial capital in usd")
+	size := flag.Float64("size", config.BacktestPositionSize, "backtest position size in usd")
+	gas := flag.Float64("gas", 0, "backtest gas cost in usd for each fill")
+	out := flag.String("out", "backtest", "backtest output directory")
 
 	flag.Parse()
 
-	hndl := handler.NewHandler(*account, *db, *debug, *deal, *wiser, *hx)
+	svc := newChainService(*db)
+
+	if *bt != "" {
+		start, err := parseTime(*from)
+		if err != nil {
+			utils.Fatalf("parse from error: %v", err)
+		}
+
+		end := time.Now()
+		if *to != "" {
+			if end, err = parseTime(*to); err != nil {
+				utils.Fatalf("parse to error: %v", err)
+			}
+		}
+
+		cfg := backtest.NewConfig(start, end)
+		cfg.InitialCapital = *capital
+		cfg.PositionSize = *size
+		cfg.GasUsd = *gas
+
+		if _, err := handler.RunBacktest(svc, *bt, *params, cfg, *out); err != nil {
+			utils.Fatalf("backtest %v error: %v", *bt, err)
+		}
+		return
+	}
+
+	bus, err := eventbus.Dial(config.CurrentChain.Name, svc.DB())
+	if err != nil {
+		utils.Fatalf("dial event bus error: %v", err)
+	}
+
+	hndl := handler.NewHandler(svc, bus, *account, *debug, *deal, *wiser, *hx)
 
 	hndl.Run()
 }
+
+func parseTime(s string) (time.Time, error) {
+	t, err := time.ParseInLocation("2006-01-02 15:04", s, time.Local)
+	if err != nil {
+		return time.ParseInLocation("2006-01-02", s, time.Local)
+	}
+
+	return t, nil
+}
+
+func newChainService(db string) *chain.Service {
+	if db != "" {
+		config.DatabaseName = db
+		config.CurrentChain.DatabaseName = db
+	}
+
+	clientOptions := options.Client().ApplyURI(config.MONGO_ADDR)
+	mongodb, err := mongo.Connect(context.Background(), clientOptions)
+	if err != nil {
+		utils.Fatalf("connect mongo error: %v", err)
+	}
+
+	svc, err := chain.Dial(config.CurrentChain, mongodb.Database(config.DatabaseName))
+	if err != nil {
+		utils.Fatalf("connect chain error: %v", err)
+	}
+
+	return svc
+}
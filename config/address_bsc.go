@@ -2,1980 +2,2000 @@ package config
 
 // 一些已知的地址集合
 
-func initBscChain() {
-	BlockChain = "bsc"
+func bscChainConfig() *ChainConfig {
+	return &ChainConfig{
+		Name:         "bsc",
+		ChainId:      56,
+		DatabaseName: "bsc",
+		BlockTime:    3,
 
-	WETH_ADDRESS = "0xbb4CdB9CBd36B01bD1cBaEBF2De08d9173bc095c"
-	QuoteEthCoinList = []string{
-		WETH_ADDRESS,
-	}
+		NativeTokenSymbol: "BNB",
+		WethAddress:       "0xbb4CdB9CBd36B01bD1cBaEBF2De08d9173bc095c",
 
-	QuoteUsdCoinList = []string{
-		"0x8AC76a51cc950d9822D68b83fE1Ad97B32Cd580d", // usdc
-		"0x55d398326f99059fF775485246999027B3197955", // usdt
-		"0x1AF3F329e8BE154074D8769D1FFa4eE058B1DBc3", // dai
-		"0xe9e7CEA3DedcA5984780Bafc599bD69ADd087D56", // busd
-	}
+		// pancake usdt/wbnb v2 pair
+		NativePricePool: NativePricePool{
+			Address:  "0x16b9a82891338f9bA80E2D6970FddA79D1eb0daE",
+			Type:     "v2",
+			Decimal0: 18,
+			Decimal1: 18,
+		},
 
-	BlackHoleAddresses = []string{
-		"0x0000000000000000000000000000000000000000",
-		"0x000000000000000000000000000000000000dEaD",
-	}
+		// pancake usdt/wbnb, busd/wbnb v2 pair
+		NativePriceRefPools: []string{
+			"0x16b9a82891338f9bA80E2D6970FddA79D1eb0daE",
+			"0x58F876857a02D6762E0101bb5C46A8c1ED44Dc16",
+		},
 
-	FamousRouters = []string{
-		"0x10ED43C718714eb63d5aA57B78B54704E256024E",
-		"0x7DAe51BD3E3376B8c7c4900E9107f12Be3AF1bA8",
-		"0x3a6d8cA21D1CF76F653A67577FA0D27453350dD8",
-		"0xcF0feBd3f17CEf5b47b0cD257aCf6025c5BFf3b7",
-		"0xCDe540d7eAFE93aC5fE6233Bee57E1270D3E330F",
-		"0x05fF2B0DB69458A0750badebc4f9e13aDd608C7F",
-		"0x50f5679F0CeF71287bD9C7e619960fF9C579661C",
-		"0xbd67d157502A23309Db761c41965600c2Ec788b2",
-		"0xD48745E39BbED146eEC15b79cBF964884F9877c2",
-		"0x95B09c446aF69f385455670B78B64BcBFb24eB8E",
-		"0x325E343f1dE602396E256B67eFd1F61C3A6B38Bd",
-		"0x8f2A0d8865D995364DC6843D51Cf6989001f989e",
-		"0xdb07Ed70aA18FfC8B422bF3D8AF947E937511FDF",
-		"0x65b302EdC264604b335487542559658F79128EA5",
-		"0x3a6DbC31B2a68Aaf5eE6F359d790ccDdb700Add9",
-		"0xc2a88eCE6B6321819D947c9EadEABBa699c16349",
-		"0x0a04fA4A842fa21e202DD07E721B048b466EDb47",
-		"0x238fF9B0B8256830Ac229801C1791C3e2801dEda",
-		"0x730aCC3bBf2443f2EaEaCFc7ac7b4d8DC9E32dB8",
-		"0x933DAea3a5995Fb94b14A7696a5F3ffD7B1E385A",
-		"0x6B011d0d53b0Da6ace2a3F436Fd197A4E35f47EF",
-		"0x6A1A6B78A57965E8EF8D1C51d92701601FA74F01",
-		"0x34DBe8E5faefaBF5018c16822e4d86F02d57Ec27",
-		"0xBA9DF2c143C502A433d6A876C1a291C9FC940cf9",
-		"0xC0788A3aD43d79aa53B09c2EaCc313A787d1d607",
-		"0xb2E8D6BD3ECA4fd91FDC14C43580e80183979F2c",
-		"0x3658f687eee36B1661e2Bc8758e6e0AB704FAb93",
-		"0x71ac17934b60A4610dc58b715B61e45DCBdE4054",
-		"0x151030a9Fa62FbB202eEe50Bd4A4057AB9E826AD",
-		"0x48e611BCAEDb0eFf07d7B7Cfe4cBfED000eCf379",
-		"0x7Dd3B4D9DE913A46E0b386dF1F7d9FAc46865754",
-		"0xB44A8AEb4805a5404a8d20A1294a61C95Ae6F256",
-		"0x410835D82321c2dF3888F74bcE8a31Eb1266A369",
-		"0xA4CE57F063A610290EEEF0564B034278438D06CF",
-		"0x018dd7894DDe11FE47111432c79D2eD23E12E31c",
-		"0xB82D317492b95DADB903D4b7651108528eafDa61",
-		"0x1042bDe2477D8368Cb7b33A6EcaB8837e63d0361",
-		"0xBe65b8f75B9F20f4C522e0067a3887FADa714800",
-		"0x53C309a523316599F9dAd3797860e625dA62F66e",
-		"0xeb17Dd35e47B1a41ba4D86B3506ec1f9b680b56a",
-		"0x8CA43fC055Ae47E11959e079F37051831F850cA7",
-		"0x64E8Dad72fEB8Af8261D377A0a2c1441e3589A75",
-		"0xA63B831264183D755756ca9AE5190fF5183d65D6",
-		"0x3464aFEae02A6f4D657946Fb6c162503a208E95D",
-		"0x1C0a81Cc2383E2f28DF42d7E4b47a09dD9526157",
-		"0x9F088377BcdC220CB0E1Ad15aE6Bc75074beE9F6",
-		"0x2C6cF65f3cD32a9Be1822855AbF2321F6F8f6b24",
-		"0xD2243FBDf88eEB6A3b147e4d73E3bA29f90dD4Dd",
-		"0x77dDFC7BD31EC82D1377E273b2b127a15038D6f3",
-		"0xa3b81724638208fAA03810b391dde879E5FB0c79",
-		"0x319EF69a98c8E8aAB36Aea561Daba0Bf3D0fa3ac",
-		"0xE804f3C3E6DdA8159055428848fE6f2a91c2b9AF",
-		"0xd4B5Bf027d5Ac9Ab43C437959694b26C3A6F0C24",
-		"0x790B780964fEa91fD0E9498a5e68aBF9A7994c8A",
-		"0x24f7C33ae5f77e2A9ECeed7EA858B4ca2fa1B7eC",
-		"0x7E54F740C14cA8398871F5042Df8cE6DAB1E1f09",
-		"0x0c7146a55f976E9A7157F8a832BD4B751e0E6A13",
-		"0x0130F4FAEC538BAe9EcA34b12605bf3e0a6Aca42",
-		"0x34766241a5DF0483545A52AB1DBd5eC88E251dD3",
-		"0xaA8902273ac49680a412b5e8bE31278e6e78CD2f",
-		"0xfb9675e9c707f88283a5625dEd560be1eea9738e",
-		"0xA4385646517D9B34aDa4AC393a64Ea120d08408C",
-		"0x062693fb996cC7c3185e0Aa963f514Dcd5304760",
-		"0x5164b689eBECf0F5186969D410919092620C1F1f",
-		"0xF2d8b85CA474C41AAFDAeA24f4D1f3FAf5F6516c",
-		"0x558B92017a3625A2C5f9E131E97123941356fE2f",
-		"0x3047799262d8D2EF41eD2a222205968bC9B0d895",
-		"0x5Beb385Ac5c30CfE4077942e396D7B745E593C88",
-		"0x1b02dA8Cb0d097eB8D57A175b88c7D8b47997506",
-		"0xeB98E6e5D34c94F56708133579abB8a6A2aC2F26",
-		"0xc9E475A954087e851a11aC47fA03902f94e8F74b",
-		"0xdd827EB8Fd0C95a1372CcA8f2ded3406eE034f08",
-		"0x0f1700cEc9781740a832B370467cbBBcdAf75AD1",
-		"0xb68825C810E67D4e444ad5B9DeB55BA56A66e72D",
-		"0xBeB43fbb2f7AEA8AC904975816BB1b4cA9f4D9c5",
-		"0x29D1Adbb65d93a5710cafe2EF0E8131f64E6AB22",
-		"0x31d232214Ff5B767916dd74bE5f4ABD88A63C0c3",
-		"0x864ba2F7153749a55ab8d93218cA0aa5F53Ec392",
-		"0xba79bf6D52934D3b55FE0c14565A083c74FBD224",
-		"0xeB1c0EfE6655E019E2c6AB01e0Bd0c97f4899A4e",
-		"0x45A664993f6c3e978A1257c6EF7bBB512af9F098",
-		"0x939ffC5a4f3e9DF85e1036A8C86b18599A403F3B",
-		"0xA1fDB322Ab5fE4dF90099E6f514B9819AEaCA8Cf",
-		"0xfd0A50D0350Fa7A0cd233Fd229bAa6703F425BF8",
-		"0xA4fB6622c59628A822E3514B5FD99b8b70A9bACD",
-		"0x8Ba22ebAFcBC717086f94d13dBa5a5F1d06F9DaC",
-		"0xc3e8b0AB3c1c24e224c11B50727874167203bD9f",
-		"0x76437234D29f84D9A12820A137c6c6A719138C24",
-		"0x0F4610aB02920a99f639F675085A5d3e24b0D7ae",
-		"0x62B3bB8B97d74Bd755bE1a385CDD21E25071aFB4",
-		"0xf542194C48729406983868443ccC10Cb7f9d1799",
-		"0xE9797EC96C3728D4A14493E0CAE21f216DbE6b3b",
-		"0x2b9856fa15974F994A7D3040874AF658E6B9f312",
-		"0x3bc677674df90A9e5D741f28f6CA303357D0E4Ec",
-		"0x789c11212EaCA5312d4aa6d63148613e658CcFAd",
-		"0xBFBc891E6C707243Bb1949A92A1DcBc9ba160DE7",
-		"0x3181460954E2c590a0314B948a2e529777cc89A2",
-		"0x816278BbBCc529f8cdEE8CC72C226fb01def6E6C",
-		"0xD1E15281258959B3dE7CbC119bB230a668cD92A6",
-		"0x3e8743B5453A348606111AB0a4dEe7F70A87f305",
-		"0x3F9E7DFeBe5732342D6752CFcd0D68C54634D64e",
-		"0x833bA1Ab69309Dc6202826800968921F0fdc168C",
-		"0x8318DC0015DB177001C8EF49587f290788416656",
-		"0x817cBbDCA4113143264a95f3F196790a627F556e",
-		"0x062665e650F186142164Eac0369e24E640C51DB0",
-		"0x07DC75E8bc57A21A183129Ec29bbCC232d79eE56",
-		"0xdADaae6cDFE4FA3c35d54811087b3bC3Cd60F348",
-		"0x30b6Da486feA6CCf2489E562df53e2F95EbBB2fF",
-		"0xf83d7656C543a949f7AB4fB0224B754F80f91eB6",
-		"0x6e0f235E73dc6cd77c2f94ED581a480Da54Ee957",
-		"0x42589d8199e3144B2683FA07bFfff641De14C38d",
-		"0xa97250a718A79b1AC54CACB1b32Bd3485b8b6F63",
-		"0x4a227a8A4a0009b3321C5941bD359c4E8f3D6D91",
-		"0xf6135FCb4A0F469DcBb3e6D83520Dc21825A0001",
-		"0x70756620ab567B57335f29d372297a0832ea462C",
-		"0x0163b81594e45f9a4889431417d4840301Ea359d",
-		"0xDa5884F0ae3eEF8baA4D11cf9278323FE049D536",
-		"0xFF7ea76CEEA796C2Bf2800C6A71b762a72fe570c",
-		"0x9Fb8fF47Db2A57f43D3589eD8c16AF4f5FcCc5f8",
-		"0xBfBCc27fC5eA4c1D7538e3e076c79A631Eb2beA6",
-		"0xF61A349F8feda7f738F231A2b0c856f9afAa59e7",
-		"0xBFEBd871ddE8cD22999aC9C5679Dfc8248884fCe",
-		"0x29A3Ea9fE2fc3CF8fd27d42dE4d12f022a25B326",
-		"0x7b0e3787dAd96e5eA5f024c5521FA0E9B1608E62",
-		"0xa02d2375DfeFbA93b76a647BC61A6E65512BeB68",
-		"0xB0e28C53B7C84741085EFE2e16CFF1d04149848f",
-		"0x166fE0a4190669dc0Bb0EeE15f50a002af3b869C",
-		"0xD7d1215ddbc2F6e69D7FD2d52e5096671FfF46Cb",
-		"0x01F9C3f2edBA6B673C45aA437e496078D9ac918A",
-		"0xe779e189a865e880CCCeBC75bC353E38DE487030",
-		"0x6728f3c8241C44Cc741C9553Ff7824ba9E932A4A",
-		"0x4DDB6FE0d9A9228795aEc247E8d599d8fbf68Df9",
-		"0x6E3a5e2f96fC47660d3c0289DC7Dc76D4d5314F9",
-		"0xbf73c5060229c12AD8B0B39971eFf2Ee658B57B5",
-		"0x5C5bD35CA3E6Bd322A956BD99bBF08F1Aa58931d",
-		"0x0a4A901E9Ef381667f7c01d70a530BC2D266dd80",
-		"0x974A8959c52f6109C59d0A6D63D4eA4CC522DfA2",
-		"0x788B8EcE56F2C0eD41f31D7cd172276addCD7F99",
-		"0x8403502464375da4002D011Bf8F39288501A179d",
-		"0x68F3efbFab22CB761c06dB3143f4b66De92b7AD6",
-		"0xBE930734eDAfc41676A76d2240f206Ed36dafbA2",
-		"0x008Ea4e222B932D29171fD0E79c7430Bca37454e",
-		"0x6679D8E3cDa6441d5C5C12691C3de9E47d80663d",
-		"0x948b3adfc795Cc4144D061a9e69fcA4C01E4e62D",
-		"0xfe7a3cA7273CC197e70dEA81B9ba25F2510fa033",
-		"0xC8a18C45a20ae3C200C1145a44223a991ea0eb2B",
-		"0x7fce9C24e09363754Dc7dBc1d033E7Aa93e751B9",
-		"0x72C8919c7fab199E9cA60272207B7e8F10C7C1fE",
-		"0xd05Af8871f8ADEF7b32Aa213a91A89d6e6A8C2A5",
-		"0x83e49d6D58EC47c4f590ef11930da18349104c99",
-		"0xEfd9281E2fAe0fC1538B04b3CC16A602D57e4D07",
-		"0x299385325392F537Fc6B4281d2dbe31280833Dcb",
-		"0x0e3fFbcdF9B699370F3dF4D9716F18F22a0974B7",
-		"0xd9142E0Cd3B2b46F6F6f1E20270fd290356323c3",
-		"0xD4709c2662281BB601c1a1330B85478d2cF1F37a",
-		"0x1C63048420aAeCEC6C418B456Dc9FeFFd9584e37",
-		"0x257d864FD7787f64D214b6039314F24fC1522d5f",
-		"0x6d2a25a59B04C8d0BC53b94D83134bFcb162e68B",
-		"0xcEAF0B1F34B009bCa4a78E4A9b2a84740275969a",
-		"0x211A47A691c84D3576Ff081ff9709F19F0813983",
-		"0x6E20a29b8a011905f654d8C52E141976e77f3365",
-		"0xdf892439A5a26BDb69259Db315a706eD2F239948",
-		"0xF3FcB02c0Ae2FB04e8dAEFCA49D29557Ec1F68e5",
-		"0x88702179A882C73e88ffB40243C0B499Ab9DecFb",
-		"0x106Bd286705d9A6C6b61553a12FB3266d52DE42a",
-		"0x78761Ef51b45ec09a0372faEAA3a0dC082b9eeE6",
-		"0x022b03C44da70034ee0B8562fc027C66D74F72F0",
-		"0x24cEFA86fC1826FD31b4cb911034907735F8085A",
-		"0x9759EE0E59039fD08E8C2454ae739b4C266F3eEA",
-		"0xC2DaefF8Cb77Be50FfC921C9d4bf9AB3a02Ad98D",
-		"0x3EabfC5B9eca129520f272F2D8f7aF164320fa5a",
-		"0x235B537Edb6091F75Afbfc706f5a1795C5c41C87",
-		"0xa871F514C70dC3399aB9605258eFF5c78d5a95Ae",
-		"0x2002E3e326Afa4091Ea44A878845758F60D66468",
-		"0x02341158Eb17aeEA92F29E6a7E0FE942615d8A44",
-		"0x9564a2972B25d2E954f513fBC949FC313730483E",
-		"0xd07622f60543D1f744B6397C27Ac4a2e226d0943",
-		"0x7F3e8646b238cFdf635CF2B039E3946B2f524139",
-		"0x070599c0F92Bc9280b56edD62F14f2EfD85a35Af",
-		"0x647B09b532cEf52f3B9b0B5bd05E843abD99B773",
-		"0x1e69D4CF801C0931DC1b8f04067888cB7B5092Df",
-		"0x267bAbbE93B9E9499aCA9938Dd46922590428643",
-		"0x2dA836986176Dac234f91C5B1Aabc1F8FefFA794",
-		"0x4eaB3f1B2f0ab85DdDA4d34EDf691ADE9eB9b146",
-		"0xBb496Ddf38d4c035Cb2D54d3A633f260B74B5fbe",
-		"0x039B5818e51dfEC86c1D56A4668787AF0Ed1c068",
-		"0x6612aB01CAa56f8EC4283609a99f20A89173fD57",
-		"0x03a2F8F90B219732757472BB54801a82f33A8d0D",
-		"0x200795DD9901d33760dA1D0Ec1536c7A82079d5E",
-		"0xf78234E21f1F34c4D8f65faF1BC82bfc0fa24920",
-		"0xBf6527834dBB89cdC97A79FCD62E6c08B19F8ec0",
-		"0xfED014c59D67596a05E405098627C52449eBd231",
-		"0xb4BC6B66e651db4e1d4Db1B6357DB92256a6DbDf",
-		"0x3C36559f2F43ece239408Db632C65595984E3eAF",
-		"0x2E055ecBC630C8B27835cD9A11c69C9b5Bda806C",
-		"0xd954551853F55deb4Ae31407c423e67B1621424A",
-		"0xe709F9b9998b3244716C77D1edA4a3AA6dE72D39",
-		"0x2720aa61A090dDcD4D49DFa57A48CD204a536e3B",
-		"0x7833f7d66248E4fbD705CD83DcC74288ff98d313",
-		"0xbF9ef464B93B1acd5b0139CCd3Dc94e61506D3F4",
-		"0xcEd35286a83DF82b6914241BBbbbAc8657B508AD",
-		"0x8cFD8B326FC4F76aC7B09b24926e70a1f9971953",
-		"0x943b9D2C6B75eEAAB567E192ac301ee166d9Fa9C",
-		"0xf1B5Dd022a339E5b6F5Cb420aDc7Eea23F520d74",
-		"0x172eda9C686F507DAa1c20Df2868552d6382d69F",
-		"0xB53E3f35Cc812e0E9661c86cda6a1A08d25D7b55",
-		"0x4c80d01e8B35D8A5d349779e24A006DF3bac7165",
-		"0xE09E30b8a57b245Ba48595663204f5dbAB75bced",
-		"0x5D0b9FE11518AE2b810A2a3d25cAc457b427F839",
-		"0x8dA0A4eF9843E79bFC8b60176bfDD98d956e6E39",
-		"0xB1Aa6BC3779f91f03354F3B2c1c792987475354A",
-		"0x5Ee5aC9F359B5aE622cF9DDD473473FADcD1D5f3",
-		"0x0445BA3749Ea76f63A52Fb697F5BE99D605eA084",
-		"0x829AdDC0B20AA383533488fBf00f5bE2D8326793",
-		"0x2Be5f71fc24EdbAADfD9cF44d155E57c53Feccc5",
-		"0xB9935Ef79cd5b782E1868Ad90FE774bFc2ccd278",
-		"0xBF378E3423341A9C95a640Da4C5C9Fa4896509c6",
-		"0x53AFE866689Dd61d28cBDC631CE8BfB96a8B6EE8",
-		"0x86060f6d5F4b7F44a1F622d31421bb209E121C58",
-		"0x54C71bb4CEa54d55274d10D3bAc0e6e9d41Efa20",
-		"0x44D47333489eb40B51795442976A83d5efC5ce2e",
-		"0x6332098C436b62f9d3ad9037dA01fD3ded91B587",
-		"0x5fcE4b46b58996a4aAc0962dEC2cd9B257352ee1",
-		"0xb3eEb69945D278Fe6f755830316a6A43eeB09Bd5",
-		"0xaD36C9028BD2319E6e44e5512C149051c4C8805D",
-		"0xDf9c8df924C8B15C51b5A21F572A23F7170d8358",
-		"0x4d3a7e04Be857a1E8255eC80C503042498872141",
-		"0xCDe9Bbb4c2f5d3f16C825A33aB70d1856a7B3616",
-		"0xA195bc35Ae7eBe75F4fe3946B840eB8D7D330A01",
-		"0x915f9282FED53f3F602B0189bfdfA61936bf3f80",
-		"0x39C25D0f1c214D0850F8745451f44988eCe54ECF",
-		"0xb5DBb58AE0e3660BFE344b30A1cbA53f8645B40d",
-		"0xc0FFEE8908472C6D52A17212302D25B617182249",
-		"0xdff9AdfE938CfE7fDC900E8e17B76d6D4f56FD0a",
-		"0x098dDaE6F7064349C47dC4636ff21950a24F14Bc",
-		"0x50185e25Eba699a5fc5471750776EaF20601914E",
-		"0x3d492a1Cf02112f201721544e13a5e239a5258d9",
-		"0x1CFf40562d74dC38244eA1E7D2EF98A7b83F4893",
-		"0x13947D465C7c1A4165783FfC751D96dB966EDB51",
-		"0xcA52053eB17b7Bd87Fc6f50188E2d90D5240cde2",
-		"0x3F7D42098acc35A27Fd0eB2D978357749A563F8F",
-		"0x8572Ce7C71051DA8dA9f8fBF9F8B0820ac8EA6E3",
-		"0x8744750E1557882d9Ae7E9600499c535C64367E2",
-		"0x04d4d8C319C35F60782138DEDff14813b95965E7",
-		"0xEcC3Cd549CB18DEcb134317240F898234D847cD0",
-		"0xe7EE9E2d07AD78243f801C3a623029c98194A5C1",
-		"0xE1e1D26d78F0e7917c17E3283A57f79f1FEC2487",
-		"0xEB94Efc5A0196C4BB9017bFC9e129e69dd3f7A7a",
-		"0x08285A32ab574355EBCb1EB30222CB2256fbD992",
-		"0x401a67F101C65dF6B5Cb21e14701d83FBf9d8831",
-		"0x388F5C06006b04beFb48F29da9ef7333a8361d1C",
-		"0x1DF18fEaC0fe79A5DEa91d8C4Cf286908c383181",
-		"0x9073284e1BABD3C12932dEf4EDf684139952a337",
-		"0x9387A796e19A177eA726b6BABd79482600A29D2B",
-		"0x99A3f16475B73caD9c30c45416c7bdBB0C35f2E3",
-		"0x38e63bBfCf959e584016d1261172122ced731fdD",
-		"0x827BDb822940198F22FA984c5645a27C60E6Bb5B",
-		"0x2F91756Cbb5A5247db6EE79DC195c85039e27687",
-		"0x4863A6A90f8C62F7Ce0046Bf5a9AdbC7C936ba9A",
-		"0x43773B20580123F868b6Cf4Fd81967ada5e9e6CA",
-		"0x02332C69DeBf4EdA2857E6146cA87425f1F432e8",
-		"0xB6F69581488054447A14780e6268b021A3Eb1157",
-		"0x6652FC74f75a4B04D8CAf74658AfA5c96dBc507a",
-		"0x18dd45896Aa1B5c09E29D65060b44493C985B8c6",
-		"0x2029F4d96E980aC4f5841Ae38B17e3Feb0D75e66",
-		"0x590c44fAc89307fa05748F50bE82278666861101",
-		"0x2AD2C5314028897AEcfCF37FD923c079BeEb2C56",
-		"0x1E367615E21cCf15a26B693a579FfcdF0B70ebf9",
-		"0xf23a3F21072a619ab9275aE827950D1711041a09",
-		"0x96a1Dee27d4981D98FF9eC95e7f6d45b373118B0",
-		"0x9d2DD0E6437ede2D2eEe443258d308Ed3178Aa0b",
-		"0xB9a955C0b76d63e650EA5Df73060c3008ac36E9f",
-		"0xECD2f798A80641d14F4f8A123E5f65eE726968c5",
-		"0x0Dd8418c4A7715C8b7173e3Ca97A5523e659f60c",
-		"0xEf18Ce74E960877673ACFf30184Ef6638e2d3041",
-		"0x5B406e0C8ac60e2923a5DC4400977df1301a3DFF",
-		"0xBC225699c4899CF85894902CD947BDc02b42d610",
-		"0xCcF714b2109aae9A6D1c8B1d1Dbf6b81f8C003B0",
-		"0xCF816F008948775A460d2c5b6FFD79760f5227c3",
-		"0x99feb11E3A119E0F6a4769a619efD3Da84Bac290",
-		"0x7ef88691051c7F828E41fFda824B6ebd788fe569",
-		"0x7f1F846bc6B252bDEE65f61491A879f0AD7ee926",
-		"0xb05a663fF3DD66781A6bbFCC6b77297858cB0d00",
-		"0xf92A29309805fbBb1D4AE2A97a3D9539f461C2Fa",
-		"0x5AC47dB87272EB158f1A69f00BAD9Bf8D311E5E6",
-		"0xEF3A73b639719fDBe775411b41683E0aBee17025",
-		"0xA39e0E95d80C3f570C4E4ca96e3934eeCe9BFfd8",
-		"0xb709D4b823eE0b942d5d29eeBb4756962fE39783",
-		"0x6022Ff1654247f4e715254be00daa49f163b8D00",
-		"0x61a797F2F7A43a77F311014E8BB37169f8f3661b",
-		"0x82d45a1cCaBE624eEB275B9d3DAA177aFf82953f",
-		"0x86Ea80CD4812aBDAD9f7Acbc449130098BE6FDdc",
-		"0x6d0C04fd579B98789613523c29251dAccBd0608D",
-		"0xd8c327F3aeE4CFA715bCA0Ca93CF3452eE37eb94",
-		"0xd1F1B7b2A0940c63437C0829f0AE8A23c8B1051A",
-		"0xD72956bf70DD168Aa8709878f8cfd2486b3B078E",
-		"0x1380BF99ba8d0fEA3A82d0e28A7D10F430239190",
-		"0xe8d2c4ca811e0445B83326aBe85B7c40C66759eD",
-		"0x3041B4FDF86006B0A87415A93929e8F617F0DAE7",
-		"0xE85C6ab56A3422E7bAfd71e81Eb7d0f290646078",
-		"0x61D04f556F1B5EBe38548cBD27FC9BDcf87Bad44",
-		"0x77Bac7585094307023f31fF5EE1BCe6e75917c09",
-		"0x0DaA6EfE21202efcec018fd7eCB392534c8e4869",
-		"0xFA1578836cbC710201c3A01C2d8AD702b206a552",
-		"0x0FE9ce480a7a8354F648215C98FC75cFDA5A43cd",
-		"0x20b2c5921878778174F7E50dd222c639a457D9d7",
-		"0xc500cFe651A1e8173CD7Ddba7023702F6DB0b943",
-		"0x2fB9Cee9d580cD12E671b8789F2f9fb228A5d2bc",
-		"0xeCBF96Dd4fBfD666A849252EC022Bf311A4cA002",
-		"0xbF9Ec925F3A9719b9cD99505851865ac0B0f61fe",
-		"0x73C856308aB5c9587AD8cc8Dc0Ee3B8900f00F42",
-		"0x65b36187b3Fa00Fe7f9425a03a30fb878182b1A2",
-		"0x7a8B6DB53F50C00c7DCe560ed4f7445c7d6058C4",
-		"0x9514Dda5974a2dD0Fb27e3Ee0812C9CD1DE627Ad",
-		"0x8fd8879657C4f530F0f84436af3999C560A2168B",
-		"0x08324a22a63e515Fd98A3d77120Da8b193254D00",
-		"0x63235bA3b1C8b8A3Cb9C1dc204abF40A18D023E0",
-		"0xCc24Fd9232bb221c2155D77EAbF4809568e529a9",
-		"0xBaa8cbd0F6D6D37194AAe25e774A05aC9059a950",
-		"0x46bCC350aF916a10C2065E6E616e01B6b7770833",
-		"0x2F69a4002E07de9099bF98E103C317094067A85C",
-		"0xC92a07dA45f48E898894351C9D2faAf50087C0F9",
-		"0x31bc4C4fC335895082ae9f7a78f3bF58f5CBB2ec",
-		"0x631Bc9fB67E5E339AF6d11Db18f0D32861A85A1F",
-		"0x253fE2C76728C98FC18Bb52e9A0bA8522be15aa5",
-		"0x50226FD760194aA5f605e66D3F9ac2FA5DE9DFfF",
-		"0x4Ec82EcD19529ff50a467F522029f69459bf071d",
-		"0xb3Bb34973f9285903ddc4cCDd41e9B3c339E207a",
-		"0xC2F15C487D808982CD0e86DCBbaE6386589123B6",
-		"0xd23eC2a1b3EbaADe3cc176679C6BeeC171489F09",
-		"0xc70d783b98AD5B5F9a3bBd810da94Cf60a4e5ACB",
-		"0x149129Ad6dB2B2EB91bf8E74497112E05fD57eb5",
-		"0x09d58DFb5b73e3db831FA729094cd3852284C8e3",
-		"0x3aB7174c3352321fC4EeF34EDB007897d7Ca2052",
-		"0xfd4Ca5b37B6085cDE0FAcC579F5520CDe00Bb4E5",
-		"0x0bf7ca461041E0843161F032696CE9AA2dCd31D6",
-		"0x2Ef7111D31B3e2Fd3b4C02d8276CD3c408dcDB88",
-		"0xF243b0F47BbAab11F48b08939D9c3F27c99884cf",
-		"0x1D925349E8dAEcac700bE311Aa48d29839007f85",
-		"0x325ee48a8A126d9758acDfe8789F33342e848022",
-		"0xCdF9A52DBEbdcE0d3Fdc70d28fE4a226a51330Fa",
-		"0xE00e373eE195fe0fd511F2Aa923a70125952DF43",
-		"0xBcEe9cFE1F7650a6d94E486D99eD2f8A693a2A9b",
-		"0xd1903C4F046dc843389a72917cAac2D528CFd70c",
-		"0x69eeaF9CA8733D2400d78562B16A7E660A8cD973",
-		"0x867254aF06801C21C5812690a5c3FAd82537C6Dc",
-		"0x8e27B628459c34D092bc459A9e0a4B202e8cB81f",
-		"0x14613D716E3E33A44F09536DD795Cc0A8FC95E2D",
-		"0xD00F03068897323946B4d52662a38343Ece6d553",
-		"0x7816fd8C7e304a5eFc0eA6D7096343094B9c9Fcb",
-		"0xd6a0D770280301d3FebBa271bB0da1cbb2dAf633",
-		"0x5bCE50ee907249bAeBa2Fb245a38e8eDA4dEec7c",
-		"0x83F0775A151aa8C3769BB53337d2Cf3a7708baB9",
-		"0x2c90E3c79Cc3A740eB817CCF235B506A3436cCAF",
-		"0x43613DEcCa301c375217eCE862CF72664837bBa6",
-		"0x20DAcf489e846B127Bd6829A88b970D9DB83fA1F",
-		"0x8eC13e2Bccc982e4C954368d25D6fd67C9d280FF",
-		"0x76AaDDf607049Ad32d655f31962a2A55B1fea235",
-		"0x6771700057088C1fC8a537c32d65a842736f9C5C",
-		"0x55481ec930822643737A4Ca34F312C9AA8eE1897",
-		"0x2A0541b0653bb228c333710880eb9780041Af291",
-		"0x62Eb0b7e42Bc377F0Eb1e03556A6192F532408e0",
-		"0xf56f2e7ef26807a8D5f33BEF036397aAd1C56d49",
-		"0x4918A09934b4c706013F32F8C5F6A683BE956edC",
-		"0xCCc09D2ff5a6171E6626C01e31d2c1917F824Eb3",
-		"0x8A7668c4Ac5ddFcB2985631138736E655324325D",
-		"0x29199b321F36002f51ee2dfc62e3634E378Ac9DB",
-		"0xa69c80CF36079C29bE297Bd2B52A300307F316Eb",
-		"0x628B3551EB612C3B2A26e72EC19D31080E893817",
-		"0x2E740aF61c22fE7cc1523b5ca952b59c92c261F8",
-		"0x645Df2783c86ba63620D5A6f555940EeB0bAb9D0",
-		"0xB48c9E6DdF2449E048379ca09d075375e536d8A4",
-		"0x41Db6610cB78E8F977D48379b57FD83C8457ec43",
-		"0x3F84Cc219A39F98c5994D61724644C6B2d40D7a1",
-		"0xf4fa3BCBF93F2D14C3725a823ff9932D470c3172",
-		"0xc81C7CfD81C5632cC8A31723405aC5B05dc10044",
-		"0x73a37E83A8da45270854148204bcC51417dB8D51",
-		"0xdbCe7C03CB836FAaeF2f07D4f7B94F2E8eb18be3",
-		"0x0c8f65cA17fC3F01B8bE973f26aCd0463A034A99",
-		"0x72326299771BCCc6854f97CcAd54f96691dA1F66",
-		"0x2C9e263A95F5edB2ed288A0E3B546c1391b7ccBf",
-		"0xDc4BEA4F2B5a0acc4F2719500D25BBEC149F3142",
-		"0x22F44CE785fb862A6E64bF46978084933249661a",
-		"0xaC190De7cfa16b1EB6E1e4654A37CAe082196CbA",
-		"0x784BD4aFC33e2876AA2424061fC216f348fE77aF",
-		"0xB1faF69b541Af02A809731Cc3f9d6dc971C636E2",
-		"0xaD49270934356393aD5410C605A37F875f3bEc58",
-		"0x5fd512C2BbA0802f36334Cd334167B0bfa7C3DC5",
-		"0x55fDFfb3d10fF9930929433a5eB0A4473167C6B4",
-		"0x20A68da8869B92f1fc78474dac89A0E3928449f2",
-		"0xFb3528bEd493c578C53229ddec14948c1644C077",
-		"0xb6C866aF31D06F275CF15CB8387CE8f3E507b149",
-		"0xE99e67346741038a771bCb7B92837E775B814d08",
-		"0x2fED6833A86f764bDB84842Be0823A0B79d3c7AA",
-		"0x9aA3Fdb88bB9b06af6F9FF9c9c71c703776Cf608",
-		"0xd94ae75B1282B54ece322f63F5804D49F09a0b27",
-		"0x056dDEcfdBf6DDbb59BDEF4fDF2986B861Ea411c",
-		"0xE9C7650b97712C0Ec958FF270FBF4189fB99C071",
-		"0xD5678Fe4C0a991e48719B0Eb96A1466C396Aa9D7",
-		"0x0F2E86E751F44a42bAbB30125d72e47D37cB9DEc",
-		"0x3782cC428BDb10b259E858424a9fdAdB879638b2",
-		"0x8a1bFeE3cfD21bebd5b20c3B625dbd792513395D",
-		"0x92F65Be3f5517db9a41B31d600eb969E5E05C887",
-		"0x3c629cD0cAE2Ad740b88048fCb50605ad60E7414",
-		"0x650Fd1F5d732927fc882a87132d6C0A513E29d45",
-		"0x14aC72943F45E63B2492a838b59A0d96dFEA59EB",
-		"0xf92837B9357cdd3AAD0adE2A29eb925256b277F5",
-		"0xBFb0DE9023Dfc401B169C24D12Cc573500411a81",
-		"0x5Bc0d6fe54B2960cD94C99Aeb77b0A86D9DEC246",
-		"0x36a1847cdA738E3EAE6808d8AB92dC3dB5093e87",
-		"0x3d2008F3414e0ef60a5fD1be35bA3797E5432Ec1",
-		"0x7AcE54Baa6e841797f1E85d0170483775BE47Aea",
-		"0xB285f54dd967b5a5dd2B44bbe94dc8702b03389A",
-		"0x61355c3a231680e76773b117CC3A03FEdCE1840a",
-		"0x9D2E12FE40f1beF91546f44aad4Faba448D2adC6",
-		"0x64D2D8720F5986fE1cc7A474aa38176A85314D0C",
-		"0xf45CfAECBb7a413E776D79610EE9CBaB0265ebA5",
-		"0x105CB2bc1247b0C612240433005B130011A2F068",
-		"0xAE8b95aC6b77e303882b70211dda778c72762423",
-		"0xdC733d5bE0D212A67dc937cDb7F2AA2D049060ec",
-		"0x8d226eCB2De9624E87a4249883b2ac9f0Af895a7",
-		"0x7B51D0D4D3a312398736139053f9DED155097292",
-		"0x3e38c5c79FefEDa1018a50A4CE3BBCC14bb6CaDB",
-		"0x9f39313d5bFb9b8524F771E9A6fC48287251015A",
-		"0x6a5310cf12368c7EdeD3c1B72229528194E6B513",
-		"0x6891ea76B982d6F183F86BAE0Ec2CCCe380000dD",
-		"0xe0463D690533E788F7baf2f9be2e7179cD373F96",
-		"0x8A17ab916BD5448358DeE9882B9fEaabE3a4C3b7",
-		"0xDeC3F5A42D8948dEc1d5AFfa2E23426B5D970ce3",
-		"0xF4c70F9791491aEe6bD50fb6d35D3C1d222D39FC",
-		"0x01b217c65F66B80CEF994038B9A1981E9245803F",
-		"0x7213657cd360f8025136237a429A8Ad98311659C",
-		"0x2C3edCB333D9E56263BfdaA685894C481Edb9ccc",
-		"0xEa96E239ffE26196E89f71C87C3A4EFa420A494a",
-		"0xE2276672C1F2E190076202af3486a4260F888535",
-		"0xA73794c8e9D05E2Cf5C3B5869A1c2521ABb8a8F3",
-		"0x29aA51FC9D1C80D317556cf406cD00CF52a81563",
-		"0x2638846Fcfa6d023Eb24966222290c7E0E83E22B",
-		"0x134265c8358B9764C39A1eEA97926D3B88134bf9",
-		"0xb0c4371094e206FB7db115c9CC180985561bF6Cb",
-		"0xA208B29F59c853B5a048d095C9eDEB04B4173F63",
-		"0xB0EeB0632bAB15F120735e5838908378936bd484",
-		"0x79787892C42b937FCBa404464C0a97387c969582",
-		"0x7EB7277297888F9e66Ab4BCB4308BEd4740bD2C7",
-		"0x8e7dD57D65cF2Dcc694b944B65359f75a0Ec95F9",
-		"0xBB8004fAD7760449b871eF6F63596052afE58f1c",
-		"0x0f2fAbe455FA071A8e48241Fac8b9f20DBe2c955",
-		"0x4765A8b6DB539eF7D790b04e798ebAFF37006296",
-		"0xa77A70360BA11B4AE92A40e5002387e6C7629D2e",
-		"0xBFd2d12FF4bf0F213c00c4544101acd8552D9b83",
-		"0x07Ae137c9d96EDb0A27b74Cf20B99a4b58009C3F",
-		"0x6F68AeeA831BB78920005a34EA5ca293AaC0e1ad",
-		"0x80485456e9F61Fd3ef1a0c9adEFE88Ae4A520993",
-		"0xfEA83Bf0a3a9DDd4496A8A720C8a16e552B68B7e",
-		"0x6B4b93F17D7B6DfFdAC6a95BeC651FeC9A7EEa1d",
-		"0xD2d8b89B9F21077302a6e721da47bE4217cc8656",
-		"0xC1ad62bb82582520169b6411565966B76f038435",
-		"0xAAC81c0c950058256cf3D8ab7A7eedCDBdd18677",
-		"0x05E61E0cDcD2170a76F9568a110CEe3AFdD6c46f",
-		"0x9de7c5E05d87D20cC9798C83844dd9813EC74FDF",
-		"0x10C22079c1D0388056e54Be5bf6751D9320F54D1",
-		"0x41a2b7c209729890D851F4e083279146F09ae0A5",
-		"0x0384E9ad329396C3A6A401243Ca71633B2bC4333",
-		"0x0C8094a69e8e44404371676f07B2C32923B5699c",
-		"0x7A4F1e5207bAe6FC0bBb3e43EC3523ACB07a17a5",
-		"0x69420Ee3FFB06ec4e6b4C68fBf9f67EFe6dD3bB6",
-		"0x28DFDb6FEdD8dF4C891911D2EcCAe1613917B612",
-		"0x386ce438f306e4017eA44D0cdfF6E3d5f2bF5a29",
-		"0x1d0Bd8F7DB559E8413A3b3D358F705fdF663E8C4",
-		"0x918E26b057A2B2B5DEC0B2c4b59805E7C866FAEB",
-		"0x7182083579e211843AF4713A494f67cE823f90D4",
-		"0x0dC614eCb00Fe2f6312cd1736e61a04969a4fe7B",
-		"0x8e1363F43b36b969697a4B7cd2348Fc4bD420B83",
-		"0x07cf74ecE85c4f8eCcd27bDA92Bc7E2a304E8170",
-		"0x9f44BfB8518a8C836e9D6b57256A65C90457E0dc",
-		"0x2c66145dE134F76F31bb84C73269C994008c6159",
-		"0x1a547B5CE91ba3B65305E7979A12C8BC8A3D4962",
-		"0x13db5665586D9d620b0372e89D4Df84C538eDC4c",
-		"0x941bA036A61fCD2Eea801dDdFB8359326e62cc2f",
-		"0x3EA74026BCee5F95c9Fc704b54921da3cf0375b5",
-		"0x65740A0d0aa3C6556aFd52DE9aEf4fb38838EDfc",
-		"0x3dA6ee8e095280AE0C6929A8De1EDAe01610D72b",
-		"0x296B7C203E2C7306b132F2D5aD66106Bb7665C46",
-		"0x932D15B8Ef9ecEFD3872AA04F44c92F0d0C913A1",
-		"0x09a695EbD2C6617F19bdF3d2e86dC4FC1031c4F5",
-		"0x11aa331Ab179cAC454CeF57ced4bA6BF66441D81",
-		"0xa3D0e1108c7b4d7496c8a455f05cDc981343ce48",
-		"0x3D878681703B3aA0641346B700803841da3a5FA2",
-		"0x47b604dCE4Af28Ede480EC795fbc1DAF3e4cF731",
-		"0x093533c07518c68255C4bFAf0B07093e50cCE305",
-		"0x15844Bf571615367cc43B02943B474a74eDD062f",
-		"0x825b0036882E0a1e62D66ab6AA87660e693cA01a",
-		"0x1D107ADA7F66BC8b95DFDA13547ADff8B17bB073",
-		"0x3aEEc33b35380EA80600dA1CbDEC4F14C54C9d4e",
-		"0x766B6F79F4fBBc1959202D12445eC2dA0DB85098",
-		"0xc2c8c8fAE89Ec189545C4Ce4039Ecc2538A8a5fD",
-		"0x3926af7bf52ab487e70B24F830a41388B5EcE286",
-		"0x4F1425c0185BB00D6788C751be8F26E6ce31b04c",
-		"0xb88b26FAFa051D30D3Bc9AF0971f60d32b82ABd7",
-		"0xe616e167C9011D2f2555E622a2bf28F0Ccf4c824",
-		"0x69f5dA3fE08Dec8D457f49e5126FC5Aa88d439e5",
-		"0x5628F2eB82E9B2C25Ff678c94293398731d01424",
-		"0x26FdAe9456e97B83a2054ddFDD751d912063B781",
-		"0xA4f9009292C6517dc801735913B0554A488dA9d5",
-		"0x3aC66471258aA543D73ed3D1087048F23566Ef6F",
-		"0x23fF8650EFA5965723acaAecA1378e94EBaAA7f7",
-		"0xd74F0854BB5266CDE54A9d22bfce6e42005A3dA9",
-		"0x98Ee246E3aCb2eBA5F5Cc4AF768f4ae989Af3C3e",
-		"0xF89dA6CdF437E4E4897dEA867Fa7c633d3D70D63",
-		"0xa81D569f7EdDc432FE86808B809d0AfCaE670ee3",
-		"0x6d4fe1f4dC41378aef9DF5C4DB27138D81AdFB46",
-		"0x7f181FB4b44C9b0E03713333E0cb7f67862Edba3",
-		"0x5B0822659415DAf6ffEc747375edf751AB39E732",
-		"0x10B47Be1C02117D48C38fCDDf7AB332Ee588CC16",
-		"0x6DfeAB52d413E2d16BE84c8d801FBeb2F0DC5Dc4",
-		"0x347f47756bBd579f0589B414cCCDc3857895b4D4",
-		"0x3F853AB27207e4a90cA10BC5f5a6d1c3340F8548",
-		"0x0BF994bB5663cf42888295E983841BFB66dC6921",
-		"0x2724B9497b2cF3325C6BE3ea430b3cec34B5Ef2d",
-		"0x3260cEcfa15faa662D8Ba5D15DDAa7EC6040B6Eb",
-		"0x0b8A75Cb0BC2De7060aC1Ac900C3D9AEACd69A66",
-		"0x519d743CE097734f07CF75FccC82280f8c528B2E",
-		"0x688cf97De4D3d01AE8C329C7500A238B77F3f8A7",
-		"0x038D51Ae282C2c16d8bAe1908f468bc919f2eb1b",
-		"0x33DCfcD192a21D8922C4FDb2AC81f0eFB067d964",
-		"0x7382Cf956f5C2E8039C3b08729e452df26FA26B4",
-		"0x7529740ECa172707D8edBCcdD2Cba3d140ACBd85",
-		"0x3Ee92C4061CCd4f5bce21C9918C10e4591FDed44",
-		"0x835EEF9aBCA0fE1263bb5a71310f7DABF5685152",
-		"0xe215E1384646F1D00bD5F64Ec1CDAbc956ed3c79",
-		"0xFb94080F0e02e6413c5f1a858ACC5e0368E566A7",
-		"0x2C9D834F0391219aB3aCaB08206CCC8b0c225Ca9",
-		"0xd13a5c1c217A67464E7C58AF02F2Dc97Bd611F7b",
-		"0x3109245b302E4252cDA86B1D532eB9d96bd75C2C",
-		"0xDa9369C378e1607B53933dAbcFe131700A0fc30b",
-		"0x248B65F9aF5E0c6075139a849CF56dFfd187C635",
-		"0xeD8562b9421EdB330ffC46Be88ECeECbb29a5C06",
-		"0x526816e1c755Ed07B0455B3e6cbcB5B9eA4C5373",
-		"0xA8C17ac16F3aF4d0D12131ACe0C0e7E262B6E026",
-		"0xB212fa6Ae9a8e1c7B060f73DFFe2debF443d0ADF",
-		"0x102B6f9A6C03CCCCdA33f143F3C261157E24B97F",
-		"0x24DB2fe3CAA83EFB89E28c48AFd5197992E1f4dB",
-		"0xD376F030CAdBC234389A4ba4f5CA1145a22A84F9",
-		"0x517a1dF27a942ebDa34E6885132d6C119D7087fA",
-		"0x2B411365f854C9ECD96aD98aeA9D2818E104873D",
-		"0xE0a334eb3486a26d715E72940211626E64c8AEf8",
-		"0x86768A769505F40E3DDa52a72622f3FDEeBF864c",
-		"0xe214393a371d999a3b87b698887783447c1002C7",
-		"0x1A030A243e228D1072B1029D239893f13650C2a5",
-		"0x8e500d2d8877F6a60eeD95BEB22C149CcCa6F142",
-		"0x98c488ad6aDF8367eC69d2Cc6D378b5b66D6DDe4",
-		"0xf914f5555cF9482a9A96e722817AA722D26eeA9D",
-		"0x291B595b83d503BC022601805Ad6F670a60467f8",
-		"0x132F8a3d58b10303Ae2b2cbde4340d18992afE02",
-		"0xBCBd375C355C1d1E76b0a923Fa1C0B1545818108",
-		"0x5c2E80Ab34D0e7Bc72802a743677068C7C0D4701",
-		"0x02722dAbEe40fb1B4AeC5F5c4628925c6c421Fa4",
-		"0x215AAffAEE271eC8f688551e35b7E0d074D8f248",
-		"0xdCD6bcAbcAbe94eB074Cc5ce48Dc3Fb6048D1C91",
-		"0xAc8e62a34c8C801A4EDE126dD4ef710a220b62CE",
-		"0x96FD300e3E4DAb1fD9EBDb47274Fd10D67B50B57",
-		"0xd76C65d26d8c32e4F1A60141841378F8D2901E31",
-		"0x4262a3f276feCF9Ef57DfF462e04F7fdf6BC2799",
-		"0xDF13Edb518a0835f026D0464b280526AE9aF9A3b",
-		"0x82E801e701BC8B3C0c0b43CA1Cc2DBf8B1655Bbc",
-		"0x1882d3268399B2072d2d8E089A3375704928fDC9",
-		"0x8ae601B1B4827aEC52eD670ea642D22174DC2Cb3",
-		"0x5c7BB3264aa259cDE38EabEeB14aeE6972b90A78",
-		"0xF9ADa13EC9Af82152ae103CB9bDe022642B06068",
-		"0x63D9682De625AaA9D9881D5560C5a79D29D4E98e",
-		"0xb700D1c43f88D628c05De1a5C5F5336f2303F2C7",
-		"0x8fe6719fF48E5F87317538E64908F5252E7c58EE",
-		"0x1DcFc4B17b7691f0e2A847A8aEdC63913Cb929ad",
-		"0x463e785Fd05479196611a48051a3ae68dE7a2246",
-		"0x0b608573005BaD5032fefb6CE4d4C1BfBAD7174A",
-		"0xFE7E3Ff4D50Fe841B04f2eeDa4C75180C315eaaF",
-		"0xEC441e6Fa373349566Ae9c63A314d46ad7EF43d3",
-		"0xeBa612DE10A79458830c8959728c5a5568668a43",
-		"0xA4D550A26dD42B4c74FD075E91A155CB697cEac8",
-		"0xe4ec4936f6A47dD6679593Dd454A453d5e18E5F4",
-		"0xA648EEEA3cbCDcE305ad072F4b640ce1bd4D0479",
-		"0x5dFD31F6F77d006e229c7865E5E96E726f6Ae345",
-		"0xFBCdCf9AB328Fb8545320f80ddcCf2c33823a15C",
-		"0xD72890018b09e8383dAad20f3B505E5cefE3B93A",
-		"0x8f45e92Bb7d7b19E54f39Ad014d607a164Eab5e4",
-		"0x614B2aF13E315A1A6B301DE2fcba0Ab9C0b80a54",
-		"0x392C148b1c5f276a2867B79d34648cf951468aAC",
-		"0xBE755A2b301302FA63B5646cCa9665B60eE88aa9",
-		"0xB2131373d634e7B55C957dD6179C74d8c4EcEE6f",
-		"0x01c23419F83a58b49DDDB010fC5a87B1658088aD",
-		"0xb2fDD9a62dB921E5C3217E766E81f6E456BdF1f8",
-		"0xB33fb982aEB6ddbC0A0DC2Cad171CA8D2Bd475d7",
-		"0x45366b9e957C0E32D66B0A8E3022938bDd050482",
-		"0xeE21a7021F0E6fCFa19689CC2121B6CF04a13c18",
-		"0x832A621ebf4Ab926ed9b3a1C91E6ff13f5a2Df5c",
-		"0x4C65cdD4a47cf8250153fC372378B2FeBd737F09",
-		"0x7dc42ce46575206FAeb094D392b3265EF40E308c",
-		"0x60Cf205F009878f1819A58d00ceff5Fda37202a2",
-		"0xED7222948b1b62cA833e081f5C6BD7DD53293A39",
-		"0x958F0583B2155e8E7d7F31884fCe3693EF2BC5F7",
-		"0x8A3cFFDf66a4B9Bc1949a39b4F3CfDf62007ea9B",
-		"0x51aC5f3177809AdC3900AacdA14DE4BC0987Bc2E",
-		"0x29034ccc743D65B0701FF614DFdE631902ab8EF4",
-		"0x6023d73d7e4e13052d8D731c6A6710bf62660E3E",
-		"0xba57ab1897521f548FBf009FA1F60e43b8a4d3AB",
-		"0x069A306A638ac9d3a68a6BD8BE898774C073DCb3",
-		"0x3f815e7d299f08278c0308aE1048aa45ED12415f",
-		"0x914678d778F9255bAa3F94439C8d5DF38aB03a65",
-		"0x5455EE33B5422e8c90845A3fBdfa2D3b35bBe1c0",
-		"0x60B9cA461C2028921cbDA51605BaE941f66175cE",
-		"0xe1afADeF8910113969e36D95788Edd3181C41C60",
-		"0x9214ED167560A6B3d8A0cB610Cf0c10390d101B5",
-		"0x9057158B7A2544d2D83088292893CDF5490Ade6B",
-		"0xDED76BBBAAE46729AdCF695be8faCf223F5CF9D6",
-		"0x6c0E5aED5ed5dcaF2c863cDd92FDBEA75a1720B1",
-		"0x5c07966eDFe8D51185dC1e49b04DB3AFe025C857",
-		"0x31BDFf048974d4E55034ab0C1D3bAD706EE6EB20",
-		"0xaD24179cC754501F9972875896cA7555eeC748a5",
-		"0xB4148bcba279296c4cddd82F43Be86B18280eB57",
-		"0x95C2D32fDb32EDA8ce7fbF6539Dd6945acb9bB09",
-		"0x8aD74d3D6F5C01963d6ED4587AB092cE1F19d6e1",
-		"0xE97905A47682c15A9D985338e90110714d06090F",
-		"0x703c91126e5De3600121517165b1f7980aD95f6C",
-		"0x3204d1CFe6fD0b0626b28650272a829921E90f0E",
-		"0xB79546f48607BF793569388Ead31CC2CC7450F6B",
-		"0x3bcA8c47729620d36011c340970860c14aCB58B9",
-		"0xE7D9C5d8a46099478Ee6d2dA817448e054522643",
-		"0x48Bb5f07e78f32Ac7039366533D620C72c389797",
-		"0x8e4A9a3382754eFF95f270730e3C078496f3b84C",
-		"0x82FF829bE713025c0cD6375e24940d1d55b3d7f6",
-		"0x0281BC1125751Cb28614320d48fC99B04ec4c79C",
-		"0x5D2ba24DC39e07B325b5A5F63053cB7549D6e85E",
-		"0xCbDf4cb386b42C1ab41F8B5009cb3D87F6Dc623e",
-		"0xd00BC8A9721a8f47340619d29c22ABc35922b40d",
-		"0x4DE643340a96C0675c0E04F66E50fd6652F49FDD",
-		"0xDd23eEA167f58d881E38F88cC4786B99e1A8ACE3",
-		"0x66Ee8e2291CDAe6F37DdEb4118f5f59c9e2707E2",
-		"0x58B6Ca7c51Aa5661F14Bd29af3e6C4E12B1cD4AB",
-		"0x0790Ab5D856c247caAB266bE64eF7659C98EB2C0",
-		"0x56F6Ca0a3364Fa3aC9F0E8E9858b2966CdF39d03",
-		"0x78eb504454Eb18f23f6D8257D0E9fD360948af0B",
-		"0x72c2271252628738da154AccC9564A8d6bBE81e5",
-		"0xEcC5e08237AF4d1b54ecf7dEDaEA92E27FE2e20B",
-		"0x31a9b81A395863345360c00D4B8867866b976406",
-		"0x5F229db53aAD870BE897c21AE5c2d04B9ABB8f98",
-		"0x757371D111890385877769190d1bC8752b71033D",
-		"0x9112b207ED0084e1389AC9C3f53C67906EC8E9Ad",
-		"0xEc7eC1a8842F7368701f30BEEE6E0FF3dB1c2F42",
-		"0x9CDe2d631dbEA21AE9C75C0c6cb58E3580EF50eE",
-		"0x4DCa8E42634abdE1925ebB7f82AC29Ea00d34bA2",
-		"0x0c72fb12509A05d86d04A268278858bc1e1B9793",
-		"0x0a0DFC239D545463F7fDac59202F28B7044cc3F5",
-		"0x40434D4e0f2bB84F1f068B46632aa7dC25fAb543",
-		"0xf4f796aA2FCc421fE785e0E5eD29b08d0a0E7Cf2",
-		"0xDc18b6769E56EC309a567f84601f2D7d9E95f3cd",
-		"0x210e1E6eB6A92dEDAbe91d6E00bF53387d4E877d",
-		"0xB91A084129C3Fe13d4630a2B6DA095494F360149",
-		"0x54BBe512f7545dd678815d12b5033967Ba4226d0",
-		"0xE9470148ca2C9CFF14B965e2B7Ec0Fc8Fa7138b6",
-		"0x1d5535aa678E91b663f1779aE12D14FC3D6aBA0d",
-		"0x5E04550Cc139d0Fa845f12606959A895749b5B1d",
-		"0xDAA31d87E1fC1C59b3d28dc75fe11cCddF3B9f7e",
-		"0x0f0B9Fb0a24D993DF4b0508909A39E6b4B75A25e",
-		"0xfD645b7a99ef7adF1096140206489362F916eB1f",
-		"0xFF43Ab0e317b18e208a6ecE0520D5D6558DD3eb5",
-		"0x3B42b4Cb52739F4daa24f4f34c4f631e56B20f28",
-		"0xFaAD77090Eff934Cd8Da39Ea8509eE4DC4357362",
-		"0x234FB262dd478bb4eced72FB2f6A10Fc852D12E5",
-		"0x4Fa9F0caC6Bc7d4574AD03827fba8c9cdeda5aE3",
-		"0xAEc9abfdAC8AaF2b52E57bC08101C72Bfcbb1E29",
-		"0x60a5fDD633AF768b7E4B8308B463E956aca1ff7B",
-		"0xB9e0F573fb4041f21506Aa5Eab66DF4B0fE598C2",
-		"0xad02320a81606fbB760C32e065495A8ddbf322A8",
-		"0x87674bBDed56d8749E49B00413a621831a0Fb266",
-		"0x96948447D1521260c24fCdE281d09364BdC5A2d0",
-		"0x02260935f76D70F4e6eabcf3aa428478b97eB1E7",
-		"0xE5F7337e4f769386F008294381f85CaF37E06A99",
-		"0x7ed0F734e5eBe3112d62fFb3D669222de5Bf08B3",
-		"0x41eacEB96C38630BD9044C61168fe3BA239dbD02",
-		"0xB8Fc7E0aE1D38f40F38590925104Eb2Cc816E60E",
-		"0x5098328EAD41F41614468Aa5e6dd57e67aC4304D",
-		"0xb5836112e03e7054898E1A0B4Bd1982EFBE4e465",
-		"0x56B0FFA836d669E144c37a4aFbB7A52119ef937c",
-		"0x77770571EDb8158e04E71ca211455a677C3862A4",
-		"0xD8dDb2C3A57E9b74cc4e97958a53eEd39Cb4f659",
-		"0x1228613B3687246207AB49c8EcdfBCA922357468",
-		"0xc606654A23fd18661Df7aF1F8485879885F41dba",
-		"0x1B6C9c20693afDE803B27F8782156c0f892ABC2d",
-		"0xcBFe9C7415a06aC536a157ce6250C09aB4037C43",
-		"0xFcA7145C0af3f35B705204412E8dd793C5977451",
-		"0x30d70E3c613C50DA8030556aa949fbc21Fd51454",
-		"0x4D3D0e721C66Db32ac98E377bfF2c46D0C7bE36E",
-		"0xAE960363E6dAEc1Aa9fB26C6dFa99e307686080D",
-		"0xE5C7565B45C5109515b4dEE70330Be40d2D198fB",
-		"0x5A83B77BBCF2927d762849843a6Fc295602198E1",
-		"0xF45BaEd2Cb0d1d54E5C0Ba6530d9CB7AE78A262e",
-		"0xCaB22CB5b72A51967E16d1D4C8aF80F7fb6d9320",
-		"0x4052352c41d981521D554984f8bE423e50c7E958",
-		"0xED67bd930ac357d52576A39A75959b7194db652b",
-		"0x8cF4B90133F882933519d6915dcb95Ae7e3589a9",
-		"0xD9164FBa311918778f9d8a95212677A45d07e29b",
-		"0x8B3FE1E45F39484C402b0D43769B45752731eC98",
-		"0x64DE5e1B9aD06D6B007804e729Fe0f0072FB67fc",
-		"0x175663Ca84D391eD044577946652b24D641FBF7B",
-		"0xD6350F289732d418121554fE12EBc178b54bd6C3",
-		"0x7D6b75BB36f2dF70cC1a45d07f57f3fee67d0C76",
-		"0x512d959cA4839694f53794F4c9764F42551a6eD5",
-		"0x4962cf4a87a031be0f7A4C6b08E86658Db0f27f2",
-		"0x3Cb93c5fa14B16AE6E8B67fCd7b1d2471F10677e",
-		"0x48015b9C9856A24c60853bB8555034D901e5C58c",
-		"0x66F2153DF04CeAA8c53CB327a7cEDe936Db5AEF5",
-		"0x4A52F57FA495286f804708d60F4a92C03D05E03E",
-		"0xD38542ae5888E36440b995618D23C01a61eE5B5C",
-		"0xa9110c338DF2C79f339A9E97895e68181cF2Df4e",
-		"0xE54cc7C256Ff6ef87c4E37280d7a4F6Ee877bAc7",
-		"0x48Db4e596a25Ea18adB8cBC4AAc37E99eBD7f32f",
-		"0x01cF63224509A8bF1F6C50e0dc493286BCA70fff",
-		"0x02B91F47fda6ae012C119006e5e7a1A88b025BB1",
-		"0x66C9c1D2B620332Ee914374842ba98Bfe26FAdD8",
-		"0xE2cB50C226EbaDb5391a501CF84CA84C63954482",
-		"0x95F0b38c3837AcDE6a31466EF883EC3491E052F8",
-		"0xD654953D746f0b114d1F85332Dc43446ac79413d",
-		"0x7aC0A043d625FEC9036F5a48B22ba28dcC1DBf32",
-		"0x4279AF04121F948398FA0A2204e75D86d5AaCCc6",
-		"0xCeC960941Bcc3961aab78Ac0b31dc22ca1c17f81",
-		"0x27158e026DC046239b6C19aecaca9A65231Cfbb9",
-		"0x367844faD5aAB8377d980d65495E54Dd63c9591e",
-		"0xF2076EF42334000910bd2026a489623127988EC6",
-		"0x5efe438ce5334294FbEC51fD0F5ce69bE152B922",
-		"0x3BB3097f05a1Ef30EE52a172896cE3a05a875743",
-		"0xaC6953E8ee8C8032009853931a248761adc70f0D",
-		"0x305aef05247761e57327DEc5a0c2711eC16FB713",
-		"0x835827B9f800DF4a2E5A1851D8DB40964b1984cB",
-		"0x8B2Cd6Ed5aC10b3321cdFDEB26a9A0A38B8c7031",
-		"0xAeff68596B7b089445fd7451758A8B43D6b15098",
-		"0x1b7E9Bc4f939D1B307436fB1235f16c606250334",
-		"0x7cCf6c66085812aE4221Bb07207b3672aDd572E2",
-		"0xCDdbF9E265564a9fFDA8CcA052Eaa720a3C9e0f2",
-		"0xCF3e0807B72f307e50EEB51468B22411d5350C64",
-		"0x77481bf9736016cbF2D502C6496F580d6cF02e5B",
-		"0xB97DCe10397d3330Be1B0b56Ac58419268687432",
-		"0x9517da1144252aBc848Eb3c42bCF6b872fb60d56",
-		"0x5F9D0288944B0eA72748BA0FdA84b50da03fd214",
-		"0x1E43B28E9936e8A775fB2787107B7563624Daa70",
-		"0x530204acEB59d515d0E672b7A2b2D17f95E113F6",
-		"0xA25575865caE6935c3f5Af260E5644ee7F2276cb",
-		"0xC0d2a7a3D6e19caf2Bd85A2118DDd5425496C2Ed",
-		"0x00F9EBd1Cc6931105AdBf66EaCe1b69312Fe28FC",
-		"0xD7068ce89ADF01E3B7EDbE4e1c7ADC71FB07375F",
-		"0x2AEe52Ae158C2F22C76C6985a1bA726673C4655A",
-		"0x6FC0ab1Ac33eAFfE039e9Ebe19745dB746cD2Fef",
-		"0x68751DdaFEeF41d26a3e1Eb3932d2BA0ea5D8254",
-		"0xC223404062bD80F8E739240e1cFEdb5d1d46aE56",
-		"0x4f955dd9AFF36159b182F2EC40483Fc72934187e",
-		"0x0D0Cf4b49Ea247efcEb453BEBBCBB19467E18C91",
-		"0xd991f43c4B0dBe2564F2023E12560757ec0f34Dc",
-		"0xdc8e2C6385d48a572b82CA0AEa8C25D83B4d1eA2",
-		"0xAfbd3c3ED030d45484E10334aB9b15C3414DD142",
-		"0x54509f72B9AaA941BECaA098625Bff930bCfB1A2",
-		"0xC632d6B8dfe192A61eaD56C2BA01B4818803Bfca",
-		"0xB4B998f1E3377Db7c05c9706645258303d48D8E8",
-		"0x08e864E0568b31801Edc88e209cBfFDFc0DDeE77",
-		"0x7c5e84332765CAFEFCd44A611f7404F1BB9079Fb",
-		"0xC2fBfe51C7287807063Dc401277cC26DA7e8a3cC",
-		"0x87a5e34c2BFc88c9C61CeeeE7F39A283570672b9",
-		"0xA9DB45c29Ba92B2bE17ED49853b2178E6121b369",
-		"0x938205713972aC44c27ddab1122299ab343337fb",
-		"0x2134df9562De43a56eCf9b731f453c7c6a04c863",
-		"0x7E2b55373fa7F8DD71E240b619478AE63633C665",
-		"0x05A3AD253fdbcE9BE285740DF8289C4d087342a2",
-		"0xCFA56296E679A961D0ec1c3a26a019938CB65533",
-		"0xD7E5c169E9Be2BD3e5F9F917C7521a8a55a531bE",
-		"0x7D77756407dd3c11c3F98ffc8440371eFE0B4cdf",
-		"0x14f783477E1CF3306cEEc1C2f1031387c2fCE862",
-		"0xe6bc1a881D09Fc51Da3A8Efd1102697a17f91C60",
-		"0x54276E0d467DE08Bf4C53bDe5A3B06685637dCC8",
-		"0x6895a004E866021F9707C772e3296D1012b516FB",
-		"0x4BA3d9FD39552DbaAA7053F001A2Ee36Ec8B44F0",
-		"0x300072D0CD7c5417851D68F20cD09C5aCdAF750e",
-		"0x3d5dBa7602309FcD45269DC8C817ca9C67E571Ae",
-		"0xcCc2B3f5C473232B81324e1F9F29088431E9Bc5F",
-		"0x7Cb7bb87593A9CE3159F1cee1cdf57Fde0432099",
-		"0xff1004325e41e2eBb887e10eb08EaA72305f8459",
-		"0x11f475A2fb5F6E6DFC6914ba0152394ef2E64744",
-		"0x1958584C0fbe13372a62Fe914330CF2f5d40035f",
-		"0x406a99f9351c4b3d59Ce99EE6A04d263957e1d92",
-		"0xD628EC99942b7C96599804ad8b91834B19548407",
-		"0x2a48A450705c9a5E1b30Ab352330d97eB6Bb36bA",
-		"0xBA5cb2D4667A487BbaC8d1F2667F5cB68CDF7e6b",
-		"0x16e2B0513885313D3bB6bB71Cf856AeAb07b751d",
-		"0x6e28855D37aB9ad68Edbe68cd667CA789b51A6c3",
-		"0x28e5f8d8C0D856Be1F2AC5ABE2B297F7662AF2e1",
-		"0x44Bc6C969C59056e4984831246d58E274A571888",
-		"0xC4f4C6cfa07f99f38052B4ff97c5179cFb7CB478",
-		"0xB06A99E135316ACbb0a8b212b3Fc9dc07A364B5D",
-		"0x3822eD369a46D9B5347850eEFA3D0ACc4964c24b",
-		"0x1F1204F1C301526cAE82F089554ED955977F9436",
-		"0x8B6e0Aa1E9363765Ea106fa42Fc665C691443b63",
-		"0x757d68B092a039B0033f4e756D309a6b5a9cbDbf",
-		"0xF3d5feA0E5A557c3922C88831B0b1d7d8920A250",
-		"0xF1a3003646a7d39808854C3bceAAb6A5cB174c9A",
-		"0xF813a646e4AB97fF71E1e3c6b381C7b0B28F9a9e",
-		"0xA4CccC10f2DfEEdf902F90cabC7439bE60Ed523B",
-		"0xa9313Bd0cc3CCfd68F670E4715073FbD6Ff1A108",
-		"0xAA676B5C71D7B6400b553582225C6FfC0Cc3B160",
-		"0x8aDBfB4Bd9BF2fbC9DB54c2f2e1F4910ca49ec91",
-		"0x02c15b424d9cb9D34B650E49868bc29723727f68",
-		"0x003DA4470Ad79aD1498AA807d1961cef90CBf7b8",
-		"0xe94cDf0Ee1c5a3b7F04D66Bf673dC64414943EEd",
-		"0x6B80d1B9A936E0d1e69144fC03422E1556a103fb",
-		"0x6Aa0421dF2dd4D90faB983D048825c8f100a5845",
-		"0x8a4943E82AA572DE95db33F8cd2e980F7A1F8503",
-		"0xc64FDC71d00616d5F95F8A274e63083d38AceBEC",
-		"0xF5997d9d55bE8D349582302C56Caec92c387e9a8",
-		"0x74f27e9763d660e1C039191AE23C1C60Cf1C6f86",
-		"0x7E2b4D814C1a7261622d52049c5610b036b3302C",
-		"0xF4EE46Ac2BA83121F79c778ed0D950ffF11a18Ed",
-		"0xB66981daF23a6da80d9fdf7494e3889c82bf5B87",
-		"0x3a602c32BbdcCa8348FA43b9d4d981D199F44d4C",
-		"0x026809383D6eb4Cb274D292e47CC3DcBC90B9911",
-		"0x59A18C26c1F7D11A7b58171Fcfd3b5bDef7EBeeF",
-		"0x31Ea6627C958531823D50024028dF6635DD3faE5",
-		"0xB38BDa2d2a4C6273C4673060D07E4f6f68F74eF1",
-		"0x65c6F60b4E271b120b44e7D13F7865BAC7b027Af",
-		"0xcC82Fb9EAC89CA4ea0A95244710e7EC45845f811",
-		"0x93CfCBBc119Eb5dFeCfae2a2605d94Dab7E06Afc",
-		"0x8f05A23a1d5b18367C8BF9B1A6E73EE01c0FE7c7",
-		"0x45F137013164f5d9cf397ffd896CF9Bb157E0753",
-		"0x5103BD067f762567ca3d139aBa5F93ACF0ae7cc5",
-		"0xc5318C3F580d3D670c0FAf2248c8F6d3e81E16b7",
-		"0x4afF9781CFD213Fc3DA034d317EF4e4BE8Fc8521",
-		"0xFB7eD5b2d1Fb03aeFb4ae87e02EbBc3f8F2B391A",
-		"0x1432699384Cf459445dA9BF023f442A670d982E0",
-		"0x8DECC186F3149a6afB36b5302AE809bef11bE0fE",
-		"0xb5ac7cfB032dDe71ca51E6B7d524A9dE082a0719",
-		"0x2A09FbA034e26852ff38d5393f3fd15748518791",
-		"0xAF2f34be5e805a80f3f69A97A87cf85aE0D7576a",
-		"0xDA82F47d9D375B8Dc51Ea4Ea46337b841C816924",
-		"0x5F4f8bFFBaec0B53A36E4b61868D00971cCe19Cd",
-		"0x8023bf6FA1281aC464BbD5b38FB386a90ca53c82",
-		"0x1465eaEF8D7be47dA8F90716f89fE7D5705473c9",
-		"0xb95F5964E8D743E5AdD7D3a739a7E9E9399bD694",
-		"0x294369207984f53664618E6A83A9a14da894e0FF",
-		"0x61Be60DfCf341E82eB00bD1Bac863A1512db9823",
-		"0x1883aFE0a5A2BDbe43F62C95f43e89Cde3545EEC",
-		"0xfce8363Fd59DD57C7A7Ec6E138c68738809D56d8",
-		"0x1C70AfF6824f3704d0a0f1267F537369d1F86f74",
-		"0xEfbacA5A1B33e638d785e93C28CCE272a0917B5E",
-		"0x61514540991Fa9476AF1580663743177BF203876",
-		"0x140AcA869295C96894C0f8A685022Afa16Ed6352",
-		"0x2d0Ed13DD1E1939335B526bcF6d15b5b4d14D7F6",
-		"0xCD322281bD33E8730E2fF1E69749c6b53934Abd7",
-		"0x750533873a4B90E41264CDED82Ab0A55A61e5d6c",
-		"0x47447BA394a1046D12366Ca7bD87a817d6D2eFA1",
-		"0x556a9e30e09388B0BD71BA617c681fC06A137381",
-		"0xcEa787ac99Eab5845030cC37A5EfAD3262E78A70",
-		"0xEdb3709F0de06F6296c5D13AD84a73cAb94e0962",
-		"0xCFb5633EeDFFB3Dabb333F9fD3EAb2894eE39Bbe",
-		"0x591575579D607c8BDC5E1F15f1aa443FD5e5510a",
-		"0x05Ca313179bCC5F5a75A3b9b8dA6B1AFE0fEB89E",
-		"0xA1b3161Ce50819a220B2f19eb4FE126B1f2CfD66",
-		"0xba1A6bEd54B46cA7561B05E2309e6dC3A49Ba455",
-		"0x06Cb69fA574E08c016Fa739fE087deee69630B95",
-		"0x0b0c245813b2a9dFCeEbe47026dB1a9973540a36",
-		"0x636052FB0B4933bf46736E8760a4af3949Bac017",
-		"0xFB1bA29b1af417cA76AFf3c44200cFAE8dFd2eec",
-		"0x46F60bEF68090E32aAFd1465E213bE2b7E6039f3",
-		"0xf8D4fF7fa420CbE4E06AD9B52f990683503d8486",
-		"0x118435ef64091a348aCE0FF628aEb0B132987815",
-		"0x5E62244104c8317909fC83543c828F2dc3A4D583",
-		"0x696B5C6EB9a3C5F8d78caAaAFC9bf1A9b13a14c9",
-		"0x9Cd3D04FE2521F921478044CB6C8aE179558eceD",
-		"0xc27676af54709E546dC654BDc033a84F433d54B7",
-		"0xc855E7Bf837fF60201ED142FFBbD2c88f0e71362",
-		"0x4579F9f4Fa83C26483Dfef1b5D7c16D8274bC1c8",
-		"0x771f5320126Fe15736880B1Bf259A71095B32Ff7",
-		"0xFb04175E0c8B5f51850f04105F474938958AD44b",
-		"0x920E607Cb084E02eFd598EEba0c3A4120f098A35",
-		"0x005cC206D7303292f2CF88ee585Ef6AA9726782c",
-		"0x90A772b965019131DBb1609ba5054f8e3920fCB4",
-		"0xa5D0932a7e46ac888a670b5169Ed7385EB43a9E1",
-		"0xb69DeC4EF7d327a7896d36d520780F552BC003B0",
-		"0x2a046a5beC4F8161cFc9E10263c4C54bFda704Db",
-		"0x40EEBB1449F737C468c8F2867d511B4C4AD36ad6",
-		"0xeC7DA36566Aac53922edE2Fa2bdF380ae352996f",
-		"0x70b785E912d0AB5fB126F4d347d2F899b951b497",
-		"0x2e8C54d980D930C16eFeb28f7061b0f3A78c0A87",
-		"0x1500ae29C9F74A4e7a012B59D0C0c70b44EE7162",
-		"0x9569deAB7b21B5A0df8fB3a6Cd20CB8e1765641c",
-		"0xe402CE0b470d7bc68Aa421ff82A19Ff7cde4Efd7",
-		"0x2f080082f2b2DE0362a3c008c275DD2c9143e1E4",
-		"0x48AF5cFb3A62F4c5c80B3e7e6eFa483c46d7A65d",
-		"0x70748b755fe5BAe7CdC8BE3cc57b7f47fc5B8A64",
-		"0x0Ce9622315ad6fED2c915289EEfDAce57E660E66",
-		"0x097CA9C1A15fcd9Acc048f394AbF649c31a08E2E",
-		"0xBD9C82538D008Ec09De8c737441927d05e7f2E98",
-		"0x548D910F85E878E425529c7486a93eeF282e288E",
-		"0xEa54E6D5f66B29a44F30a9FeAfCbFe12aC3C7F89",
-		"0xcFE5853Dbe3dFeb104E3D550dc7B642689F126f9",
-		"0x901A5667948315971c40fC030191c7CcBDa21531",
-		"0xcd3d4Eb34385A2DDF470aeD617378EAae53082D4",
-		"0x34A24969C54fF1172879Dd993dec307a4a83A674",
-		"0x2009754eaa539102C4C575B0723521192Aac809c",
-		"0x3b1aD803238f8b0B408B51798cEd0C65e7ACe04f",
-		"0xc1CEE919D93DC545D0EA59234C11BE7A536E5108",
-		"0x801507C8e229eC43962915e1616D985B7150a878",
-		"0xA098860E891Ec61070Be43742a9418d8B1f7d0f0",
-		"0x1362769E23855390C9633FFD5652901D6De12B67",
-		"0x64F163797dc94302897C89615Ab5714BAa90535D",
-		"0x1E11E0c3b952B9fF78A92f0DcB6006270afFC69E",
-		"0xC30BA54784769F56AbED84263Dd26Db0E560c95F",
-		"0x4Da34070149ffA344F775D7184586BAE2F2e91A6",
-		"0x5D7F754F297cA85AB2029908706ed6CDbd00a6fB",
-		"0x0446D81627E342dF7239c4D7403C8131A8E93da1",
-		"0x8170F2Fc3e2C689EF17745e5041046a4711fa62c",
-		"0xea162946d81B3269A5e10Ae95cc5370559Ca48C2",
-		"0xcd523cc46d29Ed74F9B9dacBb1Edf3D81eBb6148",
-		"0xbA5107Ea923Eba5d3085e055Bb2e512c930A0B63",
-		"0xC34f4f7B9641C27BdEc2557e249d113EAdc013Ca",
-		"0xfd682C4ceCe9A749915aaaE3871099391fB2e0E7",
-		"0xBBAB3b7b6354fcE20b20C91dbC5Eaf5C90a4CbBE",
-		"0x4Cec8F03240B6E2a390BB53B7E0556Ed13b7d439",
-		"0x1DA09d1fA973c78954570749d75Af472a9148246",
-		"0x05fD545782aD8a9331D425fa913908Bc82675326",
-		"0xC7feB139D853B5496359871C3Ae30902A6d9B7Cf",
-		"0x24195412938290F63ac47F0130ABBdb39319168a",
-		"0x9a7d8863B54ED52098FDdDe8a532db8723395DF0",
-		"0x5FeCceEC9c3dD4b80c2D691ff35CA248Ec423C78",
-		"0x55216c7BF00A93f11cc7435D6FDC692E2d80Bc81",
-		"0x1E1E83B0e813afF9f0163634F2331ECA91c825Bc",
-		"0x60b77a94CC02F0dd43a87ec00FeAE2347F08c8F1",
-		"0x23bf83A0Bf632C47dEb1757746FD90e68965Be42",
-		"0xB968803EB0D91C25C8d586b102752eeFd6e76E1F",
-		"0x8f816b50bF20912e57cEfeE1ad6B6F7D20ab976e",
-		"0x312dA18b6217440123A315290663AcB99538c6D0",
-		"0x1a58276aC385440CFCb64c108FA65205F4D54A2f",
-		"0xf24208149576d43662800340fcA19eFEfDae2CD9",
-		"0xe99f8D68f7c13cB24B5e7A3BA89128402b4450a8",
-		"0xd30De3c069020A2cFe70Fe1691136704866B7c9b",
-		"0x7Ef379F8720CcC5529B586cAF9A837dBd6c4D79b",
-		"0x623ED17C81a500c960b36635D7b4B48D6dEA2b26",
-		"0xDFb23d4838886F9F5Df83872BEd0B6b612750379",
-		"0x1866A672715Cf430150a36C67f09d18e9Fd9c5DC",
-		"0x266C2E0C2566f28526B4ef2CE60F10789326537A",
-		"0x7e967993cB158dCb62E5fc42422c300A845f9fB2",
-		"0xE9e6Ed24E1Aad6405e0Da8eC16f355f16d3e9C36",
-		"0x84bF235fb4Da21a21bb4B1eb5ff0f17774dBFf97",
-		"0xB16d99f549de131adef96499c4181eaE817eceE8",
-		"0x1b9512bD2b025d5FC60FF0De97e878Ddb1c892a8",
-		"0xf00101986383047D0F492027C6DC0220cb48B063",
-		"0xbfDCccd1A126518160534bC64309b18189675A22",
-		"0xBa67BA73774dA585664661d22775dB9761418dC5",
-		"0x94c06629D8ea4f19145F0B0e19bd14f7a3F92339",
-		"0x9fF2820331372a57468A36aD2446dd214F9a104A",
-		"0xdFBA8A210372560e5334Ade35fe5e17ACC445a54",
-		"0xde49191E1E28D30e2cb1bd3696054B91e4A9ef05",
-		"0x2e0BE10d0207DB3132E583c9332b153F861f21a1",
-		"0x0de16C05d27C3591072587c08F20f19D81a9324C",
-		"0x969041A1c221393BFb861d2B945fBBeA03965Ec2",
-		"0x0372ee530e69E4e39b3da5d2b4a8AafEeacD065c",
-		"0xc2a8dD01aabB1e21bF2535Ca1C222ffC916e6609",
-		"0x0A05E2fE64EeBb8390F1DE7164B1E9D398Ce96D0",
-		"0x63C737E5BD543ECC0A02d91BfCb50845e1be31cF",
-		"0x7fa45D8a4a363f1583929F7F12c17B910C8D7998",
-		"0x6CD71A07E72C514f5d511651F6808c6395353968",
-		"0xfc71a821966B143F6db2Aee3aA129e58149df2B3",
-		"0x03408527d5430abF8F0D2C3F8044b7a8EA9209E5",
-		"0x3fCD758B246B6038C0EEFccb62df28B69d6B0669",
-		"0xe5236b0e37cBEB6A7cb22b8f00B5433dDa977D5B",
-		"0x2a7130AB4AEEEe5Be5c6E525D11CCb0314304Fe0",
-		"0x8B07c6CB1b2edA3942369EEC9DF8e12213f99181",
-		"0x0773ee22860E58e8E413B111259E529E6E1aC5A5",
-		"0x9dc017FDcE7eef9DD895F88Ec6C44BEfc672ac59",
-		"0x534a681EA5E16489B2dd9374ff7320b98a5fA19a",
-		"0x696A33A7404a336A8eA0dF0B8872D071896C9d85",
-		"0x7a6b53382E9806deB0A3b42450Fb9B7A679E89d9",
-		"0x009d611490eCfED2dC3F306231Bba7e7F3E9196E",
-		"0xDB7ef44D6689B7C74FdA0C2dD468e2FfD5E78bb2",
-		"0x5b883F02Ce7d7B6e54CbC5C053559bD6C78cDDd6",
-		"0x0aBE9aB39bBF370629Faf80B1CFDC7F29651D221",
-		"0xEe5a87263fA7F40287844660eC33B687533a7E79",
-		"0x61ee4e3BA0eC02eFb83355557d3055E26F315056",
-		"0x441805C6E38033e26a4Dd8F1C98572a7d760d013",
-		"0xe306C9Cb095165a90287d690ae19B4Df68F29929",
-		"0x553Aea36E6B60311077290D4cA6ab526194A0C57",
-		"0xfC471a0f5314759468848F907f67d437541d32B2",
-		"0x18F471AD74dfE9D0C97C6229D4b65602C5EA6913",
-		"0x3Ad7573772cE99430894Fc9159347cA7350324D0",
-		"0xF038f2da641cADe61207cD5dD7339B3Bd9b89175",
-		"0xdc64F3311c2f8C185509beC746f614CF25959092",
-		"0xDF637Add49568F11bDD3A447824f7d21A9BfD855",
-		"0x6725FA3E5683e28b864043886132A7a189F1fe46",
-		"0x192979c1C8792aEf8868fe40B2A2B5a719D1c8a6",
-		"0x7a0595662CC3249075Eb8F88679AbAA7f992dFB4",
-		"0x0ad5C283131fec3f3DdE4FA958048858e382eAFF",
-		"0xddc009B96581c98E98d651F8Ba94c789757C5256",
-		"0xA119f4e3dB14E97618778F4b867fa81AdaB33E98",
-		"0x5bc3ED94919af03279c8613cB807ab667cE5De99",
-		"0x8ab5BC4F969D21aB31560D8bCBBE904391d6de7B",
-		"0x23D3f3DB80cCe3A660275db91EA4b4659DBe9C62",
-		"0x214a0a2Ef3FdFE695d212D07eBC2c2E9Fb267195",
-		"0xBfE85f62B860cB0a185895f74Bc5467ccD804714",
-		"0x6438C129c93bB788546dC6f874431871833B9Cdb",
-		"0x0d35916fADf5078CEEB026A928070f903d33CbE3",
-		"0x94A7E9381457Ce4Ba887a2F712c88DDBD631086a",
-		"0x843b1bAc1b916E5F41a33A63865Fa065a73e81f9",
-		"0x2eb6C424679fEF0eB613f5aA581c8BCD49801519",
-		"0x88ebFe4D6702e13043e942655E570fD18AA63664",
-		"0xBF39b74998F88BC24d4c965c208B929497d6566a",
-		"0x05eCd3CD8ABd5EA2E904436294C8FDA406bAF6ef",
-		"0x9f2E89eA7ed12BEceDE9Ed2e4Cfff0D657506595",
-		"0x366bfA37515bbfdcd2a65d298F1aC90a98199ac8",
-		"0xEade377cAA1E34995D4E0cC07ddb39A3396cc24f",
-		"0xa3BBE52e345a5431B161685E26418363B78f3a56",
-		"0x2d7f4629f86FCab440Ac73D782978A6730849443",
-		"0x8c766668DBed0CC99A36D42F1866D4863df9c8D2",
-		"0x6d0e01bAfBefA1aA4Fad290170E3a22FF494353b",
-		"0x69048B9A5368dD2007D6bC5ccef9370F29EBA846",
-		"0x5EaC8141Bb984F776e8B981D47382CDE886F7e0c",
-		"0x8cBBE575932877D46b909Fe52A03D5ACFcD24c1d",
-		"0xECce839e3Cfe75A81ba7c27B6A13356a69464f75",
-		"0x157FF00E66b4496e3e42132Fd6898c188b3E5755",
-		"0x887A1fb4839285CC2B1169B69ED5E9020Ff656f4",
-		"0x2f353aA73A6FcD94020f89fbC7c39CF5B0693d39",
-		"0xD82b684426503FFf924349116123645A8205Cc67",
-		"0xb712AB0B239ab906B3E9907C49aba55Fe80bFd77",
-		"0x38326a16FDCDEb681d47C3DdfebE4911c7019A86",
-		"0x42A77DEdD13520141aaF1720EF88086B5Cae95f5",
-		"0x330244ed4C53642FA5809919490AD4a8768eF4FF",
-		"0x21E20544Cbbf3058c60C3df5F74924573DBBd9e2",
-		"0xc57ce326a706788c5dE7Bb0DaA209Fa789f0532f",
-		"0x2832fB9F11C928CB50595B4707491424052e60F9",
-		"0xA833b526f32DaCeAeC50b5ABFF21c3d57A739365",
-		"0x2B420B04447aAf9b4EDaaF8eae2Db173CC5Fb4AD",
-		"0x66A7009c3CBFC5FdEf75E89D104D1CD9ab50f3a0",
-		"0xfdD15d188Ea702cD1d168277BE414546F3e38a79",
-		"0x09F93d9519ac9B670bf18C2c713F39492e478DfA",
-		"0x01c7C4a8C39528Aec38F5A21607ef9eED6Ded831",
-		"0xE030eC0B17eB8FBB19Ca4b108Db064A5a47C2602",
-		"0xC9e49070E6B0F4939E2B737616eA64CaeAb3feA1",
-		"0xc014dCa6395A943639653f7C23B674c38EDFfC69",
-		"0x9A8eb04e2b0d9713D5adDc1a87C8dDf1c1F79D8C",
-		"0xa238C9F15c9143fB0609f259E0513bbC642D90C1",
-		"0xeDe7B02dec0C557d65CA90cE9A4Acd8EE1C60Be3",
-		"0x6A93Ca1a73E82EEd9d5BF7248ce1A8758647597b",
-		"0xD9BAD00a32b9B77C7c37Ce39f40b7123fFF22209",
-		"0x937565385471Da015c8a3b774755ee92502f5741",
-		"0xAb16345276aF44ce909E6Bfd27925CF2A39404D8",
-		"0x0293d5FEb2c6803472B36f7F8538e9cAB8864c6C",
-		"0x381209688099BB6a9CBA70bEFb83CE751dc32B3d",
-		"0xd8BEC3cC1710e8A60a61f3db24d39B35ec61952D",
-		"0xCcDAEaC42AB6C5C8b939927d81dD971A557684ac",
-		"0xF626432069CF89a860d99C8fb90fdf381452245d",
-		"0xe29dFE522485F618eE154c579415F5b82E55f006",
-		"0xDa7d1003f033c4248d89312A20523616dFf1e411",
-		"0xA28d036c4B3DC5B11FC0E1f627d040fdeFd7cA7E",
-		"0xBe74f36a9aD94152ADCfBB47c00FF2b8B3bfc1FC",
-		"0x3f8aadD297E60Ebe7c1bB8d3f6D413b9f39EFB8B",
-		"0xF79a0680284a530945a938bE498D662613422545",
-		"0x1aB438237aE7cEE9EA62E4b0252003fa86e8809C",
-		"0xa849A4201Ea95Db5f480A7EF3b2FF53617431484",
-		"0xb5277de943b3C1bc344095BAc54C4b25DD3d9Ab9",
-		"0x673114ab397a0A759249935F4bDfaAA05fEF0e19",
-		"0x7482D32d1bd53F753c65F7FFAdEDBc913CD89475",
-		"0x736bC477550958c21417c14af9118bBeE7e34723",
-		"0x596464A3Db562cF9dBd8f059E26Deec6C2f2ae0B",
-		"0xe86dfF4450418CB3eC1d02F5576dca92feEE908b",
-		"0x37388434Dc065FE5BDbA657F1bc5E178Aa13A795",
-		"0x859784d1887cA6dC87c555d4a98e59E224bCd0c7",
-		"0x562610Faa7802fd1E198d9D98CBcaE14ac980b5A",
-		"0xD63792D1d4a205610c6ea10f081914C8C457Cbe3",
-		"0x2Df0d214239E20535060220aE54ef361606e346b",
-		"0x11a54B6E6e11F7a5B0Ad250Bc2a5b5A5bEe7DFB5",
-		"0xd9023f1010115a20AfcA2170dFBB8CaE8F6F8692",
-		"0xE217B95E80a5EA57f2C88380E4A29aD0470c6296",
-		"0xa357b1fb87bd494908360a37E2627580a3191B78",
-		"0xa01087ddEB29B3b6c8049483Fe0C702dBDB1cb49",
-		"0xD3f3774667419A29336c95C2d019b704E61FB619",
-		"0x82D8b49dAe4D764bcEd36FA933c458c2132686F2",
-		"0xf5f93e34a85F73ea9d75546e9e9137779BeD9237",
-		"0xFBEcF338f2D28Cf581672ed33E7b3073cD7a69d6",
-		"0x47a305498284577F065D0098fC0A37D8Ba1eD9d0",
-		"0x5E51f0747087b0e95d09dE46Cb7E86d162ca8E7c",
-		"0xFf444283Cc938fb45af5C0110b864019cDe4915C",
-		"0x22525f464BFB5ec2e29CfC5556f56d53EcF2D4C7",
-		"0xb5409bBe9Bb429B3956fC212B1B12CCBF0582ED4",
-		"0xD736F97100E63eA55BF84472B9030Fff68e3e602",
-		"0x2D68e5418330C21E84b24adFDd4dfE238Ead42Be",
-		"0x756a135A16cFa105b4508A81b2551D282a2dB420",
-		"0xe03805e0af10c2B600Cf7094E98894B70A8A1bDe",
-		"0x36a01A95eDd023fB2c24dACd30421Edeb51eab8C",
-		"0x9C32269971946E3cd6c5f16d0D93a26f868dD535",
-		"0xdF9211cFE213ec6A896ff6fAe9EADa31dE319Ca1",
-		"0x3c2152686Fe1497e87b094874320c30d93D1d93f",
-		"0x2Bf55D1596786F1AE8160e997D655DbE6d9Bca7A",
-		"0xce944A3fB77C10f124f063f4fb47AD6D8f132336",
-		"0x911D4A750b963C61f29549CBf17e2C10Fcd10867",
-		"0x51c7a91FC743A1ba8b2BD7c7be2Ba4F0d1021e3d",
-		"0x0f57D9d730715d50b2a0F734b0A564e437E90EB1",
-		"0x5E49377E6665bF2311B00eeabB83D6Bc544CD7c0",
-		"0x44a96425794dc0fc0471440175F8352b0588982e",
-		"0xddb278FA49B838efAE22f2d07c10552B5a24dEf1",
-		"0x3b7410B2b164f64EE9c5F242B17526be1aFbfD3e",
-		"0x79072c5e8538DFAf76955Fcd4f8F3aaD0fFb0134",
-		"0xbE0019811270B816866e57B6d7227AFe40F11fE2",
-		"0x85B504D0E0dEba6c069e673B9ffd429753df6DA8",
-		"0x5D821cAB934f044a01c3d59F7e144E4B3C3a26e7",
-		"0x3cea5419F22d7C6f2314CB7192550100c9Ee798D",
-		"0xf415e6D1b51fBfCE20629edfdDabD4b0efb3e3F5",
-		"0x74FEC4964F733BeD7857f237E519f63f8893156e",
-		"0xb91fC15ed0c8C15d5a550fb5D699AeE8aFFB8C53",
-		"0x1Fbe0750cBf8ebc0A60CA56f832997a76FC0b7CA",
-		"0x788ace58C88879D218a186e94CbD562E0d4f02a8",
-		"0xA92A6944e185cbB72A80f248f93Df67d9D56a2e0",
-		"0xAb0345ec943e449A3e3B1c84b1edBC4Cd0F6b329",
-		"0x6D327b4cc271652D2bB9765A4d079a3258964a35",
-		"0x9111bbBF4ebdC26FA474dc6C02723bBEF5d6Ed80",
-		"0xAf1039a5D74a87b07aa1C9b90d10d1e24360032a",
-		"0x82A7c721D8adA980Bd0f37F65c1619A17de3d4a4",
-		"0x107eFB6880C587Ae593443bF2333BA8c01D9a7a6",
-		"0x53e9f750d3B3dE0c004cbAc93764Af9441C49FCD",
-		"0x405f80d23c8054779C7eff992704fE10083E3EB8",
-		"0xeef7D2B07dC140127706f1d458c622884D81A151",
-		"0x37da632c6436137BD4D0CA30c98d3c615974120b",
-		"0x459fd02316862F3D2588423d20572f16439FEa11",
-		"0x2d5188Be6fF071aB4CbF76a9D654A163bAeE569B",
-		"0x2Cc9C6096deDE93f166c9d7c7ad620d8DbaF5a56",
-		"0x991fe642F3606CC3cF0f7b7eAA8A8E75587Ab723",
-		"0x84ee76222602bFbeBd0d285CdaC22826365Be7c7",
-		"0x4bAAEB8a6A87dab28Adf63D2Be0b111081b3Fa7F",
-		"0xFDcCBb9f04354bFc388691F2fc68E374970EBd8C",
-		"0xA8Adb745295e845208E131a3061fB68ed97F55b9",
-		"0x09fc3Ef22fdFC2a45f30E42f76C2Ce3c109500FA",
-		"0x1ef69d4C8C903Feb7e89Fe8C31AA12896A5b27d0",
-		"0x19E938A0bF1CaF158d28D6e959A15723F066aB5e",
-		"0x0947294780EFa97b50B0c6827216B220935e7216",
-		"0xc5d5e4Faf35768F72146BcB8AC77909235B77B80",
-		"0x7Babf7770c65422faC22e284401A041D003A6005",
-		"0x2949F582Ca26D793a5C884284d7a9Ccee85842d3",
-		"0xe5EDB0F57395a82f5782BE1A6e652Ff287dEbB9f",
-		"0xAa3c7692bfbc6D7831029A42bc287273e7e7CeDD",
-		"0x8B83Ce8894Df2653B2C5a30aC9D69f9C1d1342F5",
-		"0xcA4A2a153FecA6AaC1c1411Df83B6d6b2df7ac42",
-		"0xF2Ff4867Db353F623ED3Befb0ac157DE73ce5e6B",
-		"0x9EFd9e828b64bFf749B65881692B16C15B34E5c6",
-		"0xf65c984f166553942C66BCc1FE74cFD3f26523e6",
-		"0x97Cf513DC5DAa6aD31b9784F9C441e523d6b56A5",
-		"0x118300893fcFa13f5918B32639773244a6FE083F",
-		"0x61934F31eE682A8dD43844Ff20a4D51531127b8B",
-		"0x7421F852dbE64f693649D476109171A6cbbD7607",
-		"0x1Ba21EC6eDD424D184b93ee5dC24eC7259C54fDB",
-		"0x5fc20bE6Bf7732EbcB1A5691552ba607f7395b18",
-		"0x10Ae01d3B3A48F33D841DA0dF586DC1d87c285d8",
-		"0x90870691fb5d4016c9B7F89Ec5acEf03d8724Ba8",
-		"0x4c5e614412CB89aA85544eD8c335454C0636578C",
-		"0xbF22a38b01951E6901c2da3a6f60eeb9f3341796",
-		"0x96D25e2A164037954096b81b0e06c610C5CC121C",
-		"0x08c7CC9FD1d53A3A12eE3Ae9D11732B4fE1A2Ea6",
-		"0xB10558eB9dA9fF46974C7a11ecF3b1333a397226",
-		"0x1D2dE286c27Ad0C87dffB96E99D4CD44fa20d4a4",
-		"0x89d03025A605adC422AEaa928f86Ae06ad9f1673",
-		"0xacb85595c219e7FC92623A0934eD72D7968d9Bf4",
-		"0x154D94A3Ddf1a4b4cBda9F5201eCf99C38ef5Aa0",
-		"0x78df70615ffc8066cc0887917f2Cd72092C86409",
-		"0x93F85336a56ca5840d79A3e69E5b6e7C5bcA2a3C",
-		"0x77e746bcCb930a51194b9bD8c1A03d44e7EeaE38",
-		"0x4c4c9BbD1754890684aEebE07f4E875565a314E9",
-		"0xd4dd4bf4abe7454a1C04199321AAeFD85A7beAE1",
-		"0x3d74B11C918A6a1F2dd85eD7d60eAa8469010881",
-		"0x8547e2E16783Fdc559C435fDc158d572D1bD0970",
-		"0x2bCA039DbaCc16a0D0d41c9670d4CF639f86A2E1",
-		"0x3c8907B80B3F2abB67fd8f27A6d4C9EB58a6Ef3A",
-		"0x6f0f37Cff6Eb0414fb9AB465cF23c605D87Ca9D7",
-		"0xe0359e3B91Fded62b330d53E78CD7DCE6a0E8D74",
-		"0x4cEd40C4993229B0eDA1639a0737FbFed6506426",
-		"0x90d25E7B5162D40abb98d921FE4a97158f29c60b",
-		"0xb57E9206c6346a6239CaABcD7c485B2d61f87E2F",
-		"0x5d0AE06f73e2BDa8246170BebB5020d779C3B327",
-		"0x6E4295f2E80Fa929b221c656d835bF5fF735890e",
-		"0xdc9155050A5baB27D37B930e877Ddd307f0331b7",
-		"0xc58d988bb15B8614CD32f4366433Ae567b1D77f6",
-		"0xdCed5bf772B03f929fcF3E0b73169F3786f1419B",
-		"0x9478c944Da2449D721Ee606eC577c69b91eedd12",
-		"0xc6bDb7f486AF1D964f65D4788b8DC9aa710f199a",
-		"0x41ea01c164123d37b592BAce7e37998A01657982",
-		"0xeB5b8868c6507CefAed70aFdA8b6d44a56004e07",
-		"0xe7A0aa475995886e43096194EA3C2f8A4245B348",
-		"0x1e2792e17a1E1b467a424050Fd8a42C60588923a",
-		"0x750EB43b5c6E36a4451eC7a7480e6583B38F287C",
-		"0x6B25ef953Cbe59D79E138bf0784B7CE89a0E68c1",
-		"0x9dC6dC635c7581afdd45de9EfdD03C7C57A650aD",
-		"0x1dca8D6f626A39622bD48f4398A3569B54E6596F",
-		"0x9dd4A2fca5b09c8cc8961A7Ad847507b5F8325e9",
-		"0x11444d985cABF35592e52C891b21AD42B4035FD5",
-		"0x582f7C375F5F166BdF732CC708fbA7841565734D",
-		"0x5261A83B62cf8e73B53C17D99D55738DcCe8FCb6",
-		"0xa0e7eCb7b035138B8698c972BA4386bce8555885",
-		"0xA16ecb666Dd3F4fF5CA8049d1543bA93851C9624",
-		"0x40F9473285BD9F8BAa7F9fe6f6c603239C45E2eD",
-		"0xe8Aa54223b01DcdE33eBdb8A62649D8dCe35E950",
-		"0xb9acE062874fD12b4f66E2325694e39FDc39EEa6",
-		"0x1C877a5814bC8bd7053724b42c04AAA8F65DCe11",
-		"0x01Aa28915D123E324B240F563d0861DEFec0FBF1",
-		"0xb6710572A14cEa1dc95dff07a292d2fe7c223700",
-		"0x494C2805064e2C535039086452b574afb6b9Da5b",
-		"0x0AB76Bef3ed67B87247d39e16cBd519Ac994AFdd",
-		"0xA81Ad41C6883490b7F574B4321da938c82b78E88",
-		"0xC77Cb79c323CC78af4599865E0CD6c513fB5Bac7",
-		"0x4Fd7D13c126eCE5c3fE7A839871B242971D259b3",
-		"0x61B902666e021Ac3B81387d83e46020c4baa5Aa1",
-		"0x2A784B1CFb5bBB575a809a029005c93346e81CB2",
-		"0x401268e20C20269f54A4070b20561c851F2Cd63D",
-		"0x48E8bFD461D652FaF5aB6d192244F8B09fe2D33A",
-		"0x5DD3fF92ba9c5A5C43123Ee22dC5b99D96e3DFe1",
-		"0x8c360B3E3ffEe9b3Ac0AB31bAA14e9344cE7F661",
-		"0xcd39a4D661c82cefD0edf165782ECD4101e6a326",
-		"0x1a6C61E04FAAAb956176A22056f7183C6B77849E",
-		"0x1b489bD15C04b9a06d98D90816921648ac0565aE",
-		"0xA32feb66B09Be55b65e0d5D173E4e2190951FC6a",
-		"0xa1e1CD89cE7497B6457A8f3E52e73c71c9a42029",
-		"0xeDCd4dBC58eF210e39bCc0c62A1Ef060d45415F9",
-		"0x740665ECfD321ee5E172eAb31544727D660764C2",
-		"0x87e36223Ff92C0FCBE037005bD31ab69A5C5B735",
-		"0xcd2f810A8002e930b8A8bD0D1582C95fd0945E42",
-		"0xE68c0A419C6784D62A596a41ec626C612440c303",
-		"0xBc41f977ff2a441F799Fa2Eb8Eb21129440Ad18e",
-		"0xc491e4Cd8Ca4d9F53f8ce01f798B32009E1cd84a",
-		"0x98aE5A2f6C3C39B4171a84beE4c36C80e024053D",
-		"0x24264BbF05022eC9589CF44CB532822dAa261c74",
-		"0xCCE781158453dC91db4b30E693406C2B2B2159c2",
-		"0x35f3e7CeCC2e29C05C32A9eCE006ea365D5Ad927",
-		"0xA902619D86c37F1c797D3CE1e449e7061bc6E0A1",
-		"0x534f53242b0D708B1cfe6D0a836A8A959B08C413",
-		"0x0cD7B8e41Df4F0e3bD78DF9e6e0280D4173d3757",
-		"0x7045605B0678752f647E925d63ce22A55266fc71",
-		"0x756ec2F17Db80317313550a2C4a061319D28CD0B",
-		"0x514b22D8202FbdfA2708669eF0741f403Aa720F6",
-		"0x25f97CB877e3c0f3bbB0d984c8006b0D1784879A",
-		"0xdb8E1F1eaE7cE0a27357989720D237DF8Dd7AA49",
-		"0xc18a46068EeeD3D53AfcA61B309f179BEE435118",
-		"0x7f0bB80D3108Db907a0e4cA900CD9d4469Bb7f3F",
-		"0xe3f95D7cea4bA1dfc0A42822e044553715CF2Db5",
-		"0x9fbCfd5A79eca1EFb8BF6A4c5207139ECe137f94",
-		"0x1088d1860f4E51A2e20440eD23619a1D0D59beB0",
-		"0x3E43fCede939c7e501473121b52090e2837A6cc2",
-		"0xddD362624ACb6f5AE6F920E75C7e92871dED81f8",
-		"0xB4d59BAcbDE1c7755E41b70774ad54991257e20D",
-		"0x71308F76cd290620F8824731739df92DF63816e5",
-		"0x3c5eEa1D374178C6Cd2A7c32b31cf4c72a323A6b",
-		"0xfBBb4d85158Fd37702303E456BF331D592C43d9F",
-		"0x1dCB05A912a2D636eE3Ea84047B0848B9c25e096",
-		"0xD4f278f325dd67e1e93d21F9B9A1e983AFc6a3F4",
-		"0xb9D896Bde3ebdf9571063F0D39715F29c01954CA",
-		"0xF29f5A11c9749f8b7C3f1795F410D4e80701EACb",
-		"0x1f825CCCD04A04293F72F6c99a9A58101b38aCAF",
-		"0xdB357553c2885c248c18F9503f9b49f57F32B6Ed",
-		"0x1d759F86c1D435FECDa9f7027277a11E1d2dC7B7",
-		"0xb32A3686e4fF1aCc673018F60C3557635663E5eD",
-		"0x641041c705331189B1a5B59EE3239De4828219C9",
-		"0x776c91B0048745df496A77299911b835f8424Fa3",
-		"0x5c5044ea5A006980DaEcC7dd9D33635F06099953",
-		"0xf94C3e0f0630a42350f32abC5B2eA118AABa8E8C",
-		"0x8bfFB5562ff30f555894E101E6DAD31D271EEd5a",
-		"0xAD9F5C4855b7cA234D60F25b977a274f050778e5",
-		"0xbcc57121B8A294FfCF6Ec68121406a18a3DFa383",
-		"0xe0AF9D90F503a6925b9AE556C7DdAf1aB82488a9",
-		"0xa5B1020DE06D21dd9F1209aC462e3c5C86375438",
-		"0xe3c9F05ea1DA002Be695e6697BcD40Eb626Eb2A2",
-		"0xc715F178070D4Dac5C906982f43B17EAa11c2382",
-		"0x8fE1e44f39e6834ca57E724cDBC457750EEC40b7",
-		"0x64ed487019FF6872EE2591637dB13D7eE1226db9",
-		"0xC76dFfAFafa46CC6A312EFf0774059977CAFE350",
-		"0x80706b443Bf9384ACe3ce161a475A1DE90111408",
-		"0xf54041eB92a7B6D1cf9145e44279Bec607b657b1",
-		"0x4f9fB7ff0F08ecCF7B6df4c6332666b4bE35c199",
-		"0xA27F405d57B13b72fA81f23E1Ca0EF31aCCA4b2C",
-		"0x3f03136Ecd2E6c6fB962318f85baD2C7B44430b0",
-		"0x7D4556A9443b1Bd7D08a79bE7cf289cb34647768",
-		"0x2CfAf0E237d1750C0CDEf96a704DD5E1BB57eD5c",
-		"0x6Ee6CcD64f61075fb3077CCb4E45A994105C592E",
-		"0x547f194e016Cc662DB4660fd9A88D432C1Fc3cC5",
-		"0x1Bd19caa894ae4a2Dc302457b7ca7a77af80b17f",
-		"0xc0A65a68D710A8566e62a3DB0E773b56c51437cE",
-		"0xAa09032b4f91F870D2644d56e28a14B97ceff9aa",
-		"0x8A89BBB0930BD94c0113f51631A8c6616C7B8488",
-		"0x8F9235c09Bb5ad56B6a9F443DeF9aCeBbec73C99",
-		"0x422eD3BEE2c176a2DB895D929B51c68A2E9DAfdc",
-		"0xAa3E03B59a2780727dB6A5c6f43bF1eaFB03618f",
-		"0x55B6f315eb0A32e067C90AcE4D2010a71b0A243A",
-		"0x32FDB88bf019b6CD8AB832112968e48572071a8c",
-		"0x78f3CF2731C66dfe2E29dE6B49CB587E52761Eaa",
-		"0x04F9a9c20ECDDa2eBFC4Da84368DF207487D4078",
-		"0x325b3a358916f863FE4C9d758367CbFb48a89Cd6",
-		"0x8317c460C22A9958c27b4B6403b98d2Ef4E2ad32",
-		"0x75912DA1C1C7F432087d51BF3A3D1B35D6A33527",
-		"0x6951EBaAbc7f8dc66525F22586F7dAA0E3CA724B",
-		"0xd9721E3369C86476f8CfE64569d55c9d4D5Dd759",
-		"0x654989C8388AD3aE166565B97C4953E35aF1dFc1",
-		"0xdF81889adfB8CE92142f0089388Cc9E5edfee765",
-		"0xC5C9449b5a295C38142e907d2C3Eb1448870aFDB",
-		"0x83f009a5Dddcd272cA96B955978bA2A2B6BF9B6b",
-		"0xAEC1a6d823cd786c073cA39B3c2b65BACEDF00F1",
-		"0x1B14a33f519DDcfb1612CC33f3b7C95f04FFE138",
-		"0x424BF5Ec4D1647f9f5F07e14584935323524eAdf",
-		"0x6a0eb514C8F6b30693Cd1872A5C0A10a1Bd8a752",
-		"0x02Aa20e26367Fb77D43dd5c023A69D4b8aE90065",
-		"0xc93c2ac34Bd2054B4e91D2414110B4F875cd8FB1",
-		"0x85AEEb6e66Bc66cc4f59be59ce60cFC078dfDaFd",
-		"0xEaAdD17983842db731Bd4b4dC0DbD005F4444eB6",
-		"0xA30621e53122977a5236FF7b10D896e3aaE6572E",
-		"0xA6B545201ad860eA46CA338FC262529C96f1140F",
-		"0xc2e39a7F30AA635C65A8e58659aC8b4ccb9b0126",
-		"0x096A13ACf03294778D2819C53379Efb2378390D0",
-		"0x383d9298b31433a063efE7d5C0B32B560E3D67F2",
-		"0xFa046AEb03E111C73673Ec45B0E0ACE313846ff3",
-		"0x78E335916F14363f8dB7c14475d227C6a2cc4BEa",
-		"0x5Ea68518DA7b739bF01016723e7964938c3A8aC1",
-		"0x8F6c9E3D1F76c6d5eBD858823e3cAa6CE5300032",
-		"0x6E4ff7c7dBAbaf53408428421eeA4bD26Dc8A52B",
-		"0x4Ec56aD5A8916c62f8d4723034E7A7310cfCEdb9",
-		"0x0Db0da204eFf0FFc2A6D1fC7d5E83D7846A862c7",
-		"0xad01A48076fb3D1A4a3A09A204E28ba061df716D",
-		"0xF9B8F0e48939D8Ad785D5736457Ef5a78B9D92E9",
-		"0xaE261aBea8A5e52b897135709e7D0d2536a3e793",
-		"0x8fE9745B8603887827033F45eB93351414a97550",
-		"0xb3ca41D538998f884D9f8cE33703A7f49F112E98",
-		"0xd95B8d039C150A54945207D2605eD92450bcCb95",
-		"0xB13a639727a50a28FDc600DbC741f26F2332740F",
-		"0x74b2d1f992722c941098d9aD3e9e0F28fbc84dab",
-		"0xdce5741a14C1B67a3D84Ad85F662E4DeA4320665",
-		"0xe4964c56f46063F25f2a77269B36a773140Ab325",
-		"0x2A6043a292B0E9495EE7A9b4Ca855F3b08D815aA",
-		"0xCf126a453F6B96fF424Fa5d78677e6671D5B9636",
-		"0x0B3576C34b2574889e7e456Cf2ABa965B8443b49",
-		"0xCA3F1E755BB2d36F8Eb3964562AB1Cf40A75920A",
-		"0x09806A23e8585182299750867934767d423F7B09",
-		"0x5A94eb47cA469830b2F9f56eb9cE9D6a11Aa9FCD",
-		"0x2cb21376Dc7aE0b7D1aE90975bedFE7C6B37B327",
-		"0x4DD45e2256A1914BE622Fc3D7d4accb8dC3c943E",
-		"0xA3698E208c3784895CDE220c1efdD726148AA3a3",
-		"0x83F7AfEDA326ab4d2d31C357cEC5Ea3539f03c6f",
-		"0x89C940d38dc0FFF95046977d23d642fBF675eD4c",
-		"0xF929b1072d88e37F49c58515b05286610293e6F9",
-		"0x9fD8b224B8e4f34c6eef4643c22669F1bEe3006C",
-		"0x94338021cC43c35a621434272c0437D3325E7D5e",
-		"0x0e313382017C2034edD51EBb73429C455aa9fe0E",
-		"0x5F227dce0baaFECF49ac4987fB5c07A993d36291",
-		"0x68985C601133c7be474E5d2eBa08c57cD128a642",
-		"0x6d0d3D13A478070faC4C281a7D3a3647f3D9A4Cc",
-		"0xF909649F05E26211eC16D4131349492F066cAe7A",
-		"0x292D678cbfb051a63F3827CEb00FA9a9D6A6eFe2",
-		"0x53e0e51b5Ed9202110D7Ecd637A4581db8b9879F",
-		"0xB3ca4D73b1e0EA2c53B42173388cC01e1c226F40",
-		"0x849b547BAf4404F6cf5c9235185969391860F40c",
-		"0x11dfb849285ceE04b331456129c8F5783B8D32C7",
-		"0xF29acE1FE5f36389d0dDe450a0195A30c3770245",
-		"0x62c1A0d92B09D0912F7BB9c96C5ecdC7F2b87059",
-		"0xFfBe36E6edd8422351b22AFf5ac8121dB556fb3F",
-		"0x27F1Bbe74688CA26041F1D8AD3741219750AaF22",
-		"0x4f26F5acCDADA1B4e76cedC7338C55f2FdC33256",
-		"0xE037d12345dA559C2Ed052Bc67545e967b8639bA",
-		"0x0b75058c129f17eA40e5D9b3A6485E943ddcaBea",
-		"0x4eC3432d9443f05022e2Ff4E54fC7514BE2359e0",
-		"0x44Bd27A4ea98b9855AAfC8d3e5c9DC37b5Ec6f23",
-		"0x98Cc2Cd55Ca2092034146EBD8eb043F9f976623a",
-		"0x754A84a1F7aaaB47C353283f4d07640fB153f739",
-		"0xBdd7CE63a3C438fbf986BE1E5fa091E553720c4c",
-		"0xdef145C89EE38d8e86fD40A9af466038D8f0252f",
-		"0x5B8e040510E1B9Ca0265c1cDc61d5cA5ec4A6A59",
-		"0x2B8f5653475d8528824DC551a7207160Bb789A8f",
-		"0x2884439c046836Aee4fee4F270cD163b37da55A9",
-		"0x9eEecC93b114a016257199F85489c608234F422A",
-		"0x70D319F74090d3BB6bfEA496Bea73692C59B1AD1",
-		"0x7A68FDA8EEc0ecb32DBC5B6A812A5B1Ad7978C15",
-		"0x96009c6330CBf561Abc9a988439c98d8Bf9686D3",
-		"0x9a5d0fB31Fb21198F3c9043f65DF761510831Fc9",
-		"0x5d45533683F60B05FE9EB04898B8f874FE7484Ec",
-		"0x5707Abce3fb176e34797602292B70b81De1707dc",
-		"0x6B45064F128cA5ADdbf79825186F4e3e3c9E7EB5",
-		"0x02D6bEbeE2bB75ebdbfEE4d25bd8fD281746FBb7",
-		"0xD3a5d478c9a407b357269eDB1557004704c4Cee0",
-		"0xeC65EFD8d990847E072333B0b832aa2ED9aE1776",
-		"0xC2426F2018f13Fdc5b260Bd0A88be753ee7FaFc5",
-		"0xDa31896795Ab3d89752A52E872Ea3ebb5247c24F",
-		"0x2FbE952dd04D4D24f960ECfB09a92FBa70914484",
-		"0xA968b236b0d5a38a30DBb88CD973A78Ffc19F861",
-		"0x493Fd12fd51554A2c1817D6Be2c7F3CB35ee8C2e",
-		"0xF926e6FeDc4944e44a115EBB3AcFE00236F89aa5",
-		"0x240D5f55aEc4Bd8927DA2fe31ce75c64a3C66eFb",
-		"0x9d57e4ac9145795703CE407cD4D378525bfe7B22",
-		"0xC8595392B8ca616A226dcE8F69D9E0c7D4C81FE4",
-		"0x42280C25391d5A0C4dEE8CE63596000242337835",
-		"0x073696030E75Aa269a7cCDD5C625bB49E2176c11",
-		"0x7Bd5461A20604B429E3546e375280D1311969d97",
-		"0xE6D27d0182fc07Ee7C6B17e59A7D4d7926543b9b",
-		"0x03A6C4E35739903ae1Dd92516035D07da06D056E",
-		"0x400913a196BfBDcE181B0Fd4e910b7bAD8b578F5",
-		"0x936e4fD4ADE51d694c6dF68987fd90dAA68058cc",
-		"0x8404d326C4BF82075C50567123683BF798C3725f",
-		"0x30610ad6c220738CE4964448E8be6EE737d19A98",
-		"0xB099D7dbC415bf5491B0ca6fdCcFddF8b7EDCE12",
-		"0xeE97acdBeF6424d4f98b39d96752C0bb92eCe85b",
-		"0xfA1e7160B908F0c34d7B6d7822F41310a6F5b0A4",
-		"0x611491938a3a1aB7df8bBd7e17e11dd1F9eC7824",
-		"0x0AE84c1A6E142Ed90f8A35a7E7B216CB25469E37",
-		"0x173DB0CB2620D26B58A2A9797AFc8e854143e0f9",
-		"0x509c97d4A7b98C91f1264304f228e3639416F118",
-		"0x989e555316F2cEF1949d782Fd875995F83b65FA0",
-		"0x540501A2eBDbC62Ec646b03Ee3B315D6E1708Dc7",
-		"0xD1D2052246Fe24b19cd15601A5Db4B2750bF1E53",
-		"0x8B4FDc4f7cbe2296Fb44dAd943Fa399B47DB9C05",
-		"0x70Ce114cd13a4e1d1B72Fbe00D6a0a6ed4b37B59",
-		"0x0AD0909a5163F03623E5CF5528e247DAff554d40",
-		"0xD7b05f15be01fFa97E5Ed64CEc8d99636049d39D",
-		"0xacF3093C3E8D63e51f9B901f1297c569161fb40c",
-		"0x3870D09F59564d8b86B052b1FB1e27b961f9BC73",
-		"0xD2892d12ee6f76564DA57792D97ae0c4A1fd3a45",
-		"0xD3C5617d60Cab1121Fb5c1171584139A201a739E",
-		"0x2F30baB00425D480b5E00c2172Cd049F7F01eB64",
-		"0x6b85bc3dB85d4Bd1fE511F21ee33cEFbd962ed6d",
-		"0x10a604B4C77C7a5E28D8D90468f99c8BF0b634c4",
-		"0x5588959dcF0b7587b0d877d5Ed29B77bFE8E730F",
-		"0x95A37CB2618B82cDB09F6f2F4c880C45972D3e9C",
-		"0xc25033218D181b27D4a2944Fbb04FC055da4EAB3",
-		"0x31fb1b5dd7d55cFD4ca31D64C5F5d8b8cfD4Dd16",
-		"0x7f5f6E492BDC2dfec3346eF0788bB83Ec99F9f1B",
-		"0xd47A4dBF580FB2C7D745aEDa3C9Ed1BF9AF0d5f1",
-		"0x472bed8dc54E1735eEC6EB4CC466bDFfC9E1FE45",
-		"0x85CD4913537eC4d187fc85150d28A40892304Fe1",
-		"0xcBAB548C95Ce4093d5077FC8C1A5F0387693c22B",
-		"0x0BE43929ACD985CaCF6385596cA283E265C1B52e",
-		"0xbD537A5afBB63295F1cab9A7A670415e153a91B9",
-		"0xef9EB14770f04A960572254b942827f16f67A3Fa",
-		"0x601AB3cCc7B2de314Bc007Cde7D59A3f604016E7",
-		"0xF93EF4Bea974A57d94852E741A27dD8f1a7aeEcB",
-		"0x67f3421e702C04e43512b47b055E80EAFeba4216",
-		"0xC9a0F685F39d05D835c369036251ee3aEaaF3c47",
-		"0xA8E06a0c42ef12d1d07b9Db1E64586d20737ebab",
-		"0x5307E81E2141ddF4f0339e387c888Be57E4f9D6f",
-		"0xDAb669A83dDe77Cf0F3836ae3BEF0E247e64Df67",
-		"0xF6C637ffcDDbdD0BA8440CaCa00483A830005Dd1",
-		"0x1D0EAa1038BA77270EF9698Ec41d26b0d83eb705",
-		"0x6D950294954E9d3891f108e7A991756b89c0Ef38",
-		"0xfF4CEf88372a9139d92141049caD7288F37A6cAB",
-		"0xE01b4151db36D732C31e089D66d703db7250b159",
-		"0x315B95dB090a78079194De404b9f4c17C5a8087f",
-		"0xcEAD1662d943DbaABe9Aa36a2610465009f8Fc4f",
-		"0xf625DF2BE53Cac287fFbaaCbE3A2E5B9d0994885",
-		"0x075CD0f61da233588Da67bBBEcb076Fa56b5F2fb",
-		"0xF6247E67A202474876d9985E2277e9c85e195932",
-		"0x744A4c9c9F40A443ac2A5747D4f3b773e5d86763",
-		"0x9493556ffD7320a2D9b993083274b270158512a7",
-		"0x0Fa0544003C3Ad35806d22774ee64B7F6b56589b",
-		"0x470cBFB236860eb5257bBF78715FB5bd77119C2F",
-		"0x21710E02f466ee8a83B4467Bd7b0f42bdaEF7452",
-		"0x8252296230017A9401f3e0fC56099Dcf3BdD0817",
-		"0xf2f19B265EEf10Ea4c3d86187445A9dbb0Bf0269",
-		"0x9FF4E3c40B200F286934A83ECA8d274B267E7ad5",
-		"0x5009633C920ACf842E3845e3BF4e4c1fC5178980",
-		"0xEf73D3166Efed7ce0F34DdA8cB3C305385Df9e2f",
-		"0x0f09EfbB0Bac79942936Da2c4b8e9A0E36E301B6",
-		"0xe4E3E7f05349F8EbAb9F6EeaDbE4A118E26E426d",
-		"0x5fd814793958f25119946eb3d01f341165885a05",
-		"0x3986a445d66b91188F0Ae3C2136D909161eD2f09",
-		"0x6B00d7877A5fFEe3f986205FE6201c3541af9AA8",
-		"0xB158A623A5e890154980693C218c932721BE2C82",
-		"0xbf7F8332a6C6BA66D11C42De3649E5943fa01655",
-		"0x72059e2C7Aa81d01B4eA0aa3348D6947BD2fEc44",
-		"0x75b64c38e328Cf4Bf55e8D8F1E55E80c893c6f55",
-		"0x60f40fA375BB1BD1293D1929f24C92c243473105",
-		"0x77874f119360EE305Fe423FDf269Bab6a80AbfB7",
-		"0xb3094e4B85229e8339A2408520e2dcbca1d37dBe",
-		"0xD48A648E815f3d7Fc207F06078eD0046F3961601",
-		"0x633F5137eDd80de18EE14f659D3D3304F8Ca20D9",
-		"0x52AA2664774B045011C98e6F41dEEaFC27e60C5E",
-		"0xb8F5c051C61E46CF46967774f7E1a6e28718FCbb",
-		"0x030692D7231a588BAdC72f3A2a089aB204d79Ea0",
-		"0x0B0aa8f1c7d368508e1cb2BEc7283480CF723567",
-		"0xe3292d326F23f32C887c6a4C74489c6c485Fce31",
-		"0xA9D8D4d49E8375f3D1EDF6818Fe54Bca0801A7c7",
-		"0x07B6368a056eFC23f3fE37D0Ee7B1dC755ff2541",
-		"0xB4983D67F69f64Ced61a3C01372D93e8e7fDAFDD",
-		"0x9869674E80D632F93c338bd398408273D20a6C8e",
-		"0x565A2dA8a42122A7c8cae807680a63A1902Fe61E",
-		"0xc4D2221157723d3254Ccc25259395E3Dc90372cB",
-		"0x7EB12034a8A2FD9c0A35Cde91Aa97DB5E155dC2b",
-		"0x3fb4034D846212642a9312eac20DCD95DE67ed04",
-		"0x0928D0631c6DaF0e0f0bA4fD8282b53f997De839",
-		"0x90540AD84F7547a02da739AcEdE2670Dd0302B52",
-		"0x98E43d71De3159E18aA5447E6433FDbCe6575Cd8",
-		"0x11B6dd0A864690500A160a92EF8eDC00Da12B034",
-		"0xA093dEC3E3A9895E5D3F0a30548f82a47860249D",
-		"0x7E88665f2BE01670c375d43bC6513bAF653c0Ab0",
-		"0xef2327E5284345974623D88E4eC431Cee62a9BAd",
-		"0xA5F0f04a2Ae38F28c6A2Cd3d505584B07726F04b",
-		"0x073662D53eb41F1362c03Fe07cC3942dc85f8784",
-		"0xF38dB397CAdD097A0C85ad2EC0aDd8EDE10036C7",
-		"0x3E705b985fAbC4C3d6d0674F005d5d24CfddD1f5",
-		"0xE65B0F7Ad5Ff15Fa94201c2919BDC14D5e8D9F18",
-		"0xFC4B23ee03cb2947bF063d0CdcF21e25F23aa58D",
-		"0xf910f3b0FEBeBa61C1a9Faf302bAaEFE4d29a157",
-		"0x637315757AC58Ee020Ef2e27e24482873011f21C",
-		"0xA4456B39FC8Adb455Ccb56B4587920a3AfF6ae49",
-		"0x6AC68913d8FcCD52d196B09e6bC0205735A4be5f",
-		"0x4538F78f6436cADca18307B73229abfe0CaB328b",
-		"0x24cb7c33773342851F1C4c14a059826C154F36F7",
-		"0x6476008C612dF9F8Db166844fFE39D24aEa12271",
-		"0x45DB4D02d3353148337Cf682c45a5CBAf07CB99e",
-		"0xe4d629d2DF66714eD0dd4EF8e27B3c69746d72e3",
-		"0x044CB36EACDB6e090b44226f5E7832a3eEfE53aa",
-		"0xF246d08Ec48757AD8752Af9b0986a7831Bd3E703",
-		"0x2Fa0e4cCAD9554bFf3813eac05320df7D5034E3e",
-		"0x5A5900485903d19D7f010a64F02864a9C3272E4A",
-		"0xb2F645d57671f346Dae16B725354c3082EfdD0D2",
-		"0x7C64EcCE9C92bd3C7a9Ca0d5BEeA904fc5F191e8",
-		"0x46Ab076929E4fbdFBA490D96D44a47Cf7FCeD06c",
-		"0x140ECe3E81EA384372e89317189E94bF7f30603f",
-		"0xc85527770E3f9b14E29F24Ff71A8570dD1C4823a",
-		"0x1b9248AD376800aCa986DA82837E610E43F7bbf6",
-		"0x1c2E887EB8Fd77a4A85e4660D7536B59F3e90e4B",
-		"0xe81c71579D7F5a4bde7b5389C5D8a3A69D6F2381",
-		"0xfF3975c44abB7066751e4ad45cDC0E94398886D1",
-		"0xc515d10Be9Ce6F084817a0fBE1862cBbbD4a4285",
-		"0x954E8FEA816201929447E5806A5BD0808A8801E1",
-		"0xFa2b29c2318e7195c18FeD608E09Abef3afc236a",
-		"0x9955610A29d1eD88704A727f242Ba37aa5055CFB",
-		"0xaA3F26b5A37fFa6323246123AA9f85AC3Be99e78",
-		"0xd646E168D59B317036D97971183a35223d31f7ef",
-		"0xC0f6BB66e2DA1d26a68e11e046C19E29dB484ea0",
-		"0xCA46003f47bDb18f2846A743d67F0C10261Ea4F9",
-		"0x53aEeDfdFaE2b5461457764E85e377b4bF49f358",
-		"0x458e2F89E3abb9B3915d29eff2EFcCcc7bE10993",
-		"0x67F755137E0AE2a2aa0323c047715Bf6523116E5",
-		"0xa2c5C324E0Eb1F0C9aEf6A820f8D29540881F2dC",
-		"0xFb059794aa72382474fdABEA963f08d2B3D1B58F",
-		"0xa4c7928E1B6EcB8b3464EE875EaC66571C3F2f06",
-		"0xb34DA672837aFe372eceF419b25a357A36f59F6f",
-		"0x17f4a746A7BF05C3e24A2BB7d7d25e4d3e5BBE3e",
-		"0x20B4143821c2Ee4ecaf35A2fa1D8456f4aF72DC9",
-		"0x92680A6F071BA355EA19F0063723486DC4ccd7Aa",
-		"0x9B7277e2b6dE4Db23Bf6a5CC63F5C491425ea22c",
-		"0x1e92B655F98e7Be5794367D3229ED0057628aE46",
-		"0x334F8DB1336ef1956Bb00aCC951ceB03784b3b07",
-		"0x0DFf890124097491d0b1582fF181e712ff679193",
-		"0xFF5E4bE7147054fa69639D22B84794f9855477ce",
-		"0x2813203479c8D52a0a7BF4620b9325a753Fda9f8",
-		"0xA2c24Ac72EFC40b6921c11ae0fd75f6642CC9988",
-		"0xd6429EcaDA2eCB126Ce6C16F5cEC7a42609897c0",
-		"0x9d8866e0AAFf915B82227e9C4bE0f0AE21937361",
-		"0x5F75d4329148764539cE28Ef62fA735c8ACA0a4B",
-		"0x9695906B4502D5397E6D21ff222e2C1a9e5654a9",
-		"0xa8cfC71634285FbdAA776Fd48756015a469F586F",
-		"0x3F814625Bf710E83565a52cbB460E089E0C034c2",
-		"0x4169Db906fcBFB8b12DbD20d98850Aee05B7D889",
-		"0x0759c10C7CDD92388a7A7B2d540A028DdE9Dab81",
-		"0xa7329fa0f3f4F2C2231292E3BFaE0f6AD3355d45",
-		"0x3c38a5479EcEA68DBEb3FEbd295266A19FE27782",
-		"0x976A7B475EA70e28A49E35d5db4aa2c78c33a089",
-		"0xEbddE1058be2D4c48C09E06f97C475a5eA839391",
-		"0x047D1856f9286324f7E3AdaFc0C1e025dE1a35d0",
-		"0xF4c2F68aDee2c824fd79F2Df414DA5b4d9E91B1F",
-		"0x9fd7764e2303E7748736D115304350eC64E403B2",
-		"0xdDb716ca82E96253aE88Fb9A976f5bb05b93324f",
-		"0x104f7a49c12a260B0DAA2459Dd27A985FFB43431",
-		"0x331d857ad39B8721111d8CbDf26002C4299e9783",
-		"0xAF63942498807ecD4C582581118a421dC46C0A48",
-		"0xe24b39bAFD326B012D6139C38905A8D6eF3a4662",
-		"0x3194403EDFDA78671289b251Dc75913984AaFDFF",
-		"0xAc208907d258637f0B616A66b88CCcD872da49b3",
-		"0x9675ab739F526e45CFd49C5CE359d6839AE31fdA",
-		"0x425194E48300D01F03541075931845f56bab4930",
-		"0x13d17451f83c25Da090A20C9E25f25A2f72ce5b6",
-		"0x170986eA2c7517bd9B3083705A2eeaF6401ee23a",
-		"0x0194E4a30F6DF6eb04c3F83c4837232c77F9B926",
-		"0xC17425514704dBbAC3EbeD53cA379021feC5952D",
-		"0x59a1F9b461113b0547b7215eE8b9d55B892B150b",
-		"0xF7F8f1054Ce54114887CfBD20FF4a24706174FF1",
-		"0x7fa85d9c88AE1F7133041391B585D0Ae7dAb4434",
-		"0x589634784Db8fE44d4DA8F9125642DA2e69e977F",
-		"0x0080F00C277A35f971b2E42766F376548BC7d536",
-		"0x022C703E11576fB5D740bcd5dD13F4A61B36f9E3",
-		"0x516b9DcF0C89D307D033b6d5a6ec1633a0dF10bD",
-		"0x2dA4B481026C1671E6cEE32A462607c37c9FedD7",
-		"0xF81Fa4fA444BC9e736BE73b314Ea06Dd80ac2C4e",
-		"0xA0518E138F0594f546b4Ce423376ef587Cd3A2Ab",
-		"0xF938C1735A32F4288CFEEe0eA60bd7e89E5aa0D9",
-		"0x63530632e8fE40aCf8f1f4324f7645256263b64f",
-		"0x4C5ee59D359FD1C84f2b76dcE042AFcF345C9E4C",
-		"0xCcA81b6E4189786595f3b663315c6dfca7c95FC7",
-		"0x1355A5E85bDE471B9Aa418F869f936446357748B",
-		"0xf1FAbEF358Cb96fd50f307861fCA5492cCE12B56",
-		"0xB83C3C271b082518A95e64805a78dE8dFE86A661",
-		"0xCec274180eD52b041e4359DfC016aAc2642E82e8",
-		"0xfD49F164018811e4596FF8575Dfa74EEd2a2cb50",
-		"0x670aC159373c205433C50b63b7C5D73374557740",
-		"0xc5D7F15324245eBbB7be0FF63ff79f3448abD101",
-		"0x306b5284311DD78e9c3E4591C04828c99F2F9690",
-		"0x6540Bd387b21E8f6A5DFF462a0c2C8D98057f034",
-		"0xf9A3aAE2930a47f8F5a51ec69E0B5b6ccE796477",
-		"0xa335c00f8729AAEB3e71e4845505C1Dc4462ee26",
-		"0xbddFEE27ca7068dFC3432dC3Bee41fE46c4F1Bef",
-		"0xAB33f47F710804158101Dc0727532be21E5Ac603",
-		"0x7729E73eb80Ac48e5880E7e4cbB75495b8190279",
-		"0x2DB482b9e0B73a7f68E9cc43bc22d9B6eAa120Bf",
-		"0x8a0b80392BEe0b895cE25981Afd5caE34E241824",
-		"0xc94de6C3e9b2057acDC1d79e9f261a1F8a321F5D",
-		"0xAC11E1B764a0E3B266c1e4b3A0BC7D0C5AF44bE6",
-		"0x7e9BA7DFcc0270aAA4c7aa903BfF300c46226f43",
-		"0x4f8Ba59a5e17cFea20F0Ede44dFebe1Be2936bF0",
-		"0x72324FA4B1c0e8996054a067Dc295a9bF75dc78f",
-		"0xe977Cb6C10210A08a1ff0b91168B54bdc5776cCB",
-		"0xF36995139Ecd8d7C6265670e5A1201B8E3B62E22",
-		"0x2969a7a1C1DA5a77C05e21869fD90A2a52d4153b",
-		"0x04076eF269eC3F01cB8b11171e6279b3c4B4E689",
-		"0xf19a873C13E219006D03507dfA02973538764553",
-		"0x1fDD56AdfBeC55C1321eAFa918A9423a2c46E679",
-		"0x3c6681330E4450c35F4a7e96931d37086716E523",
-		"0x33141e87ad2DFae5FBd12Ed6e61Fa2374aAeD029",
-		"0x0Ca699158FB8302c9939f26a033ebB0808f741fd",
-		"0xbF4c0d66Db59Ae8276e0cB7E1Ed36fC4Ac8C1d68",
-		"0x901c0967DF19fA0Af98Fd958E70F30301d7580dD",
-		"0x53299BF3476B1a0ea9B11598eE37076a116Ff19E",
-		"0x77138e515c91c7264880bAfd2B8a610d16962eC8",
-		"0x7B4c09696B0ba6e1DB03763Ac0d3b1127C6763D5",
-		"0x4bAa3F9f24B97aa67B0A0f080bA3A9c994c6AE93",
-		"0xFA7FC3eEc36c4F305B08BE06C466e2cbBBE51602",
-		"0x6C1369f4b62fbc6C8E1D612d9d4F56aBCC587986",
-		"0x39255DA12f96Bb587c7ea7F22Eead8087b0a59ae",
-		"0x1956005bA9690B7A00b09c78769675C0cE1457AF",
-		"0x59aDF757FC36dc5bb676B609C619daEB51D39b0c",
-		"0x3cBbe49C119e71A3693E324Dd77100DD8CeA415e",
-		"0x892Bf9Ce84E2B13732581eFFe8Bf0fbf0f7AFEA8",
-		"0x9688BbFEd5D3a6106C52596c77Fd3d41deaD3f64",
-		"0xf520407E9BcdD806B2415af433DF52f7Fc2Dd991",
-		"0x1D033A37577a40518be21d57F7B5331b2aD5B515",
-		"0x879EAD67C92ec2bFa70fa9d157F500B7b31b64AB",
-		"0x08db5361d0051A77F4FF7c570cfF1c474060FCfC",
-		"0x1cE0BAB195aedbC2ad32FCFE1301d7a6E92FB3dB",
-		"0x1CCe2BcA5712160D68888f61aFC3114AAc4dca12",
-		"0x8d5d7839AbB34B4ef3ad6bD981a37488b6BBA439",
-		"0x2ed640D902616f21e3A2C38c2648323Ac488C814",
-		"0x69f53DeB161040F0Bac64220db931dD01aE330Bd",
-		"0x0Bea275bBA1b2d344F5A594207964Bceaa0E3350",
-		"0x770A0d6150cd5A6E4C9F3Ba27432bB3B4FD08832",
-		"0x3aE40a98632578140f350219b28004c03118061c",
-		"0x44fd2AFEf1B57fCC1A8Dd409A8009124a889385A",
-		"0x4cd0244DA2f51fDCB70028a8551C71bA28Ff0413",
-		"0x7211A977306bd046234F5A9b795003edBFd452E5",
-		"0x82F4345E728beC537C08d3b82A825DC228F1b172",
-		"0x03D5933A28D8b97B08525045F5602F2CE27aC784",
-		"0xE74CfFDB580d4584cE79A94f9e586274eab59364",
-		"0xe4D96Cf7f47656200FBC3b6110DC8E785216EF0E",
-		"0xCCcc90D2d2f3d3a0B90Da3Bbed6Ac8D0DfbbB069",
-		"0x5cC379bB406429728Fab995B9C6Ce731Afa976b8",
-		"0x097cC8c9ba4935Ca13976C664c0497567611F4a6",
-		"0x2e07C266f652095E2ce988C759AFE9Cc2Fd9Af8c",
-		"0xdf83b40f905e9BF467F224c910D06dAda96E07bc",
-		"0x2eBa135774F406BfdF6F28e874A125D77B3E29d8",
-		"0xcDAeC65495Fa5c0545c5a405224214e3594f30d8",
-		"0x811089Bf8a34F963672811852516199188a42740",
-		"0x62ea7425Eb52725c8a5fb876F09bA600C3665953",
-		"0x999d08e4A2825d6A6b9454Bb62f228956C7ed6cc",
-		"0xd35927BC6b13678EE33048eF92C45b9CFC43F5dc",
-		"0x6aB5b50C674Ad724c3C3B6a8d852c5C07AA14362",
-		"0x48186E0a949EBA50E7cb9FED8F50AD02c240d628",
-		"0x7Da7927cD7AD4DeF90f084496CC69723345D7a42",
-		"0x6Fc86482379FFfb9Fd829f4fBe33BBd47bA07A87",
-		"0xDB9F1e7738c2f2aE89C520f76F2937EE52bC8209",
-		"0xDd32a4Ee93a83Ff50D219BAe069234eeE3B95Bc8",
-		"0x98410a884F20392778DC3eAF99581B6F923D1933",
-		"0xBcfA32CF40d0139084e42daAf00bF914e221fD7e",
-		"0x5b599155aDE1F59f549a4a1297ddb5A4951b1B27",
-		"0x5EF29c49e30A4de6bc01393521F7Cc4d517E731D",
-		"0x7ce836317204567e180e34e141802301C4208103",
-		"0x0267Db959951f145850910CcD394170A34525b1E",
-		"0x92B26Ef5998C73236d8157A437CA09ABE6df77f4",
-		"0xC0b4cCa71A0Ba3E96631390Ebb9A370BD95CbE24",
-		"0x931a2CD8003B6AAc060A02df31f451BA64e850B7",
-		"0xfaA9119B13D7567abe98Cd1A4A6f591B94fbEE1D",
-		"0xc5511F7C38a5DDCa62c11c4F9183556791548860",
-		"0xC494Afbf282f3E56D66a5fAfAa2a28A4a1Ee3118",
-		"0xeB3b47E033A37FA5E44B8e75C4843a40e7B4B20D",
-		"0xD56930D79f1F805C135fa1a4C309a600131dE69F",
-		"0xb8717aBa8D1BB5d779b7D7707b70a64B189DE080",
-		"0x860bAf682f9512FF11bd87ebbc33Af5CD9cf4A8C",
-		"0x20a304a7d126758dfe6B243D0fc515F83bCA8431",
-		"0x2Ec705D306b51e486B1bC0D6ebEE708E0661ADd1",
-		"0x9769aC7Da62f2419FC99BfE7F1427BC501864871",
-		"0x2249019cd814ed66C1034a8E6Bce44cD1D464AcE",
-		"0x45a6B8de03494d6d673050b81B021ECFE422963d",
-		"0x3781A018b0831eB4ad3d819703981DEAE3cfFe03",
-		"0xb3a5830a7a70669Cf4cfBaAB52515737670aD984",
-		"0x45738C687B1704bef8Ddb67D32Fc63a55638e5B9",
-		"0xEda3C1e85c7bf8ec9094b6291b90Bc355B0047Df",
-		"0x4Fb52fa0Fb86AcC043967A9A3aa002F304a2cD8e",
-		"0x635FbFB990895583Dc098ac6b0c6b830F0F7B6F6",
-		"0x4a994da131CcE3256E48616720ea79aE94391b44",
-		"0x39037C80c38Cd7bD93EBf1Acd34C73f553002bd3",
-		"0x1A5B58a60CB63693fbbA1542AAe71112b4eC16a1",
-		"0x058143926705D95350C3B2eb368D77DE08d74755",
-		"0xa0a78208A5893C3fe325ECfDf16959d0B2737446",
-		"0x1033E2dE84F1342D56576F01FA0971fc403FdEC4",
-		"0x3AbB7B9ebA58770F84861adD4dF5aFd9C796b9dB",
-		"0xa5A13A64e529F533d344E65d39bE0AF81ae4aBbE",
-		"0x9D15d0d737e06a875F3d46621fB52fe79ffA6136",
-		"0x071574D20Ab4764A3a2C3BacfFdBa16218c8b320",
-		"0x1cEa6e22e6D0d1442876c9D0C467cb67D776C266",
-		"0x96357C5e8F1fA8D7D120a0EAf437E4Dc76A304ef",
-		"0xe87A5318b4117046C50213370591E63F7eCC14d4",
-		"0x8Cd204dE298916EE6278d82014D696Cf2f9Ec228",
-		"0x9aE8082DC884BE36d2e92976aCD348Ce647DE99A",
-		"0x47fFdcb95ae8f0540a22Ea708F43cB18d083F370",
-		"0x5350Cc4C63c6f2eB903544132dfCF90D9eb97aBC",
-		"0x3ce280971a934384111d9369af8c0b6F9f036201",
-		"0x04673f393bA0e77491CB33a3ec6faE84836F2380",
-		"0x45873876897F8E79AD925D27B62A18BAe36F8591",
-		"0x77a3A4b832763A34a9757c1A1279BE633222ce6b",
-		"0x108498523DAEB3480274d82E9fb0DCBb13865C31",
-		"0x6331958fE15E2Ef35A333bdAEa3Ac91932B7DBfd",
-		"0xd4ae6eCA985340Dd434D38F470aCCce4DC78D109",
-		"0xAa5f24519dfA6A428faa7c12770f635b97591B31",
-		"0xa6340fE7D9fF3E55D614c72356689Cd3A054Bb48",
-		"0xb6c9c58873a8bb1D99B2f045Bae305B2C0Ea68F9",
-		"0x26A492441427BF8Cd7Ead64813EBaF9D13427CE7",
-		"0x50989E02b78c6CcED31b273B8334610B9F63a295",
-		"0x9b227720516458CaeE798797Fcd1Ed986c4AB703",
-		"0x31e63bcD9829EbDB8dB68dcf2F218Ecc01676267",
-		"0x17e69B8bbBB6CFF94F18325dBD26D6c97826FE2b",
-		"0x1f3F1ca0732463C0C103D4eD2b924eea658C7dd9",
-		"0xA74eec617a92B98775efbC1e95b7a765b69E4f3D",
-		"0xb4f5A2a98223A8f5818315336Fac9E2dED50c491",
-		"0xE91F720CA3eB35e99fA5dbB77bA46C44F6ea26D8",
-		"0x255EcA0C8e6457c1A94fB7608e8f764CE9842097",
-		"0x220785dB476964b43F710681996F6D49EBF666A8",
-		"0xD486D0846812266D21e1Ab6c57FCF202DF836dc8",
-		"0x655dB694371C980Eb8eb9FDB54aa0747a85C1dc9",
-		"0x2f54966712d6D5a643E5B91c647eCB2a867132F6",
-		"0x87cE2b6B0745161126B6cFf136D7ab66268DC994",
-		"0xCD2F7C5a98C04090e285d1874Eb802Ce103Cb35a",
-		"0x41ccEe5908ADC14A448A9f99B91fcdFc41490340",
-		"0x8508d61841C15d8d9563a4b884F4D2AD6D9F6674",
-		"0x3Cb18fF114F948f92d65858418d909425AfF16Fb",
-		"0x34a4b9060438c4465c8C2A25b6620C5c614635BA",
-		"0x89Fa1974120d2a7F83a0cb80df3654721c6a38Cd",
-		"0x11a2e73BADA26f184e3d508186085C72217Dc014",
-		"0x47c8C4efae4696AAc1C09DB8912032e0bAffdA3b",
-		"0xB6E87C0cD75DEFc3Eda9D001a4951c6aBaF51B95",
-		"0x72132F1344D9D09C2a6BEfFb9Cfcf5f7379cAe4F",
-		"0xc80213fDe91568788608616795250a17Be125EBf",
-		"0x57670B267b59139106fA795ffeB185B20FF96684",
-		"0x72376eE645bC50E4B23Fd57864Da483f8C0112Ce",
-		"0x210f11B28abD69249141770E7145a7E8Ff7526b2",
-		"0xebbEe840F9b034993C72ca37c3158e7F0ae852BA",
-		"0x7b8A01B39D58278b5DE7e48c8449c9f4F5170613",
-		"0xB971eF87ede563556b2ED4b1C0b0019111Dd85d2",
-		"0x32681814957e0C13117ddc0c2aba232b5c9e760f",
-		"0x253A911e560c0BBcA9c6eBb416d88Aa9db7E03d1",
-		"0x24330e967d1CFd8Ddf608806C063ec0eBc2f304B",
-		"0x87F540906417c087596Cce5A201b2320bAA00f5a",
-		"0xED4BfEaa8540836779cA330D3994929B05dce337",
-		"0x44865f3cdAc61f421eaEC98a460ba39a809172D9",
-		"0x11ba4F4A419Fc73bCF59Bf2092Aa5983b4D50cEa",
-		"0x47715f01238f52fAD6FD6cA5822db4d68F1F35C0",
-		"0xb5A75b1DDb571a26B7794D78868CDdC23A1CD541",
-		"0x18034aA1FaC89B72d4B314073CdF44b3cF9d52bc",
-		"0x01DA755E5C278a2FD1dcE3723752f256Bcc7A87E",
-		"0x8c9e8325ADE9243a86316d3562fd39D536168Df2",
-		"0xb9c1ec45f7269abd252f2263bd91f81f3Aa601CF",
-		"0xfD5A4151Af87284da6072f62E33dAA205554C08d",
-		"0xd34e851011d1BCf8745Dd227781b5be95974f158",
-		"0x710856Bf65790f7807682899f94fE1fb1adFe2Ee",
-		"0x4EEdcdD79C257C0924D90Ae6c53F21573218156b",
-		"0x89fc437d346219c527a45e0025Cc15F5C1Bc5720",
-		"0x85da8a87C9e7593f3b4EbbEF0C9571Eaf0df0514",
-		"0x21a46Fa39729f3016055E1a01cABe4439Cb55cd7",
-		"0x88370fD148f052bB54bCdFE76AA37A5172f4Ddb4",
-		"0x36f2c06A5C5564911F6AF7053cda03d60Aeeb5Ab",
-		"0x0508dBAA0cb57C1e50C1AA79Cc48f30743dED5aD",
-		"0xE04c84057D3521eb274B14C926a3216A050906a7",
-		"0xE8C1C61A23Dc7b1C0BA96ee8C5de112fAc1024DB",
-		"0x254fDc4E98E347eAF3Ca978994F7AD9145fa00Fa",
-		"0x0E155a98eaa4931421b6769C2B6f51EA24Bef9D8",
-		"0xA0d09B7F6866507408d28d32E1485C28dB6654DF",
-		"0x7Dd51e0CFd5946F93659fDBCEB60c94E534AFCB6",
-		"0x0e632Bf81ef328bC89Ea02944c10f72722c2Add3",
-		"0xA83155595546A5DA24A260CC56bBC2b82758CC71",
-		"0x1d17b0fB5989318c9e7F522A2162C5B99289852A",
-		"0x13f4EA83D0bd40E75C8222255bc855a974568Dd4",
-		"0x844BC1496c2E12f24AD11c61F75d6f38b71Da51e",
-		"0x99457C3e6112B802C790F62dc21963CE9e2a174e",
-		"0xCCd8281Bd7b39bbA61497212d56950451EBD87Fd",
-		"0xea1CF3995A5977375f9Df5E18Ba42dA9A9B2A34b",
-		"0x934AD72eEd420bfC7CCE614f7E9b8Ea8Ff7F694A",
-		"0x894dEB5840E146A1c823F7e0381F1B783feaD342",
-		"0x13147820401f455e3318db8686958D457ad2E7b0",
-		"0x28AE1E9501273ad2454cf1d25c7Bc15F16914674",
-		"0x244B3a18C6C2bc4DDC430A024F3F0DEbCDfed214",
-		"0x238479d9E64c1a040Ad91a78E832737fC59D8B29",
-		"0x645516a9519CD5DA39e7d309d1bae9a4f2b40844",
-		"0x8E197cD2E299F6EA02300dCf381DD04bF08D9749",
-		"0xcADC24Dd1447163bcff71AB815413205300C94dE",
-		"0x566FCeEB419C8a42feb990c62038A9A124A7343f",
-		"0x3c8A66cA8141E2B47BEFF09Eca068bd1049F4e70",
-		"0x99273A590E9D11076AD6cAfD2B2f8706B6B11837",
-		"0xA4DB99e60400aDC69d1c1681AE5d8844C9Fce775",
-		"0x92EbeD756678634AD372F6FE7e1aA271C8cDCd5e",
-		"0x12cf3cAA170c05148e24C790E23B204693254d74",
-		"0xab6D9d991E0420B8A8b79f328c5Dfc8C8B502278",
-		"0x2A52419c6fbD249F7Ad0Ce887E1a793765d177A0",
-		"0xe30D25D8ef5178B7fCc836De2E811e4fCDF01718",
-		"0xb3d815bBA0E6EF099acfc51062C666FeAaB35AEd",
-		"0xDf0Af06E4d532c3297cBCe05445fDe16b45bEB17",
-		"0x46797F24f555F83D03de895feade40c18D28c1Fe",
-		"0xF5C19cE97D3C2eb913AcfcC82C0B30D72Ae49FfC",
-		"0x9a04b598CA32D3e9CDF366f34B2B4d41EF5f027F",
-		"0x8C6B251DfDd2fd5a83780Bf3Ef090812073D6816",
-		"0xD87B2556B1c4ce71BA50c35cB25CcC865eCFd558",
-		"0x622E94DCc718e0809bAC23f2984011a6219d673A",
-		"0x8C06ac03783647C10ebc42D9Ff2D8d9f4bB314Bb",
-		"0x56F7a104d59Aeae585757cA91F809FaAB0E26B1D",
-		"0x41467F4D8C54196228E889843e625e23025F700d",
-		"0x3B980A96258A37acEBd3569B02D75081a7fE9195",
-		"0x6B56784Ba87EEB6D9b0B6289780D3A164e6757A3",
-		"0x016073980B9C355Ab36de19E4b4030A1c9409603",
-		"0x5AB68C322b49899292c4E38A17cA85497719Aac2",
-		"0x8f7A246CDc1E47ACDE76613E8bBD372e569bE98E",
-		"0xB3f86EBcFb1444976a644e73d772d12bf08CAda9",
-		"0x38e707b495526b9ADEb52E6DA7487569863FEDEC",
-		"0x091F32841819F3f580c24A8B818bf75D3a8Eba78",
-		"0x7F884B22A41a6a3c03983DB948e7460c1F7BC9D4",
-		"0xD0fbd3AF3B1630E231843e4Fd12010D92f8AC129",
-		"0x59E1d068eD03dd79328B24D0B13C2BBF1ee81d06",
-		"0x21231db2e791f0b4A5CedAFAE6d631911F12845d",
-		"0xc0Fa0d61C28ab5AD4AA75A4618D0e74120e72dA6",
-		"0xAf252b3A8F8C091a8bAabCb7A345cB3F065FD90c",
-		"0xC6Cc0a53845F60d0310f02D17D4BEDC36D8997Ba",
-		"0x72D0c8cE95aF737d0BDe307af7dF9EDF13Ad7Fcf",
-		"0xD34060c29338cA5323BEa9A493051Ed2C16dB995",
-		"0x026F924F3E2E13fFbF985A5F23B0E9D1e06aFDaa",
-		"0x340B0084202d87Adf6c514542d973a1a86B49D7E",
-		"0xF8273AfC87F6517f3DF84416e5c8cbde4aF22A2A",
-		"0xc3FfcB3D5745BbA5ada6928D0F5238830A358943",
-		"0xB6996E9e7e5665BbFbBb71e941597B6791048071",
-		"0xDbC781b6dA48e14ac6b34AFf1E16da2aC55B1358",
-		"0x821090d965011F5BcdEC64d133dBE9F0c2E2C5D0",
-		"0xf5577856a153D28f50C100833E778E208f4B84Fd",
-		"0xF28D0Db79124a8B1A47d8F404806cD2f75CCf035",
-		"0x4E9661153139e7D07C62f6C7459e99cb9240a888",
-		"0x142B88EbBF4031797933De24CB4456F6676c89fb",
-		"0x87D14cb4d647EC28380F0374074FEa433044BC02",
-		"0x9997E9b0aF4Edd7c375666aEB3bA81932D8E753E",
-		"0xeA3D5A6c7e2dF244a3226BceCa818e6831Ca37b6",
-		"0xBDDFA43dbBfb5120738C922fa0212ef1E4a0850B",
-		"0xebC25C81050b22d765b39b8D17a3457404cfc4cD",
-		"0x45936F6739F5ECC46E9bc63ab3dd553067fa0Af4",
-		"0x94f16e0Dd043db30FC813Efb9194ecFfd79AF7e1",
-		"0xe99aBbaaC8923d4E0dE5DC0D565a921A9aCe3581",
-		"0x4C661a0E9819A2830Fcea1E1D66C862bb5be34C4",
-		"0xB5C6bC0728284A32aEeD11d9f7BFACC2bc4B45F5",
-		"0x7D5d9A29298B1A069a5D9cb9A2eb9E065d524eB5",
-		"0xe2a3216373A7700C49b02916B60bA0718ffb4F0e",
-		"0xdEf7086Ff406050eE90c0b1c89616f8e5724588e",
-		"0x968a8bEF199369fbF2Dc1acCd808a867C86676BD",
-		"0x9821bAF31d0F621e275A1533a2ab48CdD7e1Fcf9",
-		"0x3abae5a94773bBF7E922DfF1400254fe005992Cb",
-		"0x99e6a1557Fa597DCe21bfAA5104B11b44642cDB2",
-		"0x6F7C563e0B171DA6E23Bd30e33E7218fD8863AE3",
-		"0x70F2157C581DB58C9427850FD1c9B95E4D725b24",
-		"0x1492f6Ff2CF746559B9E65B27e116a83Bd57C178",
-		"0xcF2E3a026De834c352cF35e721b0bab3d7568ADB",
-		"0xa3591c10c71c196c9f10c9928659775eFf4FC26D",
-		"0x76ab14116029F2A8EE85Aa233f215eee0E4170E3",
-		"0xea835cf2219A0f6C229c215dcBbe75560CcF9Ccb",
-		"0x7bB1eC65eaA3225647B5eb79662eB676BA475524",
-		"0xd9ec5C26750F6caDE5D44DBCB471C55F042643Fa",
-		"0x6B20D6e6cf606C7D6769608d3adb7692d56381CD",
-		"0x84885bEF8bF3e8128BD114754e34de113eDe00ca",
-		"0xE572bBf479F22f81be2F0E34d78f1C6e509580fc",
-		"0x70a6a4dEE1a692E7E2cef649DA3192Ff83CA9781",
-		"0x6cF9E1407c0C9802336Cc308A0aa6cc2c1AE1372",
-		"0xA2c30A81bbdC9363F0f10d3A74ce14Cd8422799E",
-		"0x972fa83d0E52Dc4ee1E4bd2e7589BE36b7fd9346",
-		"0x7cacA044DD034456dCD05b389aC78E4c13269d0f",
-		"0xFa5FCb21bd9db3A5d3691628c10cAcbE513196Ed",
-		"0xacAf38Fc52E202f434A0B13c4A5A388AcF2378bB",
-		"0x885B50DaaCdf75bFD125BC7eeb1e5E7a37294d81",
-		"0xF062f63b1076a448B0cb333Cd264bF4F82473c1C",
-		"0x71530D5CFef739D57F84Ea0233eF5c16F9Ad2D0B",
-		"0x467444a9c010996E50e5BD7b81186ddD78333490",
-		"0x8Aa4cAFA526D4d07B121E0b02485952146C0361c",
-		"0x069D8adF4eFA9fC0060FC3eDda58c3E2D70051d0",
-		"0x94b9b3A2F02f42C4f443df7f097BFE550720a367",
-		"0x5c2519588dF5d732eFa5B8c30948f7029f312F51",
-		"0xd6006Fa2b80365f518851f14E84D05E8Bfe0eD4F",
-		"0xff543aC06E6a914D8f08A0aAF3Fa82572F830e6b",
-		"0x64fd2CAcf2889da0776161f2b68dD1DA348a8516",
-		"0xba1917Dc146168334955c7dAaB73cf079f1C4F8b",
-		"0xa07fAc2C60712B52e91ffad308d6d329D412AC51",
-		"0x504fBAE04BEE6f8cAD077d42fE4Be791E1c6b2B0",
-		"0x9f8560CA3F68e5f5B43F35eE59Abc3ABCd411285",
-		"0xE23AEd682E1736f28832908fED56B3f6ee268884",
-		"0x47b43DBb5456800d5Fc852e6F2A2de127f7C6265",
-		"0x89b0A11cF540f9cEa55165748097D8104c88e4ae",
-		"0x1b32C77A9C121bd49a05DE32C445DD14CAf8a395",
-		"0x9b4c8ec146dD00617CFB85Cd97b479dc315c6a5C",
-		"0xe67D259c6362BeEF20375e33D5456ADB3016cd15",
-		"0x5410cb8151e65e1e8CAB964632d2B0d9A9d51c9d",
-		"0x654F131605Fb2e8DB48bAD8a4077973DfFDDA01C",
-		"0x445F728b05464147Bf886a57F5796FB7aE6d6cAD",
-		"0xbaD461a281b54c927999936cDc19A3f977337332",
-		"0xfAAa91E0E743139cB440E4282897C057D67Ef78A",
-		"0x5ba4A2f5F50A596bba9191e22026Dd48fd4a400A",
-		"0x1b81D678ffb9C0263b24A97847620C99d213eB14",
-		"0x7DE8063E9fB43321d2100e8Ddae5167F56A50060",
-		"0x36c96246946Df91F0BAb0891A7Ae4964736a8213",
-		"0x267F9D6fA6b889d53914705585b7219dB0eBA98e",
-		"0x09315EdF46c84367D8A6EF5e16248139346c9faC",
-		"0x6B3aFB7c10e4757117ae424B5a302Fe33FE794b2",
-		"0x0861055eeDC5C13105de9E75066f53b1908584F0",
-		"0x640A43Fca925247c870DaeB03E4AF9027dd9A2b7",
-		"0xB09c41FBdc03ED0d5901961f93afF6EC56e15D80",
-		"0x04fC1C3FE7C6147765956Eb3321c261d32F4c407",
-		"0x2A16095350CcB7865fbB2d0373fCdcFF3190b676",
-		"0xA993c696436c0665d77fc9E58f5957A9A312413a",
-		"0x53a7b84f073eC1b8E3571BF7966ee2d2F5b59F0F",
-		"0x6da35Ed1F5789E08d17d3BBF0422604B6dFBd97B",
-		"0xc43378a3379AeaDc11F070a668c7626B91909d4F",
-		"0xA9E386aA85a109CEB76A864C71101985DBa8F1EC",
-		"0x6c7E3CB60771D55b3e7cC9f687c8529bB0DBD938",
-		"0x3bDeFc5B9CD74629F3B6E899D3c31a52D323CAFa",
-		"0xA022AC516D453Aa8ea91a1891cb10783e1552468",
-		"0x5be88e17c8d2E9391b81E796Ce938AbD350c3C37",
-		"0xe980DCCB993C869005DC7d74E0cc26D307dBFC85",
-		"0x8143941ea738987c7461592f519E235aD77c6d2B",
-		"0xd29929471230Ba38936D25E1786A96fca95A1A33",
-		"0x08eF16A1C5B80fE2B90bae8eE8Ec63cb6D237d73",
-		"0xe08c4778Dd3cD3cbafe56D9CDdFA020514491Ae2",
-		"0xDEbD515AaFb60386aB5FEf71Dd5E9a0Cf3eCe45e",
-		"0xE4B3e36A5bE5D183A82512706a3df5E092FeAcE6",
-		"0x0e8757DF6cA40F737C93924743c9D4c50497BB29",
-		"0xC21B96Cc592e31c682A945B6beE7ACDf95F8dCad",
-		"0xeAc690cdD391665987FC8AF5d962DCD16C5B66E7",
-		"0xCc7f81170a9604636d96F53df46a6024c9DF6674",
-		"0xDCa329928f3EF256Ea5822BD4FCc008963Ab520a",
-		"0xB548C027387E174939391Ee812F0585bBfe48158",
-		"0x0ddb9D635cB92720896dE709d18069450942dbBA",
-		"0xfb75Ff2B0A4A0F55AADb62F7be43Bc9722d2d837",
-		"0x8eA6450c0616dEF16cfBeB0854FDaa8b64622F06",
-		"0x106e9389E0A205a3d3342618ac3ECFB9752C7Ee2",
-		"0xE6A6431eBC3310AF53e79FBf95B601Ef211fB5d7",
-		"0xA8B5D59E36E26769925B22B73Cb1E2c568e2F570",
-		"0xb86FE5042Ad550A84056Dc0B10F0F1977D87c2F4",
-		"0x13f4EA83D0bd40E75C8222255bc855a974568Dd4",
-	}
+		QuoteEthCoinList: []string{
+			"0xbb4CdB9CBd36B01bD1cBaEBF2De08d9173bc095c",
+		},
 
+		QuoteUsdCoinList: []string{
+			"0x8AC76a51cc950d9822D68b83fE1Ad97B32Cd580d", // usdc
+			"0x55d398326f99059fF775485246999027B3197955", // usdt
+			"0x1AF3F329e8BE154074D8769D1FFa4eE058B1DBc3", // dai
+			"0xe9e7CEA3DedcA5984780Bafc599bD69ADd087D56", // busd
+		},
+
+		BlackHoleAddresses: []string{
+			"0x0000000000000000000000000000000000000000",
+			"0x000000000000000000000000000000000000dEaD",
+		},
+
+		FamousRouters: []string{
+			"0x10ED43C718714eb63d5aA57B78B54704E256024E",
+			"0x7DAe51BD3E3376B8c7c4900E9107f12Be3AF1bA8",
+			"0x3a6d8cA21D1CF76F653A67577FA0D27453350dD8",
+			"0xcF0feBd3f17CEf5b47b0cD257aCf6025c5BFf3b7",
+			"0xCDe540d7eAFE93aC5fE6233Bee57E1270D3E330F",
+			"0x05fF2B0DB69458A0750badebc4f9e13aDd608C7F",
+			"0x50f5679F0CeF71287bD9C7e619960fF9C579661C",
+			"0xbd67d157502A23309Db761c41965600c2Ec788b2",
+			"0xD48745E39BbED146eEC15b79cBF964884F9877c2",
+			"0x95B09c446aF69f385455670B78B64BcBFb24eB8E",
+			"0x325E343f1dE602396E256B67eFd1F61C3A6B38Bd",
+			"0x8f2A0d8865D995364DC6843D51Cf6989001f989e",
+			"0xdb07Ed70aA18FfC8B422bF3D8AF947E937511FDF",
+			"0x65b302EdC264604b335487542559658F79128EA5",
+			"0x3a6DbC31B2a68Aaf5eE6F359d790ccDdb700Add9",
+			"0xc2a88eCE6B6321819D947c9EadEABBa699c16349",
+			"0x0a04fA4A842fa21e202DD07E721B048b466EDb47",
+			"0x238fF9B0B8256830Ac229801C1791C3e2801dEda",
+			"0x730aCC3bBf2443f2EaEaCFc7ac7b4d8DC9E32dB8",
+			"0x933DAea3a5995Fb94b14A7696a5F3ffD7B1E385A",
+			"0x6B011d0d53b0Da6ace2a3F436Fd197A4E35f47EF",
+			"0x6A1A6B78A57965E8EF8D1C51d92701601FA74F01",
+			"0x34DBe8E5faefaBF5018c16822e4d86F02d57Ec27",
+			"0xBA9DF2c143C502A433d6A876C1a291C9FC940cf9",
+			"0xC0788A3aD43d79aa53B09c2EaCc313A787d1d607",
+			"0xb2E8D6BD3ECA4fd91FDC14C43580e80183979F2c",
+			"0x3658f687eee36B1661e2Bc8758e6e0AB704FAb93",
+			"0x71ac17934b60A4610dc58b715B61e45DCBdE4054",
+			"0x151030a9Fa62FbB202eEe50Bd4A4057AB9E826AD",
+			"0x48e611BCAEDb0eFf07d7B7Cfe4cBfED000eCf379",
+			"0x7Dd3B4D9DE913A46E0b386dF1F7d9FAc46865754",
+			"0xB44A8AEb4805a5404a8d20A1294a61C95Ae6F256",
+			"0x410835D82321c2dF3888F74bcE8a31Eb1266A369",
+			"0xA4CE57F063A610290EEEF0564B034278438D06CF",
+			"0x018dd7894DDe11FE47111432c79D2eD23E12E31c",
+			"0xB82D317492b95DADB903D4b7651108528eafDa61",
+			"0x1042bDe2477D8368Cb7b33A6EcaB8837e63d0361",
+			"0xBe65b8f75B9F20f4C522e0067a3887FADa714800",
+			"0x53C309a523316599F9dAd3797860e625dA62F66e",
+			"0xeb17Dd35e47B1a41ba4D86B3506ec1f9b680b56a",
+			"0x8CA43fC055Ae47E11959e079F37051831F850cA7",
+			"0x64E8Dad72fEB8Af8261D377A0a2c1441e3589A75",
+			"0xA63B831264183D755756ca9AE5190fF5183d65D6",
+			"0x3464aFEae02A6f4D657946Fb6c162503a208E95D",
+			"0x1C0a81Cc2383E2f28DF42d7E4b47a09dD9526157",
+			"0x9F088377BcdC220CB0E1Ad15aE6Bc75074beE9F6",
+			"0x2C6cF65f3cD32a9Be1822855AbF2321F6F8f6b24",
+			"0xD2243FBDf88eEB6A3b147e4d73E3bA29f90dD4Dd",
+			"0x77dDFC7BD31EC82D1377E273b2b127a15038D6f3",
+			"0xa3b81724638208fAA03810b391dde879E5FB0c79",
+			"0x319EF69a98c8E8aAB36Aea561Daba0Bf3D0fa3ac",
+			"0xE804f3C3E6DdA8159055428848fE6f2a91c2b9AF",
+			"0xd4B5Bf027d5Ac9Ab43C437959694b26C3A6F0C24",
+			"0x790B780964fEa91fD0E9498a5e68aBF9A7994c8A",
+			"0x24f7C33ae5f77e2A9ECeed7EA858B4ca2fa1B7eC",
+			"0x7E54F740C14cA8398871F5042Df8cE6DAB1E1f09",
+			"0x0c7146a55f976E9A7157F8a832BD4B751e0E6A13",
+			"0x0130F4FAEC538BAe9EcA34b12605bf3e0a6Aca42",
+			"0x34766241a5DF0483545A52AB1DBd5eC88E251dD3",
+			"0xaA8902273ac49680a412b5e8bE31278e6e78CD2f",
+			"0xfb9675e9c707f88283a5625dEd560be1eea9738e",
+			"0xA4385646517D9B34aDa4AC393a64Ea120d08408C",
+			"0x062693fb996cC7c3185e0Aa963f514Dcd5304760",
+			"0x5164b689eBECf0F5186969D410919092620C1F1f",
+			"0xF2d8b85CA474C41AAFDAeA24f4D1f3FAf5F6516c",
+			"0x558B92017a3625A2C5f9E131E97123941356fE2f",
+			"0x3047799262d8D2EF41eD2a222205968bC9B0d895",
+			"0x5Beb385Ac5c30CfE4077942e396D7B745E593C88",
+			"0x1b02dA8Cb0d097eB8D57A175b88c7D8b47997506",
+			"0xeB98E6e5D34c94F56708133579abB8a6A2aC2F26",
+			"0xc9E475A954087e851a11aC47fA03902f94e8F74b",
+			"0xdd827EB8Fd0C95a1372CcA8f2ded3406eE034f08",
+			"0x0f1700cEc9781740a832B370467cbBBcdAf75AD1",
+			"0xb68825C810E67D4e444ad5B9DeB55BA56A66e72D",
+			"0xBeB43fbb2f7AEA8AC904975816BB1b4cA9f4D9c5",
+			"0x29D1Adbb65d93a5710cafe2EF0E8131f64E6AB22",
+			"0x31d232214Ff5B767916dd74bE5f4ABD88A63C0c3",
+			"0x864ba2F7153749a55ab8d93218cA0aa5F53Ec392",
+			"0xba79bf6D52934D3b55FE0c14565A083c74FBD224",
+			"0xeB1c0EfE6655E019E2c6AB01e0Bd0c97f4899A4e",
+			"0x45A664993f6c3e978A1257c6EF7bBB512af9F098",
+			"0x939ffC5a4f3e9DF85e1036A8C86b18599A403F3B",
+			"0xA1fDB322Ab5fE4dF90099E6f514B9819AEaCA8Cf",
+			"0xfd0A50D0350Fa7A0cd233Fd229bAa6703F425BF8",
+			"0xA4fB6622c59628A822E3514B5FD99b8b70A9bACD",
+			"0x8Ba22ebAFcBC717086f94d13dBa5a5F1d06F9DaC",
+			"0xc3e8b0AB3c1c24e224c11B50727874167203bD9f",
+			"0x76437234D29f84D9A12820A137c6c6A719138C24",
+			"0x0F4610aB02920a99f639F675085A5d3e24b0D7ae",
+			"0x62B3bB8B97d74Bd755bE1a385CDD21E25071aFB4",
+			"0xf542194C48729406983868443ccC10Cb7f9d1799",
+			"0xE9797EC96C3728D4A14493E0CAE21f216DbE6b3b",
+			"0x2b9856fa15974F994A7D3040874AF658E6B9f312",
+			"0x3bc677674df90A9e5D741f28f6CA303357D0E4Ec",
+			"0x789c11212EaCA5312d4aa6d63148613e658CcFAd",
+			"0xBFBc891E6C707243Bb1949A92A1DcBc9ba160DE7",
+			"0x3181460954E2c590a0314B948a2e529777cc89A2",
+			"0x816278BbBCc529f8cdEE8CC72C226fb01def6E6C",
+			"0xD1E15281258959B3dE7CbC119bB230a668cD92A6",
+			"0x3e8743B5453A348606111AB0a4dEe7F70A87f305",
+			"0x3F9E7DFeBe5732342D6752CFcd0D68C54634D64e",
+			"0x833bA1Ab69309Dc6202826800968921F0fdc168C",
+			"0x8318DC0015DB177001C8EF49587f290788416656",
+			"0x817cBbDCA4113143264a95f3F196790a627F556e",
+			"0x062665e650F186142164Eac0369e24E640C51DB0",
+			"0x07DC75E8bc57A21A183129Ec29bbCC232d79eE56",
+			"0xdADaae6cDFE4FA3c35d54811087b3bC3Cd60F348",
+			"0x30b6Da486feA6CCf2489E562df53e2F95EbBB2fF",
+			"0xf83d7656C543a949f7AB4fB0224B754F80f91eB6",
+			"0x6e0f235E73dc6cd77c2f94ED581a480Da54Ee957",
+			"0x42589d8199e3144B2683FA07bFfff641De14C38d",
+			"0xa97250a718A79b1AC54CACB1b32Bd3485b8b6F63",
+			"0x4a227a8A4a0009b3321C5941bD359c4E8f3D6D91",
+			"0xf6135FCb4A0F469DcBb3e6D83520Dc21825A0001",
+			"0x70756620ab567B57335f29d372297a0832ea462C",
+			"0x0163b81594e45f9a4889431417d4840301Ea359d",
+			"0xDa5884F0ae3eEF8baA4D11cf9278323FE049D536",
+			"0xFF7ea76CEEA796C2Bf2800C6A71b762a72fe570c",
+			"0x9Fb8fF47Db2A57f43D3589eD8c16AF4f5FcCc5f8",
+			"0xBfBCc27fC5eA4c1D7538e3e076c79A631Eb2beA6",
+			"0xF61A349F8feda7f738F231A2b0c856f9afAa59e7",
+			"0xBFEBd871ddE8cD22999aC9C5679Dfc8248884fCe",
+			"0x29A3Ea9fE2fc3CF8fd27d42dE4d12f022a25B326",
+			"0x7b0e3787dAd96e5eA5f024c5521FA0E9B1608E62",
+			"0xa02d2375DfeFbA93b76a647BC61A6E65512BeB68",
+			"0xB0e28C53B7C84741085EFE2e16CFF1d04149848f",
+			"0x166fE0a4190669dc0Bb0EeE15f50a002af3b869C",
+			"0xD7d1215ddbc2F6e69D7FD2d52e5096671FfF46Cb",
+			"0x01F9C3f2edBA6B673C45aA437e496078D9ac918A",
+			"0xe779e189a865e880CCCeBC75bC353E38DE487030",
+			"0x6728f3c8241C44Cc741C9553Ff7824ba9E932A4A",
+			"0x4DDB6FE0d9A9228795aEc247E8d599d8fbf68Df9",
+			"0x6E3a5e2f96fC47660d3c0289DC7Dc76D4d5314F9",
+			"0xbf73c5060229c12AD8B0B39971eFf2Ee658B57B5",
+			"0x5C5bD35CA3E6Bd322A956BD99bBF08F1Aa58931d",
+			"0x0a4A901E9Ef381667f7c01d70a530BC2D266dd80",
+			"0x974A8959c52f6109C59d0A6D63D4eA4CC522DfA2",
+			"0x788B8EcE56F2C0eD41f31D7cd172276addCD7F99",
+			"0x8403502464375da4002D011Bf8F39288501A179d",
+			"0x68F3efbFab22CB761c06dB3143f4b66De92b7AD6",
+			"0xBE930734eDAfc41676A76d2240f206Ed36dafbA2",
+			"0x008Ea4e222B932D29171fD0E79c7430Bca37454e",
+			"0x6679D8E3cDa6441d5C5C12691C3de9E47d80663d",
+			"0x948b3adfc795Cc4144D061a9e69fcA4C01E4e62D",
+			"0xfe7a3cA7273CC197e70dEA81B9ba25F2510fa033",
+			"0xC8a18C45a20ae3C200C1145a44223a991ea0eb2B",
+			"0x7fce9C24e09363754Dc7dBc1d033E7Aa93e751B9",
+			"0x72C8919c7fab199E9cA60272207B7e8F10C7C1fE",
+			"0xd05Af8871f8ADEF7b32Aa213a91A89d6e6A8C2A5",
+			"0x83e49d6D58EC47c4f590ef11930da18349104c99",
+			"0xEfd9281E2fAe0fC1538B04b3CC16A602D57e4D07",
+			"0x299385325392F537Fc6B4281d2dbe31280833Dcb",
+			"0x0e3fFbcdF9B699370F3dF4D9716F18F22a0974B7",
+			"0xd9142E0Cd3B2b46F6F6f1E20270fd290356323c3",
+			"0xD4709c2662281BB601c1a1330B85478d2cF1F37a",
+			"0x1C63048420aAeCEC6C418B456Dc9FeFFd9584e37",
+			"0x257d864FD7787f64D214b6039314F24fC1522d5f",
+			"0x6d2a25a59B04C8d0BC53b94D83134bFcb162e68B",
+			"0xcEAF0B1F34B009bCa4a78E4A9b2a84740275969a",
+			"0x211A47A691c84D3576Ff081ff9709F19F0813983",
+			"0x6E20a29b8a011905f654d8C52E141976e77f3365",
+			"0xdf892439A5a26BDb69259Db315a706eD2F239948",
+			"0xF3FcB02c0Ae2FB04e8dAEFCA49D29557Ec1F68e5",
+			"0x88702179A882C73e88ffB40243C0B499Ab9DecFb",
+			"0x106Bd286705d9A6C6b61553a12FB3266d52DE42a",
+			"0x78761Ef51b45ec09a0372faEAA3a0dC082b9eeE6",
+			"0x022b03C44da70034ee0B8562fc027C66D74F72F0",
+			"0x24cEFA86fC1826FD31b4cb911034907735F8085A",
+			"0x9759EE0E59039fD08E8C2454ae739b4C266F3eEA",
+			"0xC2DaefF8Cb77Be50FfC921C9d4bf9AB3a02Ad98D",
+			"0x3EabfC5B9eca129520f272F2D8f7aF164320fa5a",
+			"0x235B537Edb6091F75Afbfc706f5a1795C5c41C87",
+			"0xa871F514C70dC3399aB9605258eFF5c78d5a95Ae",
+			"0x2002E3e326Afa4091Ea44A878845758F60D66468",
+			"0x02341158Eb17aeEA92F29E6a7E0FE942615d8A44",
+			"0x9564a2972B25d2E954f513fBC949FC313730483E",
+			"0xd07622f60543D1f744B6397C27Ac4a2e226d0943",
+			"0x7F3e8646b238cFdf635CF2B039E3946B2f524139",
+			"0x070599c0F92Bc9280b56edD62F14f2EfD85a35Af",
+			"0x647B09b532cEf52f3B9b0B5bd05E843abD99B773",
+			"0x1e69D4CF801C0931DC1b8f04067888cB7B5092Df",
+			"0x267bAbbE93B9E9499aCA9938Dd46922590428643",
+			"0x2dA836986176Dac234f91C5B1Aabc1F8FefFA794",
+			"0x4eaB3f1B2f0ab85DdDA4d34EDf691ADE9eB9b146",
+			"0xBb496Ddf38d4c035Cb2D54d3A633f260B74B5fbe",
+			"0x039B5818e51dfEC86c1D56A4668787AF0Ed1c068",
+			"0x6612aB01CAa56f8EC4283609a99f20A89173fD57",
+			"0x03a2F8F90B219732757472BB54801a82f33A8d0D",
+			"0x200795DD9901d33760dA1D0Ec1536c7A82079d5E",
+			"0xf78234E21f1F34c4D8f65faF1BC82bfc0fa24920",
+			"0xBf6527834dBB89cdC97A79FCD62E6c08B19F8ec0",
+			"0xfED014c59D67596a05E405098627C52449eBd231",
+			"0xb4BC6B66e651db4e1d4Db1B6357DB92256a6DbDf",
+			"0x3C36559f2F43ece239408Db632C65595984E3eAF",
+			"0x2E055ecBC630C8B27835cD9A11c69C9b5Bda806C",
+			"0xd954551853F55deb4Ae31407c423e67B1621424A",
+			"0xe709F9b9998b3244716C77D1edA4a3AA6dE72D39",
+			"0x2720aa61A090dDcD4D49DFa57A48CD204a536e3B",
+			"0x7833f7d66248E4fbD705CD83DcC74288ff98d313",
+			"0xbF9ef464B93B1acd5b0139CCd3Dc94e61506D3F4",
+			"0xcEd35286a83DF82b6914241BBbbbAc8657B508AD",
+			"0x8cFD8B326FC4F76aC7B09b24926e70a1f9971953",
+			"0x943b9D2C6B75eEAAB567E192ac301ee166d9Fa9C",
+			"0xf1B5Dd022a339E5b6F5Cb420aDc7Eea23F520d74",
+			"0x172eda9C686F507DAa1c20Df2868552d6382d69F",
+			"0xB53E3f35Cc812e0E9661c86cda6a1A08d25D7b55",
+			"0x4c80d01e8B35D8A5d349779e24A006DF3bac7165",
+			"0xE09E30b8a57b245Ba48595663204f5dbAB75bced",
+			"0x5D0b9FE11518AE2b810A2a3d25cAc457b427F839",
+			"0x8dA0A4eF9843E79bFC8b60176bfDD98d956e6E39",
+			"0xB1Aa6BC3779f91f03354F3B2c1c792987475354A",
+			"0x5Ee5aC9F359B5aE622cF9DDD473473FADcD1D5f3",
+			"0x0445BA3749Ea76f63A52Fb697F5BE99D605eA084",
+			"0x829AdDC0B20AA383533488fBf00f5bE2D8326793",
+			"0x2Be5f71fc24EdbAADfD9cF44d155E57c53Feccc5",
+			"0xB9935Ef79cd5b782E1868Ad90FE774bFc2ccd278",
+			"0xBF378E3423341A9C95a640Da4C5C9Fa4896509c6",
+			"0x53AFE866689Dd61d28cBDC631CE8BfB96a8B6EE8",
+			"0x86060f6d5F4b7F44a1F622d31421bb209E121C58",
+			"0x54C71bb4CEa54d55274d10D3bAc0e6e9d41Efa20",
+			"0x44D47333489eb40B51795442976A83d5efC5ce2e",
+			"0x6332098C436b62f9d3ad9037dA01fD3ded91B587",
+			"0x5fcE4b46b58996a4aAc0962dEC2cd9B257352ee1",
+			"0xb3eEb69945D278Fe6f755830316a6A43eeB09Bd5",
+			"0xaD36C9028BD2319E6e44e5512C149051c4C8805D",
+			"0xDf9c8df924C8B15C51b5A21F572A23F7170d8358",
+			"0x4d3a7e04Be857a1E8255eC80C503042498872141",
+			"0xCDe9Bbb4c2f5d3f16C825A33aB70d1856a7B3616",
+			"0xA195bc35Ae7eBe75F4fe3946B840eB8D7D330A01",
+			"0x915f9282FED53f3F602B0189bfdfA61936bf3f80",
+			"0x39C25D0f1c214D0850F8745451f44988eCe54ECF",
+			"0xb5DBb58AE0e3660BFE344b30A1cbA53f8645B40d",
+			"0xc0FFEE8908472C6D52A17212302D25B617182249",
+			"0xdff9AdfE938CfE7fDC900E8e17B76d6D4f56FD0a",
+			"0x098dDaE6F7064349C47dC4636ff21950a24F14Bc",
+			"0x50185e25Eba699a5fc5471750776EaF20601914E",
+			"0x3d492a1Cf02112f201721544e13a5e239a5258d9",
+			"0x1CFf40562d74dC38244eA1E7D2EF98A7b83F4893",
+			"0x13947D465C7c1A4165783FfC751D96dB966EDB51",
+			"0xcA52053eB17b7Bd87Fc6f50188E2d90D5240cde2",
+			"0x3F7D42098acc35A27Fd0eB2D978357749A563F8F",
+			"0x8572Ce7C71051DA8dA9f8fBF9F8B0820ac8EA6E3",
+			"0x8744750E1557882d9Ae7E9600499c535C64367E2",
+			"0x04d4d8C319C35F60782138DEDff14813b95965E7",
+			"0xEcC3Cd549CB18DEcb134317240F898234D847cD0",
+			"0xe7EE9E2d07AD78243f801C3a623029c98194A5C1",
+			"0xE1e1D26d78F0e7917c17E3283A57f79f1FEC2487",
+			"0xEB94Efc5A0196C4BB9017bFC9e129e69dd3f7A7a",
+			"0x08285A32ab574355EBCb1EB30222CB2256fbD992",
+			"0x401a67F101C65dF6B5Cb21e14701d83FBf9d8831",
+			"0x388F5C06006b04beFb48F29da9ef7333a8361d1C",
+			"0x1DF18fEaC0fe79A5DEa91d8C4Cf286908c383181",
+			"0x9073284e1BABD3C12932dEf4EDf684139952a337",
+			"0x9387A796e19A177eA726b6BABd79482600A29D2B",
+			"0x99A3f16475B73caD9c30c45416c7bdBB0C35f2E3",
+			"0x38e63bBfCf959e584016d1261172122ced731fdD",
+			"0x827BDb822940198F22FA984c5645a27C60E6Bb5B",
+			"0x2F91756Cbb5A5247db6EE79DC195c85039e27687",
+			"0x4863A6A90f8C62F7Ce0046Bf5a9AdbC7C936ba9A",
+			"0x43773B20580123F868b6Cf4Fd81967ada5e9e6CA",
+			"0x02332C69DeBf4EdA2857E6146cA87425f1F432e8",
+			"0xB6F69581488054447A14780e6268b021A3Eb1157",
+			"0x6652FC74f75a4B04D8CAf74658AfA5c96dBc507a",
+			"0x18dd45896Aa1B5c09E29D65060b44493C985B8c6",
+			"0x2029F4d96E980aC4f5841Ae38B17e3Feb0D75e66",
+			"0x590c44fAc89307fa05748F50bE82278666861101",
+			"0x2AD2C5314028897AEcfCF37FD923c079BeEb2C56",
+			"0x1E367615E21cCf15a26B693a579FfcdF0B70ebf9",
+			"0xf23a3F21072a619ab9275aE827950D1711041a09",
+			"0x96a1Dee27d4981D98FF9eC95e7f6d45b373118B0",
+			"0x9d2DD0E6437ede2D2eEe443258d308Ed3178Aa0b",
+			"0xB9a955C0b76d63e650EA5Df73060c3008ac36E9f",
+			"0xECD2f798A80641d14F4f8A123E5f65eE726968c5",
+			"0x0Dd8418c4A7715C8b7173e3Ca97A5523e659f60c",
+			"0xEf18Ce74E960877673ACFf30184Ef6638e2d3041",
+			"0x5B406e0C8ac60e2923a5DC4400977df1301a3DFF",
+			"0xBC225699c4899CF85894902CD947BDc02b42d610",
+			"0xCcF714b2109aae9A6D1c8B1d1Dbf6b81f8C003B0",
+			"0xCF816F008948775A460d2c5b6FFD79760f5227c3",
+			"0x99feb11E3A119E0F6a4769a619efD3Da84Bac290",
+			"0x7ef88691051c7F828E41fFda824B6ebd788fe569",
+			"0x7f1F846bc6B252bDEE65f61491A879f0AD7ee926",
+			"0xb05a663fF3DD66781A6bbFCC6b77297858cB0d00",
+			"0xf92A29309805fbBb1D4AE2A97a3D9539f461C2Fa",
+			"0x5AC47dB87272EB158f1A69f00BAD9Bf8D311E5E6",
+			"0xEF3A73b639719fDBe775411b41683E0aBee17025",
+			"0xA39e0E95d80C3f570C4E4ca96e3934eeCe9BFfd8",
+			"0xb709D4b823eE0b942d5d29eeBb4756962fE39783",
+			"0x6022Ff1654247f4e715254be00daa49f163b8D00",
+			"0x61a797F2F7A43a77F311014E8BB37169f8f3661b",
+			"0x82d45a1cCaBE624eEB275B9d3DAA177aFf82953f",
+			"0x86Ea80CD4812aBDAD9f7Acbc449130098BE6FDdc",
+			"0x6d0C04fd579B98789613523c29251dAccBd0608D",
+			"0xd8c327F3aeE4CFA715bCA0Ca93CF3452eE37eb94",
+			"0xd1F1B7b2A0940c63437C0829f0AE8A23c8B1051A",
+			"0xD72956bf70DD168Aa8709878f8cfd2486b3B078E",
+			"0x1380BF99ba8d0fEA3A82d0e28A7D10F430239190",
+			"0xe8d2c4ca811e0445B83326aBe85B7c40C66759eD",
+			"0x3041B4FDF86006B0A87415A93929e8F617F0DAE7",
+			"0xE85C6ab56A3422E7bAfd71e81Eb7d0f290646078",
+			"0x61D04f556F1B5EBe38548cBD27FC9BDcf87Bad44",
+			"0x77Bac7585094307023f31fF5EE1BCe6e75917c09",
+			"0x0DaA6EfE21202efcec018fd7eCB392534c8e4869",
+			"0xFA1578836cbC710201c3A01C2d8AD702b206a552",
+			"0x0FE9ce480a7a8354F648215C98FC75cFDA5A43cd",
+			"0x20b2c5921878778174F7E50dd222c639a457D9d7",
+			"0xc500cFe651A1e8173CD7Ddba7023702F6DB0b943",
+			"0x2fB9Cee9d580cD12E671b8789F2f9fb228A5d2bc",
+			"0xeCBF96Dd4fBfD666A849252EC022Bf311A4cA002",
+			"0xbF9Ec925F3A9719b9cD99505851865ac0B0f61fe",
+			"0x73C856308aB5c9587AD8cc8Dc0Ee3B8900f00F42",
+			"0x65b36187b3Fa00Fe7f9425a03a30fb878182b1A2",
+			"0x7a8B6DB53F50C00c7DCe560ed4f7445c7d6058C4",
+			"0x9514Dda5974a2dD0Fb27e3Ee0812C9CD1DE627Ad",
+			"0x8fd8879657C4f530F0f84436af3999C560A2168B",
+			"0x08324a22a63e515Fd98A3d77120Da8b193254D00",
+			"0x63235bA3b1C8b8A3Cb9C1dc204abF40A18D023E0",
+			"0xCc24Fd9232bb221c2155D77EAbF4809568e529a9",
+			"0xBaa8cbd0F6D6D37194AAe25e774A05aC9059a950",
+			"0x46bCC350aF916a10C2065E6E616e01B6b7770833",
+			"0x2F69a4002E07de9099bF98E103C317094067A85C",
+			"0xC92a07dA45f48E898894351C9D2faAf50087C0F9",
+			"0x31bc4C4fC335895082ae9f7a78f3bF58f5CBB2ec",
+			"0x631Bc9fB67E5E339AF6d11Db18f0D32861A85A1F",
+			"0x253fE2C76728C98FC18Bb52e9A0bA8522be15aa5",
+			"0x50226FD760194aA5f605e66D3F9ac2FA5DE9DFfF",
+			"0x4Ec82EcD19529ff50a467F522029f69459bf071d",
+			"0xb3Bb34973f9285903ddc4cCDd41e9B3c339E207a",
+			"0xC2F15C487D808982CD0e86DCBbaE6386589123B6",
+			"0xd23eC2a1b3EbaADe3cc176679C6BeeC171489F09",
+			"0xc70d783b98AD5B5F9a3bBd810da94Cf60a4e5ACB",
+			"0x149129Ad6dB2B2EB91bf8E74497112E05fD57eb5",
+			"0x09d58DFb5b73e3db831FA729094cd3852284C8e3",
+			"0x3aB7174c3352321fC4EeF34EDB007897d7Ca2052",
+			"0xfd4Ca5b37B6085cDE0FAcC579F5520CDe00Bb4E5",
+			"0x0bf7ca461041E0843161F032696CE9AA2dCd31D6",
+			"0x2Ef7111D31B3e2Fd3b4C02d8276CD3c408dcDB88",
+			"0xF243b0F47BbAab11F48b08939D9c3F27c99884cf",
+			"0x1D925349E8dAEcac700bE311Aa48d29839007f85",
+			"0x325ee48a8A126d9758acDfe8789F33342e848022",
+			"0xCdF9A52DBEbdcE0d3Fdc70d28fE4a226a51330Fa",
+			"0xE00e373eE195fe0fd511F2Aa923a70125952DF43",
+			"0xBcEe9cFE1F7650a6d94E486D99eD2f8A693a2A9b",
+			"0xd1903C4F046dc843389a72917cAac2D528CFd70c",
+			"0x69eeaF9CA8733D2400d78562B16A7E660A8cD973",
+			"0x867254aF06801C21C5812690a5c3FAd82537C6Dc",
+			"0x8e27B628459c34D092bc459A9e0a4B202e8cB81f",
+			"0x14613D716E3E33A44F09536DD795Cc0A8FC95E2D",
+			"0xD00F03068897323946B4d52662a38343Ece6d553",
+			"0x7816fd8C7e304a5eFc0eA6D7096343094B9c9Fcb",
+			"0xd6a0D770280301d3FebBa271bB0da1cbb2dAf633",
+			"0x5bCE50ee907249bAeBa2Fb245a38e8eDA4dEec7c",
+			"0x83F0775A151aa8C3769BB53337d2Cf3a7708baB9",
+			"0x2c90E3c79Cc3A740eB817CCF235B506A3436cCAF",
+			"0x43613DEcCa301c375217eCE862CF72664837bBa6",
+			"0x20DAcf489e846B127Bd6829A88b970D9DB83fA1F",
+			"0x8eC13e2Bccc982e4C954368d25D6fd67C9d280FF",
+			"0x76AaDDf607049Ad32d655f31962a2A55B1fea235",
+			"0x6771700057088C1fC8a537c32d65a842736f9C5C",
+			"0x55481ec930822643737A4Ca34F312C9AA8eE1897",
+			"0x2A0541b0653bb228c333710880eb9780041Af291",
+			"0x62Eb0b7e42Bc377F0Eb1e03556A6192F532408e0",
+			"0xf56f2e7ef26807a8D5f33BEF036397aAd1C56d49",
+			"0x4918A09934b4c706013F32F8C5F6A683BE956edC",
+			"0xCCc09D2ff5a6171E6626C01e31d2c1917F824Eb3",
+			"0x8A7668c4Ac5ddFcB2985631138736E655324325D",
+			"0x29199b321F36002f51ee2dfc62e3634E378Ac9DB",
+			"0xa69c80CF36079C29bE297Bd2B52A300307F316Eb",
+			"0x628B3551EB612C3B2A26e72EC19D31080E893817",
+			"0x2E740aF61c22fE7cc1523b5ca952b59c92c261F8",
+			"0x645Df2783c86ba63620D5A6f555940EeB0bAb9D0",
+			"0xB48c9E6DdF2449E048379ca09d075375e536d8A4",
+			"0x41Db6610cB78E8F977D48379b57FD83C8457ec43",
+			"0x3F84Cc219A39F98c5994D61724644C6B2d40D7a1",
+			"0xf4fa3BCBF93F2D14C3725a823ff9932D470c3172",
+			"0xc81C7CfD81C5632cC8A31723405aC5B05dc10044",
+			"0x73a37E83A8da45270854148204bcC51417dB8D51",
+			"0xdbCe7C03CB836FAaeF2f07D4f7B94F2E8eb18be3",
+			"0x0c8f65cA17fC3F01B8bE973f26aCd0463A034A99",
+			"0x72326299771BCCc6854f97CcAd54f96691dA1F66",
+			"0x2C9e263A95F5edB2ed288A0E3B546c1391b7ccBf",
+			"0xDc4BEA4F2B5a0acc4F2719500D25BBEC149F3142",
+			"0x22F44CE785fb862A6E64bF46978084933249661a",
+			"0xaC190De7cfa16b1EB6E1e4654A37CAe082196CbA",
+			"0x784BD4aFC33e2876AA2424061fC216f348fE77aF",
+			"0xB1faF69b541Af02A809731Cc3f9d6dc971C636E2",
+			"0xaD49270934356393aD5410C605A37F875f3bEc58",
+			"0x5fd512C2BbA0802f36334Cd334167B0bfa7C3DC5",
+			"0x55fDFfb3d10fF9930929433a5eB0A4473167C6B4",
+			"0x20A68da8869B92f1fc78474dac89A0E3928449f2",
+			"0xFb3528bEd493c578C53229ddec14948c1644C077",
+			"0xb6C866aF31D06F275CF15CB8387CE8f3E507b149",
+			"0xE99e67346741038a771bCb7B92837E775B814d08",
+			"0x2fED6833A86f764bDB84842Be0823A0B79d3c7AA",
+			"0x9aA3Fdb88bB9b06af6F9FF9c9c71c703776Cf608",
+			"0xd94ae75B1282B54ece322f63F5804D49F09a0b27",
+			"0x056dDEcfdBf6DDbb59BDEF4fDF2986B861Ea411c",
+			"0xE9C7650b97712C0Ec958FF270FBF4189fB99C071",
+			"0xD5678Fe4C0a991e48719B0Eb96A1466C396Aa9D7",
+			"0x0F2E86E751F44a42bAbB30125d72e47D37cB9DEc",
+			"0x3782cC428BDb10b259E858424a9fdAdB879638b2",
+			"0x8a1bFeE3cfD21bebd5b20c3B625dbd792513395D",
+			"0x92F65Be3f5517db9a41B31d600eb969E5E05C887",
+			"0x3c629cD0cAE2Ad740b88048fCb50605ad60E7414",
+			"0x650Fd1F5d732927fc882a87132d6C0A513E29d45",
+			"0x14aC72943F45E63B2492a838b59A0d96dFEA59EB",
+			"0xf92837B9357cdd3AAD0adE2A29eb925256b277F5",
+			"0xBFb0DE9023Dfc401B169C24D12Cc573500411a81",
+			"0x5Bc0d6fe54B2960cD94C99Aeb77b0A86D9DEC246",
+			"0x36a1847cdA738E3EAE6808d8AB92dC3dB5093e87",
+			"0x3d2008F3414e0ef60a5fD1be35bA3797E5432Ec1",
+			"0x7AcE54Baa6e841797f1E85d0170483775BE47Aea",
+			"0xB285f54dd967b5a5dd2B44bbe94dc8702b03389A",
+			"0x61355c3a231680e76773b117CC3A03FEdCE1840a",
+			"0x9D2E12FE40f1beF91546f44aad4Faba448D2adC6",
+			"0x64D2D8720F5986fE1cc7A474aa38176A85314D0C",
+			"0xf45CfAECBb7a413E776D79610EE9CBaB0265ebA5",
+			"0x105CB2bc1247b0C612240433005B130011A2F068",
+			"0xAE8b95aC6b77e303882b70211dda778c72762423",
+			"0xdC733d5bE0D212A67dc937cDb7F2AA2D049060ec",
+			"0x8d226eCB2De9624E87a4249883b2ac9f0Af895a7",
+			"0x7B51D0D4D3a312398736139053f9DED155097292",
+			"0x3e38c5c79FefEDa1018a50A4CE3BBCC14bb6CaDB",
+			"0x9f39313d5bFb9b8524F771E9A6fC48287251015A",
+			"0x6a5310cf12368c7EdeD3c1B72229528194E6B513",
+			"0x6891ea76B982d6F183F86BAE0Ec2CCCe380000dD",
+			"0xe0463D690533E788F7baf2f9be2e7179cD373F96",
+			"0x8A17ab916BD5448358DeE9882B9fEaabE3a4C3b7",
+			"0xDeC3F5A42D8948dEc1d5AFfa2E23426B5D970ce3",
+			"0xF4c70F9791491aEe6bD50fb6d35D3C1d222D39FC",
+			"0x01b217c65F66B80CEF994038B9A1981E9245803F",
+			"0x7213657cd360f8025136237a429A8Ad98311659C",
+			"0x2C3edCB333D9E56263BfdaA685894C481Edb9ccc",
+			"0xEa96E239ffE26196E89f71C87C3A4EFa420A494a",
+			"0xE2276672C1F2E190076202af3486a4260F888535",
+			"0xA73794c8e9D05E2Cf5C3B5869A1c2521ABb8a8F3",
+			"0x29aA51FC9D1C80D317556cf406cD00CF52a81563",
+			"0x2638846Fcfa6d023Eb24966222290c7E0E83E22B",
+			"0x134265c8358B9764C39A1eEA97926D3B88134bf9",
+			"0xb0c4371094e206FB7db115c9CC180985561bF6Cb",
+			"0xA208B29F59c853B5a048d095C9eDEB04B4173F63",
+			"0xB0EeB0632bAB15F120735e5838908378936bd484",
+			"0x79787892C42b937FCBa404464C0a97387c969582",
+			"0x7EB7277297888F9e66Ab4BCB4308BEd4740bD2C7",
+			"0x8e7dD57D65cF2Dcc694b944B65359f75a0Ec95F9",
+			"0xBB8004fAD7760449b871eF6F63596052afE58f1c",
+			"0x0f2fAbe455FA071A8e48241Fac8b9f20DBe2c955",
+			"0x4765A8b6DB539eF7D790b04e798ebAFF37006296",
+			"0xa77A70360BA11B4AE92A40e5002387e6C7629D2e",
+			"0xBFd2d12FF4bf0F213c00c4544101acd8552D9b83",
+			"0x07Ae137c9d96EDb0A27b74Cf20B99a4b58009C3F",
+			"0x6F68AeeA831BB78920005a34EA5ca293AaC0e1ad",
+			"0x80485456e9F61Fd3ef1a0c9adEFE88Ae4A520993",
+			"0xfEA83Bf0a3a9DDd4496A8A720C8a16e552B68B7e",
+			"0x6B4b93F17D7B6DfFdAC6a95BeC651FeC9A7EEa1d",
+			"0xD2d8b89B9F21077302a6e721da47bE4217cc8656",
+			"0xC1ad62bb82582520169b6411565966B76f038435",
+			"0xAAC81c0c950058256cf3D8ab7A7eedCDBdd18677",
+			"0x05E61E0cDcD2170a76F9568a110CEe3AFdD6c46f",
+			"0x9de7c5E05d87D20cC9798C83844dd9813EC74FDF",
+			"0x10C22079c1D0388056e54Be5bf6751D9320F54D1",
+			"0x41a2b7c209729890D851F4e083279146F09ae0A5",
+			"0x0384E9ad329396C3A6A401243Ca71633B2bC4333",
+			"0x0C8094a69e8e44404371676f07B2C32923B5699c",
+			"0x7A4F1e5207bAe6FC0bBb3e43EC3523ACB07a17a5",
+			"0x69420Ee3FFB06ec4e6b4C68fBf9f67EFe6dD3bB6",
+			"0x28DFDb6FEdD8dF4C891911D2EcCAe1613917B612",
+			"0x386ce438f306e4017eA44D0cdfF6E3d5f2bF5a29",
+			"0x1d0Bd8F7DB559E8413A3b3D358F705fdF663E8C4",
+			"0x918E26b057A2B2B5DEC0B2c4b59805E7C866FAEB",
+			"0x7182083579e211843AF4713A494f67cE823f90D4",
+			"0x0dC614eCb00Fe2f6312cd1736e61a04969a4fe7B",
+			"0x8e1363F43b36b969697a4B7cd2348Fc4bD420B83",
+			"0x07cf74ecE85c4f8eCcd27bDA92Bc7E2a304E8170",
+			"0x9f44BfB8518a8C836e9D6b57256A65C90457E0dc",
+			"0x2c66145dE134F76F31bb84C73269C994008c6159",
+			"0x1a547B5CE91ba3B65305E7979A12C8BC8A3D4962",
+			"0x13db5665586D9d620b0372e89D4Df84C538eDC4c",
+			"0x941bA036A61fCD2Eea801dDdFB8359326e62cc2f",
+			"0x3EA74026BCee5F95c9Fc704b54921da3cf0375b5",
+			"0x65740A0d0aa3C6556aFd52DE9aEf4fb38838EDfc",
+			"0x3dA6ee8e095280AE0C6929A8De1EDAe01610D72b",
+			"0x296B7C203E2C7306b132F2D5aD66106Bb7665C46",
+			"0x932D15B8Ef9ecEFD3872AA04F44c92F0d0C913A1",
+			"0x09a695EbD2C6617F19bdF3d2e86dC4FC1031c4F5",
+			"0x11aa331Ab179cAC454CeF57ced4bA6BF66441D81",
+			"0xa3D0e1108c7b4d7496c8a455f05cDc981343ce48",
+			"0x3D878681703B3aA0641346B700803841da3a5FA2",
+			"0x47b604dCE4Af28Ede480EC795fbc1DAF3e4cF731",
+			"0x093533c07518c68255C4bFAf0B07093e50cCE305",
+			"0x15844Bf571615367cc43B02943B474a74eDD062f",
+			"0x825b0036882E0a1e62D66ab6AA87660e693cA01a",
+			"0x1D107ADA7F66BC8b95DFDA13547ADff8B17bB073",
+			"0x3aEEc33b35380EA80600dA1CbDEC4F14C54C9d4e",
+			"0x766B6F79F4fBBc1959202D12445eC2dA0DB85098",
+			"0xc2c8c8fAE89Ec189545C4Ce4039Ecc2538A8a5fD",
+			"0x3926af7bf52ab487e70B24F830a41388B5EcE286",
+			"0x4F1425c0185BB00D6788C751be8F26E6ce31b04c",
+			"0xb88b26FAFa051D30D3Bc9AF0971f60d32b82ABd7",
+			"0xe616e167C9011D2f2555E622a2bf28F0Ccf4c824",
+			"0x69f5dA3fE08Dec8D457f49e5126FC5Aa88d439e5",
+			"0x5628F2eB82E9B2C25Ff678c94293398731d01424",
+			"0x26FdAe9456e97B83a2054ddFDD751d912063B781",
+			"0xA4f9009292C6517dc801735913B0554A488dA9d5",
+			"0x3aC66471258aA543D73ed3D1087048F23566Ef6F",
+			"0x23fF8650EFA5965723acaAecA1378e94EBaAA7f7",
+			"0xd74F0854BB5266CDE54A9d22bfce6e42005A3dA9",
+			"0x98Ee246E3aCb2eBA5F5Cc4AF768f4ae989Af3C3e",
+			"0xF89dA6CdF437E4E4897dEA867Fa7c633d3D70D63",
+			"0xa81D569f7EdDc432FE86808B809d0AfCaE670ee3",
+			"0x6d4fe1f4dC41378aef9DF5C4DB27138D81AdFB46",
+			"0x7f181FB4b44C9b0E03713333E0cb7f67862Edba3",
+			"0x5B0822659415DAf6ffEc747375edf751AB39E732",
+			"0x10B47Be1C02117D48C38fCDDf7AB332Ee588CC16",
+			"0x6DfeAB52d413E2d16BE84c8d801FBeb2F0DC5Dc4",
+			"0x347f47756bBd579f0589B414cCCDc3857895b4D4",
+			"0x3F853AB27207e4a90cA10BC5f5a6d1c3340F8548",
+			"0x0BF994bB5663cf42888295E983841BFB66dC6921",
+			"0x2724B9497b2cF3325C6BE3ea430b3cec34B5Ef2d",
+			"0x3260cEcfa15faa662D8Ba5D15DDAa7EC6040B6Eb",
+			"0x0b8A75Cb0BC2De7060aC1Ac900C3D9AEACd69A66",
+			"0x519d743CE097734f07CF75FccC82280f8c528B2E",
+			"0x688cf97De4D3d01AE8C329C7500A238B77F3f8A7",
+			"0x038D51Ae282C2c16d8bAe1908f468bc919f2eb1b",
+			"0x33DCfcD192a21D8922C4FDb2AC81f0eFB067d964",
+			"0x7382Cf956f5C2E8039C3b08729e452df26FA26B4",
+			"0x7529740ECa172707D8edBCcdD2Cba3d140ACBd85",
+			"0x3Ee92C4061CCd4f5bce21C9918C10e4591FDed44",
+			"0x835EEF9aBCA0fE1263bb5a71310f7DABF5685152",
+			"0xe215E1384646F1D00bD5F64Ec1CDAbc956ed3c79",
+			"0xFb94080F0e02e6413c5f1a858ACC5e0368E566A7",
+			"0x2C9D834F0391219aB3aCaB08206CCC8b0c225Ca9",
+			"0xd13a5c1c217A67464E7C58AF02F2Dc97Bd611F7b",
+			"0x3109245b302E4252cDA86B1D532eB9d96bd75C2C",
+			"0xDa9369C378e1607B53933dAbcFe131700A0fc30b",
+			"0x248B65F9aF5E0c6075139a849CF56dFfd187C635",
+			"0xeD8562b9421EdB330ffC46Be88ECeECbb29a5C06",
+			"0x526816e1c755Ed07B0455B3e6cbcB5B9eA4C5373",
+			"0xA8C17ac16F3aF4d0D12131ACe0C0e7E262B6E026",
+			"0xB212fa6Ae9a8e1c7B060f73DFFe2debF443d0ADF",
+			"0x102B6f9A6C03CCCCdA33f143F3C261157E24B97F",
+			"0x24DB2fe3CAA83EFB89E28c48AFd5197992E1f4dB",
+			"0xD376F030CAdBC234389A4ba4f5CA1145a22A84F9",
+			"0x517a1dF27a942ebDa34E6885132d6C119D7087fA",
+			"0x2B411365f854C9ECD96aD98aeA9D2818E104873D",
+			"0xE0a334eb3486a26d715E72940211626E64c8AEf8",
+			"0x86768A769505F40E3DDa52a72622f3FDEeBF864c",
+			"0xe214393a371d999a3b87b698887783447c1002C7",
+			"0x1A030A243e228D1072B1029D239893f13650C2a5",
+			"0x8e500d2d8877F6a60eeD95BEB22C149CcCa6F142",
+			"0x98c488ad6aDF8367eC69d2Cc6D378b5b66D6DDe4",
+			"0xf914f5555cF9482a9A96e722817AA722D26eeA9D",
+			"0x291B595b83d503BC022601805Ad6F670a60467f8",
+			"0x132F8a3d58b10303Ae2b2cbde4340d18992afE02",
+			"0xBCBd375C355C1d1E76b0a923Fa1C0B1545818108",
+			"0x5c2E80Ab34D0e7Bc72802a743677068C7C0D4701",
+			"0x02722dAbEe40fb1B4AeC5F5c4628925c6c421Fa4",
+			"0x215AAffAEE271eC8f688551e35b7E0d074D8f248",
+			"0xdCD6bcAbcAbe94eB074Cc5ce48Dc3Fb6048D1C91",
+			"0xAc8e62a34c8C801A4EDE126dD4ef710a220b62CE",
+			"0x96FD300e3E4DAb1fD9EBDb47274Fd10D67B50B57",
+			"0xd76C65d26d8c32e4F1A60141841378F8D2901E31",
+			"0x4262a3f276feCF9Ef57DfF462e04F7fdf6BC2799",
+			"0xDF13Edb518a0835f026D0464b280526AE9aF9A3b",
+			"0x82E801e701BC8B3C0c0b43CA1Cc2DBf8B1655Bbc",
+			"0x1882d3268399B2072d2d8E089A3375704928fDC9",
+			"0x8ae601B1B4827aEC52eD670ea642D22174DC2Cb3",
+			"0x5c7BB3264aa259cDE38EabEeB14aeE6972b90A78",
+			"0xF9ADa13EC9Af82152ae103CB9bDe022642B06068",
+			"0x63D9682De625AaA9D9881D5560C5a79D29D4E98e",
+			"0xb700D1c43f88D628c05De1a5C5F5336f2303F2C7",
+			"0x8fe6719fF48E5F87317538E64908F5252E7c58EE",
+			"0x1DcFc4B17b7691f0e2A847A8aEdC63913Cb929ad",
+			"0x463e785Fd05479196611a48051a3ae68dE7a2246",
+			"0x0b608573005BaD5032fefb6CE4d4C1BfBAD7174A",
+			"0xFE7E3Ff4D50Fe841B04f2eeDa4C75180C315eaaF",
+			"0xEC441e6Fa373349566Ae9c63A314d46ad7EF43d3",
+			"0xeBa612DE10A79458830c8959728c5a5568668a43",
+			"0xA4D550A26dD42B4c74FD075E91A155CB697cEac8",
+			"0xe4ec4936f6A47dD6679593Dd454A453d5e18E5F4",
+			"0xA648EEEA3cbCDcE305ad072F4b640ce1bd4D0479",
+			"0x5dFD31F6F77d006e229c7865E5E96E726f6Ae345",
+			"0xFBCdCf9AB328Fb8545320f80ddcCf2c33823a15C",
+			"0xD72890018b09e8383dAad20f3B505E5cefE3B93A",
+			"0x8f45e92Bb7d7b19E54f39Ad014d607a164Eab5e4",
+			"0x614B2aF13E315A1A6B301DE2fcba0Ab9C0b80a54",
+			"0x392C148b1c5f276a2867B79d34648cf951468aAC",
+			"0xBE755A2b301302FA63B5646cCa9665B60eE88aa9",
+			"0xB2131373d634e7B55C957dD6179C74d8c4EcEE6f",
+			"0x01c23419F83a58b49DDDB010fC5a87B1658088aD",
+			"0xb2fDD9a62dB921E5C3217E766E81f6E456BdF1f8",
+			"0xB33fb982aEB6ddbC0A0DC2Cad171CA8D2Bd475d7",
+			"0x45366b9e957C0E32D66B0A8E3022938bDd050482",
+			"0xeE21a7021F0E6fCFa19689CC2121B6CF04a13c18",
+			"0x832A621ebf4Ab926ed9b3a1C91E6ff13f5a2Df5c",
+			"0x4C65cdD4a47cf8250153fC372378B2FeBd737F09",
+			"0x7dc42ce46575206FAeb094D392b3265EF40E308c",
+			"0x60Cf205F009878f1819A58d00ceff5Fda37202a2",
+			"0xED7222948b1b62cA833e081f5C6BD7DD53293A39",
+			"0x958F0583B2155e8E7d7F31884fCe3693EF2BC5F7",
+			"0x8A3cFFDf66a4B9Bc1949a39b4F3CfDf62007ea9B",
+			"0x51aC5f3177809AdC3900AacdA14DE4BC0987Bc2E",
+			"0x29034ccc743D65B0701FF614DFdE631902ab8EF4",
+			"0x6023d73d7e4e13052d8D731c6A6710bf62660E3E",
+			"0xba57ab1897521f548FBf009FA1F60e43b8a4d3AB",
+			"0x069A306A638ac9d3a68a6BD8BE898774C073DCb3",
+			"0x3f815e7d299f08278c0308aE1048aa45ED12415f",
+			"0x914678d778F9255bAa3F94439C8d5DF38aB03a65",
+			"0x5455EE33B5422e8c90845A3fBdfa2D3b35bBe1c0",
+			"0x60B9cA461C2028921cbDA51605BaE941f66175cE",
+			"0xe1afADeF8910113969e36D95788Edd3181C41C60",
+			"0x9214ED167560A6B3d8A0cB610Cf0c10390d101B5",
+			"0x9057158B7A2544d2D83088292893CDF5490Ade6B",
+			"0xDED76BBBAAE46729AdCF695be8faCf223F5CF9D6",
+			"0x6c0E5aED5ed5dcaF2c863cDd92FDBEA75a1720B1",
+			"0x5c07966eDFe8D51185dC1e49b04DB3AFe025C857",
+			"0x31BDFf048974d4E55034ab0C1D3bAD706EE6EB20",
+			"0xaD24179cC754501F9972875896cA7555eeC748a5",
+			"0xB4148bcba279296c4cddd82F43Be86B18280eB57",
+			"0x95C2D32fDb32EDA8ce7fbF6539Dd6945acb9bB09",
+			"0x8aD74d3D6F5C01963d6ED4587AB092cE1F19d6e1",
+			"0xE97905A47682c15A9D985338e90110714d06090F",
+			"0x703c91126e5De3600121517165b1f7980aD95f6C",
+			"0x3204d1CFe6fD0b0626b28650272a829921E90f0E",
+			"0xB79546f48607BF793569388Ead31CC2CC7450F6B",
+			"0x3bcA8c47729620d36011c340970860c14aCB58B9",
+			"0xE7D9C5d8a46099478Ee6d2dA817448e054522643",
+			"0x48Bb5f07e78f32Ac7039366533D620C72c389797",
+			"0x8e4A9a3382754eFF95f270730e3C078496f3b84C",
+			"0x82FF829bE713025c0cD6375e24940d1d55b3d7f6",
+			"0x0281BC1125751Cb28614320d48fC99B04ec4c79C",
+			"0x5D2ba24DC39e07B325b5A5F63053cB7549D6e85E",
+			"0xCbDf4cb386b42C1ab41F8B5009cb3D87F6Dc623e",
+			"0xd00BC8A9721a8f47340619d29c22ABc35922b40d",
+			"0x4DE643340a96C0675c0E04F66E50fd6652F49FDD",
+			"0xDd23eEA167f58d881E38F88cC4786B99e1A8ACE3",
+			"0x66Ee8e2291CDAe6F37DdEb4118f5f59c9e2707E2",
+			"0x58B6Ca7c51Aa5661F14Bd29af3e6C4E12B1cD4AB",
+			"0x0790Ab5D856c247caAB266bE64eF7659C98EB2C0",
+			"0x56F6Ca0a3364Fa3aC9F0E8E9858b2966CdF39d03",
+			"0x78eb504454Eb18f23f6D8257D0E9fD360948af0B",
+			"0x72c2271252628738da154AccC9564A8d6bBE81e5",
+			"0xEcC5e08237AF4d1b54ecf7dEDaEA92E27FE2e20B",
+			"0x31a9b81A395863345360c00D4B8867866b976406",
+			"0x5F229db53aAD870BE897c21AE5c2d04B9ABB8f98",
+			"0x757371D111890385877769190d1bC8752b71033D",
+			"0x9112b207ED0084e1389AC9C3f53C67906EC8E9Ad",
+			"0xEc7eC1a8842F7368701f30BEEE6E0FF3dB1c2F42",
+			"0x9CDe2d631dbEA21AE9C75C0c6cb58E3580EF50eE",
+			"0x4DCa8E42634abdE1925ebB7f82AC29Ea00d34bA2",
+			"0x0c72fb12509A05d86d04A268278858bc1e1B9793",
+			"0x0a0DFC239D545463F7fDac59202F28B7044cc3F5",
+			"0x40434D4e0f2bB84F1f068B46632aa7dC25fAb543",
+			"0xf4f796aA2FCc421fE785e0E5eD29b08d0a0E7Cf2",
+			"0xDc18b6769E56EC309a567f84601f2D7d9E95f3cd",
+			"0x210e1E6eB6A92dEDAbe91d6E00bF53387d4E877d",
+			"0xB91A084129C3Fe13d4630a2B6DA095494F360149",
+			"0x54BBe512f7545dd678815d12b5033967Ba4226d0",
+			"0xE9470148ca2C9CFF14B965e2B7Ec0Fc8Fa7138b6",
+			"0x1d5535aa678E91b663f1779aE12D14FC3D6aBA0d",
+			"0x5E04550Cc139d0Fa845f12606959A895749b5B1d",
+			"0xDAA31d87E1fC1C59b3d28dc75fe11cCddF3B9f7e",
+			"0x0f0B9Fb0a24D993DF4b0508909A39E6b4B75A25e",
+			"0xfD645b7a99ef7adF1096140206489362F916eB1f",
+			"0xFF43Ab0e317b18e208a6ecE0520D5D6558DD3eb5",
+			"0x3B42b4Cb52739F4daa24f4f34c4f631e56B20f28",
+			"0xFaAD77090Eff934Cd8Da39Ea8509eE4DC4357362",
+			"0x234FB262dd478bb4eced72FB2f6A10Fc852D12E5",
+			"0x4Fa9F0caC6Bc7d4574AD03827fba8c9cdeda5aE3",
+			"0xAEc9abfdAC8AaF2b52E57bC08101C72Bfcbb1E29",
+			"0x60a5fDD633AF768b7E4B8308B463E956aca1ff7B",
+			"0xB9e0F573fb4041f21506Aa5Eab66DF4B0fE598C2",
+			"0xad02320a81606fbB760C32e065495A8ddbf322A8",
+			"0x87674bBDed56d8749E49B00413a621831a0Fb266",
+			"0x96948447D1521260c24fCdE281d09364BdC5A2d0",
+			"0x02260935f76D70F4e6eabcf3aa428478b97eB1E7",
+			"0xE5F7337e4f769386F008294381f85CaF37E06A99",
+			"0x7ed0F734e5eBe3112d62fFb3D669222de5Bf08B3",
+			"0x41eacEB96C38630BD9044C61168fe3BA239dbD02",
+			"0xB8Fc7E0aE1D38f40F38590925104Eb2Cc816E60E",
+			"0x5098328EAD41F41614468Aa5e6dd57e67aC4304D",
+			"0xb5836112e03e7054898E1A0B4Bd1982EFBE4e465",
+			"0x56B0FFA836d669E144c37a4aFbB7A52119ef937c",
+			"0x77770571EDb8158e04E71ca211455a677C3862A4",
+			"0xD8dDb2C3A57E9b74cc4e97958a53eEd39Cb4f659",
+			"0x1228613B3687246207AB49c8EcdfBCA922357468",
+			"0xc606654A23fd18661Df7aF1F8485879885F41dba",
+			"0x1B6C9c20693afDE803B27F8782156c0f892ABC2d",
+			"0xcBFe9C7415a06aC536a157ce6250C09aB4037C43",
+			"0xFcA7145C0af3f35B705204412E8dd793C5977451",
+			"0x30d70E3c613C50DA8030556aa949fbc21Fd51454",
+			"0x4D3D0e721C66Db32ac98E377bfF2c46D0C7bE36E",
+			"0xAE960363E6dAEc1Aa9fB26C6dFa99e307686080D",
+			"0xE5C7565B45C5109515b4dEE70330Be40d2D198fB",
+			"0x5A83B77BBCF2927d762849843a6Fc295602198E1",
+			"0xF45BaEd2Cb0d1d54E5C0Ba6530d9CB7AE78A262e",
+			"0xCaB22CB5b72A51967E16d1D4C8aF80F7fb6d9320",
+			"0x4052352c41d981521D554984f8bE423e50c7E958",
+			"0xED67bd930ac357d52576A39A75959b7194db652b",
+			"0x8cF4B90133F882933519d6915dcb95Ae7e3589a9",
+			"0xD9164FBa311918778f9d8a95212677A45d07e29b",
+			"0x8B3FE1E45F39484C402b0D43769B45752731eC98",
+			"0x64DE5e1B9aD06D6B007804e729Fe0f0072FB67fc",
+			"0x175663Ca84D391eD044577946652b24D641FBF7B",
+			"0xD6350F289732d418121554fE12EBc178b54bd6C3",
+			"0x7D6b75BB36f2dF70cC1a45d07f57f3fee67d0C76",
+			"0x512d959cA4839694f53794F4c9764F42551a6eD5",
+			"0x4962cf4a87a031be0f7A4C6b08E86658Db0f27f2",
+			"0x3Cb93c5fa14B16AE6E8B67fCd7b1d2471F10677e",
+			"0x48015b9C9856A24c60853bB8555034D901e5C58c",
+			"0x66F2153DF04CeAA8c53CB327a7cEDe936Db5AEF5",
+			"0x4A52F57FA495286f804708d60F4a92C03D05E03E",
+			"0xD38542ae5888E36440b995618D23C01a61eE5B5C",
+			"0xa9110c338DF2C79f339A9E97895e68181cF2Df4e",
+			"0xE54cc7C256Ff6ef87c4E37280d7a4F6Ee877bAc7",
+			"0x48Db4e596a25Ea18adB8cBC4AAc37E99eBD7f32f",
+			"0x01cF63224509A8bF1F6C50e0dc493286BCA70fff",
+			"0x02B91F47fda6ae012C119006e5e7a1A88b025BB1",
+			"0x66C9c1D2B620332Ee914374842ba98Bfe26FAdD8",
+			"0xE2cB50C226EbaDb5391a501CF84CA84C63954482",
+			"0x95F0b38c3837AcDE6a31466EF883EC3491E052F8",
+			"0xD654953D746f0b114d1F85332Dc43446ac79413d",
+			"0x7aC0A043d625FEC9036F5a48B22ba28dcC1DBf32",
+			"0x4279AF04121F948398FA0A2204e75D86d5AaCCc6",
+			"0xCeC960941Bcc3961aab78Ac0b31dc22ca1c17f81",
+			"0x27158e026DC046239b6C19aecaca9A65231Cfbb9",
+			"0x367844faD5aAB8377d980d65495E54Dd63c9591e",
+			"0xF2076EF42334000910bd2026a489623127988EC6",
+			"0x5efe438ce5334294FbEC51fD0F5ce69bE152B922",
+			"0x3BB3097f05a1Ef30EE52a172896cE3a05a875743",
+			"0xaC6953E8ee8C8032009853931a248761adc70f0D",
+			"0x305aef05247761e57327DEc5a0c2711eC16FB713",
+			"0x835827B9f800DF4a2E5A1851D8DB40964b1984cB",
+			"0x8B2Cd6Ed5aC10b3321cdFDEB26a9A0A38B8c7031",
+			"0xAeff68596B7b089445fd7451758A8B43D6b15098",
+			"0x1b7E9Bc4f939D1B307436fB1235f16c606250334",
+			"0x7cCf6c66085812aE4221Bb07207b3672aDd572E2",
+			"0xCDdbF9E265564a9fFDA8CcA052Eaa720a3C9e0f2",
+			"0xCF3e0807B72f307e50EEB51468B22411d5350C64",
+			"0x77481bf9736016cbF2D502C6496F580d6cF02e5B",
+			"0xB97DCe10397d3330Be1B0b56Ac58419268687432",
+			"0x9517da1144252aBc848Eb3c42bCF6b872fb60d56",
+			"0x5F9D0288944B0eA72748BA0FdA84b50da03fd214",
+			"0x1E43B28E9936e8A775fB2787107B7563624Daa70",
+			"0x530204acEB59d515d0E672b7A2b2D17f95E113F6",
+			"0xA25575865caE6935c3f5Af260E5644ee7F2276cb",
+			"0xC0d2a7a3D6e19caf2Bd85A2118DDd5425496C2Ed",
+			"0x00F9EBd1Cc6931105AdBf66EaCe1b69312Fe28FC",
+			"0xD7068ce89ADF01E3B7EDbE4e1c7ADC71FB07375F",
+			"0x2AEe52Ae158C2F22C76C6985a1bA726673C4655A",
+			"0x6FC0ab1Ac33eAFfE039e9Ebe19745dB746cD2Fef",
+			"0x68751DdaFEeF41d26a3e1Eb3932d2BA0ea5D8254",
+			"0xC223404062bD80F8E739240e1cFEdb5d1d46aE56",
+			"0x4f955dd9AFF36159b182F2EC40483Fc72934187e",
+			"0x0D0Cf4b49Ea247efcEb453BEBBCBB19467E18C91",
+			"0xd991f43c4B0dBe2564F2023E12560757ec0f34Dc",
+			"0xdc8e2C6385d48a572b82CA0AEa8C25D83B4d1eA2",
+			"0xAfbd3c3ED030d45484E10334aB9b15C3414DD142",
+			"0x54509f72B9AaA941BECaA098625Bff930bCfB1A2",
+			"0xC632d6B8dfe192A61eaD56C2BA01B4818803Bfca",
+			"0xB4B998f1E3377Db7c05c9706645258303d48D8E8",
+			"0x08e864E0568b31801Edc88e209cBfFDFc0DDeE77",
+			"0x7c5e84332765CAFEFCd44A611f7404F1BB9079Fb",
+			"0xC2fBfe51C7287807063Dc401277cC26DA7e8a3cC",
+			"0x87a5e34c2BFc88c9C61CeeeE7F39A283570672b9",
+			"0xA9DB45c29Ba92B2bE17ED49853b2178E6121b369",
+			"0x938205713972aC44c27ddab1122299ab343337fb",
+			"0x2134df9562De43a56eCf9b731f453c7c6a04c863",
+			"0x7E2b55373fa7F8DD71E240b619478AE63633C665",
+			"0x05A3AD253fdbcE9BE285740DF8289C4d087342a2",
+			"0xCFA56296E679A961D0ec1c3a26a019938CB65533",
+			"0xD7E5c169E9Be2BD3e5F9F917C7521a8a55a531bE",
+			"0x7D77756407dd3c11c3F98ffc8440371eFE0B4cdf",
+			"0x14f783477E1CF3306cEEc1C2f1031387c2fCE862",
+			"0xe6bc1a881D09Fc51Da3A8Efd1102697a17f91C60",
+			"0x54276E0d467DE08Bf4C53bDe5A3B06685637dCC8",
+			"0x6895a004E866021F9707C772e3296D1012b516FB",
+			"0x4BA3d9FD39552DbaAA7053F001A2Ee36Ec8B44F0",
+			"0x300072D0CD7c5417851D68F20cD09C5aCdAF750e",
+			"0x3d5dBa7602309FcD45269DC8C817ca9C67E571Ae",
+			"0xcCc2B3f5C473232B81324e1F9F29088431E9Bc5F",
+			"0x7Cb7bb87593A9CE3159F1cee1cdf57Fde0432099",
+			"0xff1004325e41e2eBb887e10eb08EaA72305f8459",
+			"0x11f475A2fb5F6E6DFC6914ba0152394ef2E64744",
+			"0x1958584C0fbe13372a62Fe914330CF2f5d40035f",
+			"0x406a99f9351c4b3d59Ce99EE6A04d263957e1d92",
+			"0xD628EC99942b7C96599804ad8b91834B19548407",
+			"0x2a48A450705c9a5E1b30Ab352330d97eB6Bb36bA",
+			"0xBA5cb2D4667A487BbaC8d1F2667F5cB68CDF7e6b",
+			"0x16e2B0513885313D3bB6bB71Cf856AeAb07b751d",
+			"0x6e28855D37aB9ad68Edbe68cd667CA789b51A6c3",
+			"0x28e5f8d8C0D856Be1F2AC5ABE2B297F7662AF2e1",
+			"0x44Bc6C969C59056e4984831246d58E274A571888",
+			"0xC4f4C6cfa07f99f38052B4ff97c5179cFb7CB478",
+			"0xB06A99E135316ACbb0a8b212b3Fc9dc07A364B5D",
+			"0x3822eD369a46D9B5347850eEFA3D0ACc4964c24b",
+			"0x1F1204F1C301526cAE82F089554ED955977F9436",
+			"0x8B6e0Aa1E9363765Ea106fa42Fc665C691443b63",
+			"0x757d68B092a039B0033f4e756D309a6b5a9cbDbf",
+			"0xF3d5feA0E5A557c3922C88831B0b1d7d8920A250",
+			"0xF1a3003646a7d39808854C3bceAAb6A5cB174c9A",
+			"0xF813a646e4AB97fF71E1e3c6b381C7b0B28F9a9e",
+			"0xA4CccC10f2DfEEdf902F90cabC7439bE60Ed523B",
+			"0xa9313Bd0cc3CCfd68F670E4715073FbD6Ff1A108",
+			"0xAA676B5C71D7B6400b553582225C6FfC0Cc3B160",
+			"0x8aDBfB4Bd9BF2fbC9DB54c2f2e1F4910ca49ec91",
+			"0x02c15b424d9cb9D34B650E49868bc29723727f68",
+			"0x003DA4470Ad79aD1498AA807d1961cef90CBf7b8",
+			"0xe94cDf0Ee1c5a3b7F04D66Bf673dC64414943EEd",
+			"0x6B80d1B9A936E0d1e69144fC03422E1556a103fb",
+			"0x6Aa0421dF2dd4D90faB983D048825c8f100a5845",
+			"0x8a4943E82AA572DE95db33F8cd2e980F7A1F8503",
+			"0xc64FDC71d00616d5F95F8A274e63083d38AceBEC",
+			"0xF5997d9d55bE8D349582302C56Caec92c387e9a8",
+			"0x74f27e9763d660e1C039191AE23C1C60Cf1C6f86",
+			"0x7E2b4D814C1a7261622d52049c5610b036b3302C",
+			"0xF4EE46Ac2BA83121F79c778ed0D950ffF11a18Ed",
+			"0xB66981daF23a6da80d9fdf7494e3889c82bf5B87",
+			"0x3a602c32BbdcCa8348FA43b9d4d981D199F44d4C",
+			"0x026809383D6eb4Cb274D292e47CC3DcBC90B9911",
+			"0x59A18C26c1F7D11A7b58171Fcfd3b5bDef7EBeeF",
+			"0x31Ea6627C958531823D50024028dF6635DD3faE5",
+			"0xB38BDa2d2a4C6273C4673060D07E4f6f68F74eF1",
+			"0x65c6F60b4E271b120b44e7D13F7865BAC7b027Af",
+			"0xcC82Fb9EAC89CA4ea0A95244710e7EC45845f811",
+			"0x93CfCBBc119Eb5dFeCfae2a2605d94Dab7E06Afc",
+			"0x8f05A23a1d5b18367C8BF9B1A6E73EE01c0FE7c7",
+			"0x45F137013164f5d9cf397ffd896CF9Bb157E0753",
+			"0x5103BD067f762567ca3d139aBa5F93ACF0ae7cc5",
+			"0xc5318C3F580d3D670c0FAf2248c8F6d3e81E16b7",
+			"0x4afF9781CFD213Fc3DA034d317EF4e4BE8Fc8521",
+			"0xFB7eD5b2d1Fb03aeFb4ae87e02EbBc3f8F2B391A",
+			"0x1432699384Cf459445dA9BF023f442A670d982E0",
+			"0x8DECC186F3149a6afB36b5302AE809bef11bE0fE",
+			"0xb5ac7cfB032dDe71ca51E6B7d524A9dE082a0719",
+			"0x2A09FbA034e26852ff38d5393f3fd15748518791",
+			"0xAF2f34be5e805a80f3f69A97A87cf85aE0D7576a",
+			"0xDA82F47d9D375B8Dc51Ea4Ea46337b841C816924",
+			"0x5F4f8bFFBaec0B53A36E4b61868D00971cCe19Cd",
+			"0x8023bf6FA1281aC464BbD5b38FB386a90ca53c82",
+			"0x1465eaEF8D7be47dA8F90716f89fE7D5705473c9",
+			"0xb95F5964E8D743E5AdD7D3a739a7E9E9399bD694",
+			"0x294369207984f53664618E6A83A9a14da894e0FF",
+			"0x61Be60DfCf341E82eB00bD1Bac863A1512db9823",
+			"0x1883aFE0a5A2BDbe43F62C95f43e89Cde3545EEC",
+			"0xfce8363Fd59DD57C7A7Ec6E138c68738809D56d8",
+			"0x1C70AfF6824f3704d0a0f1267F537369d1F86f74",
+			"0xEfbacA5A1B33e638d785e93C28CCE272a0917B5E",
+			"0x61514540991Fa9476AF1580663743177BF203876",
+			"0x140AcA869295C96894C0f8A685022Afa16Ed6352",
+			"0x2d0Ed13DD1E1939335B526bcF6d15b5b4d14D7F6",
+			"0xCD322281bD33E8730E2fF1E69749c6b53934Abd7",
+			"0x750533873a4B90E41264CDED82Ab0A55A61e5d6c",
+			"0x47447BA394a1046D12366Ca7bD87a817d6D2eFA1",
+			"0x556a9e30e09388B0BD71BA617c681fC06A137381",
+			"0xcEa787ac99Eab5845030cC37A5EfAD3262E78A70",
+			"0xEdb3709F0de06F6296c5D13AD84a73cAb94e0962",
+			"0xCFb5633EeDFFB3Dabb333F9fD3EAb2894eE39Bbe",
+			"0x591575579D607c8BDC5E1F15f1aa443FD5e5510a",
+			"0x05Ca313179bCC5F5a75A3b9b8dA6B1AFE0fEB89E",
+			"0xA1b3161Ce50819a220B2f19eb4FE126B1f2CfD66",
+			"0xba1A6bEd54B46cA7561B05E2309e6dC3A49Ba455",
+			"0x06Cb69fA574E08c016Fa739fE087deee69630B95",
+			"0x0b0c245813b2a9dFCeEbe47026dB1a9973540a36",
+			"0x636052FB0B4933bf46736E8760a4af3949Bac017",
+			"0xFB1bA29b1af417cA76AFf3c44200cFAE8dFd2eec",
+			"0x46F60bEF68090E32aAFd1465E213bE2b7E6039f3",
+			"0xf8D4fF7fa420CbE4E06AD9B52f990683503d8486",
+			"0x118435ef64091a348aCE0FF628aEb0B132987815",
+			"0x5E62244104c8317909fC83543c828F2dc3A4D583",
+			"0x696B5C6EB9a3C5F8d78caAaAFC9bf1A9b13a14c9",
+			"0x9Cd3D04FE2521F921478044CB6C8aE179558eceD",
+			"0xc27676af54709E546dC654BDc033a84F433d54B7",
+			"0xc855E7Bf837fF60201ED142FFBbD2c88f0e71362",
+			"0x4579F9f4Fa83C26483Dfef1b5D7c16D8274bC1c8",
+			"0x771f5320126Fe15736880B1Bf259A71095B32Ff7",
+			"0xFb04175E0c8B5f51850f04105F474938958AD44b",
+			"0x920E607Cb084E02eFd598EEba0c3A4120f098A35",
+			"0x005cC206D7303292f2CF88ee585Ef6AA9726782c",
+			"0x90A772b965019131DBb1609ba5054f8e3920fCB4",
+			"0xa5D0932a7e46ac888a670b5169Ed7385EB43a9E1",
+			"0xb69DeC4EF7d327a7896d36d520780F552BC003B0",
+			"0x2a046a5beC4F8161cFc9E10263c4C54bFda704Db",
+			"0x40EEBB1449F737C468c8F2867d511B4C4AD36ad6",
+			"0xeC7DA36566Aac53922edE2Fa2bdF380ae352996f",
+			"0x70b785E912d0AB5fB126F4d347d2F899b951b497",
+			"0x2e8C54d980D930C16eFeb28f7061b0f3A78c0A87",
+			"0x1500ae29C9F74A4e7a012B59D0C0c70b44EE7162",
+			"0x9569deAB7b21B5A0df8fB3a6Cd20CB8e1765641c",
+			"0xe402CE0b470d7bc68Aa421ff82A19Ff7cde4Efd7",
+			"0x2f080082f2b2DE0362a3c008c275DD2c9143e1E4",
+			"0x48AF5cFb3A62F4c5c80B3e7e6eFa483c46d7A65d",
+			"0x70748b755fe5BAe7CdC8BE3cc57b7f47fc5B8A64",
+			"0x0Ce9622315ad6fED2c915289EEfDAce57E660E66",
+			"0x097CA9C1A15fcd9Acc048f394AbF649c31a08E2E",
+			"0xBD9C82538D008Ec09De8c737441927d05e7f2E98",
+			"0x548D910F85E878E425529c7486a93eeF282e288E",
+			"0xEa54E6D5f66B29a44F30a9FeAfCbFe12aC3C7F89",
+			"0xcFE5853Dbe3dFeb104E3D550dc7B642689F126f9",
+			"0x901A5667948315971c40fC030191c7CcBDa21531",
+			"0xcd3d4Eb34385A2DDF470aeD617378EAae53082D4",
+			"0x34A24969C54fF1172879Dd993dec307a4a83A674",
+			"0x2009754eaa539102C4C575B0723521192Aac809c",
+			"0x3b1aD803238f8b0B408B51798cEd0C65e7ACe04f",
+			"0xc1CEE919D93DC545D0EA59234C11BE7A536E5108",
+			"0x801507C8e229eC43962915e1616D985B7150a878",
+			"0xA098860E891Ec61070Be43742a9418d8B1f7d0f0",
+			"0x1362769E23855390C9633FFD5652901D6De12B67",
+			"0x64F163797dc94302897C89615Ab5714BAa90535D",
+			"0x1E11E0c3b952B9fF78A92f0DcB6006270afFC69E",
+			"0xC30BA54784769F56AbED84263Dd26Db0E560c95F",
+			"0x4Da34070149ffA344F775D7184586BAE2F2e91A6",
+			"0x5D7F754F297cA85AB2029908706ed6CDbd00a6fB",
+			"0x0446D81627E342dF7239c4D7403C8131A8E93da1",
+			"0x8170F2Fc3e2C689EF17745e5041046a4711fa62c",
+			"0xea162946d81B3269A5e10Ae95cc5370559Ca48C2",
+			"0xcd523cc46d29Ed74F9B9dacBb1Edf3D81eBb6148",
+			"0xbA5107Ea923Eba5d3085e055Bb2e512c930A0B63",
+			"0xC34f4f7B9641C27BdEc2557e249d113EAdc013Ca",
+			"0xfd682C4ceCe9A749915aaaE3871099391fB2e0E7",
+			"0xBBAB3b7b6354fcE20b20C91dbC5Eaf5C90a4CbBE",
+			"0x4Cec8F03240B6E2a390BB53B7E0556Ed13b7d439",
+			"0x1DA09d1fA973c78954570749d75Af472a9148246",
+			"0x05fD545782aD8a9331D425fa913908Bc82675326",
+			"0xC7feB139D853B5496359871C3Ae30902A6d9B7Cf",
+			"0x24195412938290F63ac47F0130ABBdb39319168a",
+			"0x9a7d8863B54ED52098FDdDe8a532db8723395DF0",
+			"0x5FeCceEC9c3dD4b80c2D691ff35CA248Ec423C78",
+			"0x55216c7BF00A93f11cc7435D6FDC692E2d80Bc81",
+			"0x1E1E83B0e813afF9f0163634F2331ECA91c825Bc",
+			"0x60b77a94CC02F0dd43a87ec00FeAE2347F08c8F1",
+			"0x23bf83A0Bf632C47dEb1757746FD90e68965Be42",
+			"0xB968803EB0D91C25C8d586b102752eeFd6e76E1F",
+			"0x8f816b50bF20912e57cEfeE1ad6B6F7D20ab976e",
+			"0x312dA18b6217440123A315290663AcB99538c6D0",
+			"0x1a58276aC385440CFCb64c108FA65205F4D54A2f",
+			"0xf24208149576d43662800340fcA19eFEfDae2CD9",
+			"0xe99f8D68f7c13cB24B5e7A3BA89128402b4450a8",
+			"0xd30De3c069020A2cFe70Fe1691136704866B7c9b",
+			"0x7Ef379F8720CcC5529B586cAF9A837dBd6c4D79b",
+			"0x623ED17C81a500c960b36635D7b4B48D6dEA2b26",
+			"0xDFb23d4838886F9F5Df83872BEd0B6b612750379",
+			"0x1866A672715Cf430150a36C67f09d18e9Fd9c5DC",
+			"0x266C2E0C2566f28526B4ef2CE60F10789326537A",
+			"0x7e967993cB158dCb62E5fc42422c300A845f9fB2",
+			"0xE9e6Ed24E1Aad6405e0Da8eC16f355f16d3e9C36",
+			"0x84bF235fb4Da21a21bb4B1eb5ff0f17774dBFf97",
+			"0xB16d99f549de131adef96499c4181eaE817eceE8",
+			"0x1b9512bD2b025d5FC60FF0De97e878Ddb1c892a8",
+			"0xf00101986383047D0F492027C6DC0220cb48B063",
+			"0xbfDCccd1A126518160534bC64309b18189675A22",
+			"0xBa67BA73774dA585664661d22775dB9761418dC5",
+			"0x94c06629D8ea4f19145F0B0e19bd14f7a3F92339",
+			"0x9fF2820331372a57468A36aD2446dd214F9a104A",
+			"0xdFBA8A210372560e5334Ade35fe5e17ACC445a54",
+			"0xde49191E1E28D30e2cb1bd3696054B91e4A9ef05",
+			"0x2e0BE10d0207DB3132E583c9332b153F861f21a1",
+			"0x0de16C05d27C3591072587c08F20f19D81a9324C",
+			"0x969041A1c221393BFb861d2B945fBBeA03965Ec2",
+			"0x0372ee530e69E4e39b3da5d2b4a8AafEeacD065c",
+			"0xc2a8dD01aabB1e21bF2535Ca1C222ffC916e6609",
+			"0x0A05E2fE64EeBb8390F1DE7164B1E9D398Ce96D0",
+			"0x63C737E5BD543ECC0A02d91BfCb50845e1be31cF",
+			"0x7fa45D8a4a363f1583929F7F12c17B910C8D7998",
+			"0x6CD71A07E72C514f5d511651F6808c6395353968",
+			"0xfc71a821966B143F6db2Aee3aA129e58149df2B3",
+			"0x03408527d5430abF8F0D2C3F8044b7a8EA9209E5",
+			"0x3fCD758B246B6038C0EEFccb62df28B69d6B0669",
+			"0xe5236b0e37cBEB6A7cb22b8f00B5433dDa977D5B",
+			"0x2a7130AB4AEEEe5Be5c6E525D11CCb0314304Fe0",
+			"0x8B07c6CB1b2edA3942369EEC9DF8e12213f99181",
+			"0x0773ee22860E58e8E413B111259E529E6E1aC5A5",
+			"0x9dc017FDcE7eef9DD895F88Ec6C44BEfc672ac59",
+			"0x534a681EA5E16489B2dd9374ff7320b98a5fA19a",
+			"0x696A33A7404a336A8eA0dF0B8872D071896C9d85",
+			"0x7a6b53382E9806deB0A3b42450Fb9B7A679E89d9",
+			"0x009d611490eCfED2dC3F306231Bba7e7F3E9196E",
+			"0xDB7ef44D6689B7C74FdA0C2dD468e2FfD5E78bb2",
+			"0x5b883F02Ce7d7B6e54CbC5C053559bD6C78cDDd6",
+			"0x0aBE9aB39bBF370629Faf80B1CFDC7F29651D221",
+			"0xEe5a87263fA7F40287844660eC33B687533a7E79",
+			"0x61ee4e3BA0eC02eFb83355557d3055E26F315056",
+			"0x441805C6E38033e26a4Dd8F1C98572a7d760d013",
+			"0xe306C9Cb095165a90287d690ae19B4Df68F29929",
+			"0x553Aea36E6B60311077290D4cA6ab526194A0C57",
+			"0xfC471a0f5314759468848F907f67d437541d32B2",
+			"0x18F471AD74dfE9D0C97C6229D4b65602C5EA6913",
+			"0x3Ad7573772cE99430894Fc9159347cA7350324D0",
+			"0xF038f2da641cADe61207cD5dD7339B3Bd9b89175",
+			"0xdc64F3311c2f8C185509beC746f614CF25959092",
+			"0xDF637Add49568F11bDD3A447824f7d21A9BfD855",
+			"0x6725FA3E5683e28b864043886132A7a189F1fe46",
+			"0x192979c1C8792aEf8868fe40B2A2B5a719D1c8a6",
+			"0x7a0595662CC3249075Eb8F88679AbAA7f992dFB4",
+			"0x0ad5C283131fec3f3DdE4FA958048858e382eAFF",
+			"0xddc009B96581c98E98d651F8Ba94c789757C5256",
+			"0xA119f4e3dB14E97618778F4b867fa81AdaB33E98",
+			"0x5bc3ED94919af03279c8613cB807ab667cE5De99",
+			"0x8ab5BC4F969D21aB31560D8bCBBE904391d6de7B",
+			"0x23D3f3DB80cCe3A660275db91EA4b4659DBe9C62",
+			"0x214a0a2Ef3FdFE695d212D07eBC2c2E9Fb267195",
+			"0xBfE85f62B860cB0a185895f74Bc5467ccD804714",
+			"0x6438C129c93bB788546dC6f874431871833B9Cdb",
+			"0x0d35916fADf5078CEEB026A928070f903d33CbE3",
+			"0x94A7E9381457Ce4Ba887a2F712c88DDBD631086a",
+			"0x843b1bAc1b916E5F41a33A63865Fa065a73e81f9",
+			"0x2eb6C424679fEF0eB613f5aA581c8BCD49801519",
+			"0x88ebFe4D6702e13043e942655E570fD18AA63664",
+			"0xBF39b74998F88BC24d4c965c208B929497d6566a",
+			"0x05eCd3CD8ABd5EA2E904436294C8FDA406bAF6ef",
+			"0x9f2E89eA7ed12BEceDE9Ed2e4Cfff0D657506595",
+			"0x366bfA37515bbfdcd2a65d298F1aC90a98199ac8",
+			"0xEade377cAA1E34995D4E0cC07ddb39A3396cc24f",
+			"0xa3BBE52e345a5431B161685E26418363B78f3a56",
+			"0x2d7f4629f86FCab440Ac73D782978A6730849443",
+			"0x8c766668DBed0CC99A36D42F1866D4863df9c8D2",
+			"0x6d0e01bAfBefA1aA4Fad290170E3a22FF494353b",
+			"0x69048B9A5368dD2007D6bC5ccef9370F29EBA846",
+			"0x5EaC8141Bb984F776e8B981D47382CDE886F7e0c",
+			"0x8cBBE575932877D46b909Fe52A03D5ACFcD24c1d",
+			"0xECce839e3Cfe75A81ba7c27B6A13356a69464f75",
+			"0x157FF00E66b4496e3e42132Fd6898c188b3E5755",
+			"0x887A1fb4839285CC2B1169B69ED5E9020Ff656f4",
+			"0x2f353aA73A6FcD94020f89fbC7c39CF5B0693d39",
+			"0xD82b684426503FFf924349116123645A8205Cc67",
+			"0xb712AB0B239ab906B3E9907C49aba55Fe80bFd77",
+			"0x38326a16FDCDEb681d47C3DdfebE4911c7019A86",
+			"0x42A77DEdD13520141aaF1720EF88086B5Cae95f5",
+			"0x330244ed4C53642FA5809919490AD4a8768eF4FF",
+			"0x21E20544Cbbf3058c60C3df5F74924573DBBd9e2",
+			"0xc57ce326a706788c5dE7Bb0DaA209Fa789f0532f",
+			"0x2832fB9F11C928CB50595B4707491424052e60F9",
+			"0xA833b526f32DaCeAeC50b5ABFF21c3d57A739365",
+			"0x2B420B04447aAf9b4EDaaF8eae2Db173CC5Fb4AD",
+			"0x66A7009c3CBFC5FdEf75E89D104D1CD9ab50f3a0",
+			"0xfdD15d188Ea702cD1d168277BE414546F3e38a79",
+			"0x09F93d9519ac9B670bf18C2c713F39492e478DfA",
+			"0x01c7C4a8C39528Aec38F5A21607ef9eED6Ded831",
+			"0xE030eC0B17eB8FBB19Ca4b108Db064A5a47C2602",
+			"0xC9e49070E6B0F4939E2B737616eA64CaeAb3feA1",
+			"0xc014dCa6395A943639653f7C23B674c38EDFfC69",
+			"0x9A8eb04e2b0d9713D5adDc1a87C8dDf1c1F79D8C",
+			"0xa238C9F15c9143fB0609f259E0513bbC642D90C1",
+			"0xeDe7B02dec0C557d65CA90cE9A4Acd8EE1C60Be3",
+			"0x6A93Ca1a73E82EEd9d5BF7248ce1A8758647597b",
+			"0xD9BAD00a32b9B77C7c37Ce39f40b7123fFF22209",
+			"0x937565385471Da015c8a3b774755ee92502f5741",
+			"0xAb16345276aF44ce909E6Bfd27925CF2A39404D8",
+			"0x0293d5FEb2c6803472B36f7F8538e9cAB8864c6C",
+			"0x381209688099BB6a9CBA70bEFb83CE751dc32B3d",
+			"0xd8BEC3cC1710e8A60a61f3db24d39B35ec61952D",
+			"0xCcDAEaC42AB6C5C8b939927d81dD971A557684ac",
+			"0xF626432069CF89a860d99C8fb90fdf381452245d",
+			"0xe29dFE522485F618eE154c579415F5b82E55f006",
+			"0xDa7d1003f033c4248d89312A20523616dFf1e411",
+			"0xA28d036c4B3DC5B11FC0E1f627d040fdeFd7cA7E",
+			"0xBe74f36a9aD94152ADCfBB47c00FF2b8B3bfc1FC",
+			"0x3f8aadD297E60Ebe7c1bB8d3f6D413b9f39EFB8B",
+			"0xF79a0680284a530945a938bE498D662613422545",
+			"0x1aB438237aE7cEE9EA62E4b0252003fa86e8809C",
+			"0xa849A4201Ea95Db5f480A7EF3b2FF53617431484",
+			"0xb5277de943b3C1bc344095BAc54C4b25DD3d9Ab9",
+			"0x673114ab397a0A759249935F4bDfaAA05fEF0e19",
+			"0x7482D32d1bd53F753c65F7FFAdEDBc913CD89475",
+			"0x736bC477550958c21417c14af9118bBeE7e34723",
+			"0x596464A3Db562cF9dBd8f059E26Deec6C2f2ae0B",
+			"0xe86dfF4450418CB3eC1d02F5576dca92feEE908b",
+			"0x37388434Dc065FE5BDbA657F1bc5E178Aa13A795",
+			"0x859784d1887cA6dC87c555d4a98e59E224bCd0c7",
+			"0x562610Faa7802fd1E198d9D98CBcaE14ac980b5A",
+			"0xD63792D1d4a205610c6ea10f081914C8C457Cbe3",
+			"0x2Df0d214239E20535060220aE54ef361606e346b",
+			"0x11a54B6E6e11F7a5B0Ad250Bc2a5b5A5bEe7DFB5",
+			"0xd9023f1010115a20AfcA2170dFBB8CaE8F6F8692",
+			"0xE217B95E80a5EA57f2C88380E4A29aD0470c6296",
+			"0xa357b1fb87bd494908360a37E2627580a3191B78",
+			"0xa01087ddEB29B3b6c8049483Fe0C702dBDB1cb49",
+			"0xD3f3774667419A29336c95C2d019b704E61FB619",
+			"0x82D8b49dAe4D764bcEd36FA933c458c2132686F2",
+			"0xf5f93e34a85F73ea9d75546e9e9137779BeD9237",
+			"0xFBEcF338f2D28Cf581672ed33E7b3073cD7a69d6",
+			"0x47a305498284577F065D0098fC0A37D8Ba1eD9d0",
+			"0x5E51f0747087b0e95d09dE46Cb7E86d162ca8E7c",
+			"0xFf444283Cc938fb45af5C0110b864019cDe4915C",
+			"0x22525f464BFB5ec2e29CfC5556f56d53EcF2D4C7",
+			"0xb5409bBe9Bb429B3956fC212B1B12CCBF0582ED4",
+			"0xD736F97100E63eA55BF84472B9030Fff68e3e602",
+			"0x2D68e5418330C21E84b24adFDd4dfE238Ead42Be",
+			"0x756a135A16cFa105b4508A81b2551D282a2dB420",
+			"0xe03805e0af10c2B600Cf7094E98894B70A8A1bDe",
+			"0x36a01A95eDd023fB2c24dACd30421Edeb51eab8C",
+			"0x9C32269971946E3cd6c5f16d0D93a26f868dD535",
+			"0xdF9211cFE213ec6A896ff6fAe9EADa31dE319Ca1",
+			"0x3c2152686Fe1497e87b094874320c30d93D1d93f",
+			"0x2Bf55D1596786F1AE8160e997D655DbE6d9Bca7A",
+			"0xce944A3fB77C10f124f063f4fb47AD6D8f132336",
+			"0x911D4A750b963C61f29549CBf17e2C10Fcd10867",
+			"0x51c7a91FC743A1ba8b2BD7c7be2Ba4F0d1021e3d",
+			"0x0f57D9d730715d50b2a0F734b0A564e437E90EB1",
+			"0x5E49377E6665bF2311B00eeabB83D6Bc544CD7c0",
+			"0x44a96425794dc0fc0471440175F8352b0588982e",
+			"0xddb278FA49B838efAE22f2d07c10552B5a24dEf1",
+			"0x3b7410B2b164f64EE9c5F242B17526be1aFbfD3e",
+			"0x79072c5e8538DFAf76955Fcd4f8F3aaD0fFb0134",
+			"0xbE0019811270B816866e57B6d7227AFe40F11fE2",
+			"0x85B504D0E0dEba6c069e673B9ffd429753df6DA8",
+			"0x5D821cAB934f044a01c3d59F7e144E4B3C3a26e7",
+			"0x3cea5419F22d7C6f2314CB7192550100c9Ee798D",
+			"0xf415e6D1b51fBfCE20629edfdDabD4b0efb3e3F5",
+			"0x74FEC4964F733BeD7857f237E519f63f8893156e",
+			"0xb91fC15ed0c8C15d5a550fb5D699AeE8aFFB8C53",
+			"0x1Fbe0750cBf8ebc0A60CA56f832997a76FC0b7CA",
+			"0x788ace58C88879D218a186e94CbD562E0d4f02a8",
+			"0xA92A6944e185cbB72A80f248f93Df67d9D56a2e0",
+			"0xAb0345ec943e449A3e3B1c84b1edBC4Cd0F6b329",
+			"0x6D327b4cc271652D2bB9765A4d079a3258964a35",
+			"0x9111bbBF4ebdC26FA474dc6C02723bBEF5d6Ed80",
+			"0xAf1039a5D74a87b07aa1C9b90d10d1e24360032a",
+			"0x82A7c721D8adA980Bd0f37F65c1619A17de3d4a4",
+			"0x107eFB6880C587Ae593443bF2333BA8c01D9a7a6",
+			"0x53e9f750d3B3dE0c004cbAc93764Af9441C49FCD",
+			"0x405f80d23c8054779C7eff992704fE10083E3EB8",
+			"0xeef7D2B07dC140127706f1d458c622884D81A151",
+			"0x37da632c6436137BD4D0CA30c98d3c615974120b",
+			"0x459fd02316862F3D2588423d20572f16439FEa11",
+			"0x2d5188Be6fF071aB4CbF76a9D654A163bAeE569B",
+			"0x2Cc9C6096deDE93f166c9d7c7ad620d8DbaF5a56",
+			"0x991fe642F3606CC3cF0f7b7eAA8A8E75587Ab723",
+			"0x84ee76222602bFbeBd0d285CdaC22826365Be7c7",
+			"0x4bAAEB8a6A87dab28Adf63D2Be0b111081b3Fa7F",
+			"0xFDcCBb9f04354bFc388691F2fc68E374970EBd8C",
+			"0xA8Adb745295e845208E131a3061fB68ed97F55b9",
+			"0x09fc3Ef22fdFC2a45f30E42f76C2Ce3c109500FA",
+			"0x1ef69d4C8C903Feb7e89Fe8C31AA12896A5b27d0",
+			"0x19E938A0bF1CaF158d28D6e959A15723F066aB5e",
+			"0x0947294780EFa97b50B0c6827216B220935e7216",
+			"0xc5d5e4Faf35768F72146BcB8AC77909235B77B80",
+			"0x7Babf7770c65422faC22e284401A041D003A6005",
+			"0x2949F582Ca26D793a5C884284d7a9Ccee85842d3",
+			"0xe5EDB0F57395a82f5782BE1A6e652Ff287dEbB9f",
+			"0xAa3c7692bfbc6D7831029A42bc287273e7e7CeDD",
+			"0x8B83Ce8894Df2653B2C5a30aC9D69f9C1d1342F5",
+			"0xcA4A2a153FecA6AaC1c1411Df83B6d6b2df7ac42",
+			"0xF2Ff4867Db353F623ED3Befb0ac157DE73ce5e6B",
+			"0x9EFd9e828b64bFf749B65881692B16C15B34E5c6",
+			"0xf65c984f166553942C66BCc1FE74cFD3f26523e6",
+			"0x97Cf513DC5DAa6aD31b9784F9C441e523d6b56A5",
+			"0x118300893fcFa13f5918B32639773244a6FE083F",
+			"0x61934F31eE682A8dD43844Ff20a4D51531127b8B",
+			"0x7421F852dbE64f693649D476109171A6cbbD7607",
+			"0x1Ba21EC6eDD424D184b93ee5dC24eC7259C54fDB",
+			"0x5fc20bE6Bf7732EbcB1A5691552ba607f7395b18",
+			"0x10Ae01d3B3A48F33D841DA0dF586DC1d87c285d8",
+			"0x90870691fb5d4016c9B7F89Ec5acEf03d8724Ba8",
+			"0x4c5e614412CB89aA85544eD8c335454C0636578C",
+			"0xbF22a38b01951E6901c2da3a6f60eeb9f3341796",
+			"0x96D25e2A164037954096b81b0e06c610C5CC121C",
+			"0x08c7CC9FD1d53A3A12eE3Ae9D11732B4fE1A2Ea6",
+			"0xB10558eB9dA9fF46974C7a11ecF3b1333a397226",
+			"0x1D2dE286c27Ad0C87dffB96E99D4CD44fa20d4a4",
+			"0x89d03025A605adC422AEaa928f86Ae06ad9f1673",
+			"0xacb85595c219e7FC92623A0934eD72D7968d9Bf4",
+			"0x154D94A3Ddf1a4b4cBda9F5201eCf99C38ef5Aa0",
+			"0x78df70615ffc8066cc0887917f2Cd72092C86409",
+			"0x93F85336a56ca5840d79A3e69E5b6e7C5bcA2a3C",
+			"0x77e746bcCb930a51194b9bD8c1A03d44e7EeaE38",
+			"0x4c4c9BbD1754890684aEebE07f4E875565a314E9",
+			"0xd4dd4bf4abe7454a1C04199321AAeFD85A7beAE1",
+			"0x3d74B11C918A6a1F2dd85eD7d60eAa8469010881",
+			"0x8547e2E16783Fdc559C435fDc158d572D1bD0970",
+			"0x2bCA039DbaCc16a0D0d41c9670d4CF639f86A2E1",
+			"0x3c8907B80B3F2abB67fd8f27A6d4C9EB58a6Ef3A",
+			"0x6f0f37Cff6Eb0414fb9AB465cF23c605D87Ca9D7",
+			"0xe0359e3B91Fded62b330d53E78CD7DCE6a0E8D74",
+			"0x4cEd40C4993229B0eDA1639a0737FbFed6506426",
+			"0x90d25E7B5162D40abb98d921FE4a97158f29c60b",
+			"0xb57E9206c6346a6239CaABcD7c485B2d61f87E2F",
+			"0x5d0AE06f73e2BDa8246170BebB5020d779C3B327",
+			"0x6E4295f2E80Fa929b221c656d835bF5fF735890e",
+			"0xdc9155050A5baB27D37B930e877Ddd307f0331b7",
+			"0xc58d988bb15B8614CD32f4366433Ae567b1D77f6",
+			"0xdCed5bf772B03f929fcF3E0b73169F3786f1419B",
+			"0x9478c944Da2449D721Ee606eC577c69b91eedd12",
+			"0xc6bDb7f486AF1D964f65D4788b8DC9aa710f199a",
+			"0x41ea01c164123d37b592BAce7e37998A01657982",
+			"0xeB5b8868c6507CefAed70aFdA8b6d44a56004e07",
+			"0xe7A0aa475995886e43096194EA3C2f8A4245B348",
+			"0x1e2792e17a1E1b467a424050Fd8a42C60588923a",
+			"0x750EB43b5c6E36a4451eC7a7480e6583B38F287C",
+			"0x6B25ef953Cbe59D79E138bf0784B7CE89a0E68c1",
+			"0x9dC6dC635c7581afdd45de9EfdD03C7C57A650aD",
+			"0x1dca8D6f626A39622bD48f4398A3569B54E6596F",
+			"0x9dd4A2fca5b09c8cc8961A7Ad847507b5F8325e9",
+			"0x11444d985cABF35592e52C891b21AD42B4035FD5",
+			"0x582f7C375F5F166BdF732CC708fbA7841565734D",
+			"0x5261A83B62cf8e73B53C17D99D55738DcCe8FCb6",
+			"0xa0e7eCb7b035138B8698c972BA4386bce8555885",
+			"0xA16ecb666Dd3F4fF5CA8049d1543bA93851C9624",
+			"0x40F9473285BD9F8BAa7F9fe6f6c603239C45E2eD",
+			"0xe8Aa54223b01DcdE33eBdb8A62649D8dCe35E950",
+			"0xb9acE062874fD12b4f66E2325694e39FDc39EEa6",
+			"0x1C877a5814bC8bd7053724b42c04AAA8F65DCe11",
+			"0x01Aa28915D123E324B240F563d0861DEFec0FBF1",
+			"0xb6710572A14cEa1dc95dff07a292d2fe7c223700",
+			"0x494C2805064e2C535039086452b574afb6b9Da5b",
+			"0x0AB76Bef3ed67B87247d39e16cBd519Ac994AFdd",
+			"0xA81Ad41C6883490b7F574B4321da938c82b78E88",
+			"0xC77Cb79c323CC78af4599865E0CD6c513fB5Bac7",
+			"0x4Fd7D13c126eCE5c3fE7A839871B242971D259b3",
+			"0x61B902666e021Ac3B81387d83e46020c4baa5Aa1",
+			"0x2A784B1CFb5bBB575a809a029005c93346e81CB2",
+			"0x401268e20C20269f54A4070b20561c851F2Cd63D",
+			"0x48E8bFD461D652FaF5aB6d192244F8B09fe2D33A",
+			"0x5DD3fF92ba9c5A5C43123Ee22dC5b99D96e3DFe1",
+			"0x8c360B3E3ffEe9b3Ac0AB31bAA14e9344cE7F661",
+			"0xcd39a4D661c82cefD0edf165782ECD4101e6a326",
+			"0x1a6C61E04FAAAb956176A22056f7183C6B77849E",
+			"0x1b489bD15C04b9a06d98D90816921648ac0565aE",
+			"0xA32feb66B09Be55b65e0d5D173E4e2190951FC6a",
+			"0xa1e1CD89cE7497B6457A8f3E52e73c71c9a42029",
+			"0xeDCd4dBC58eF210e39bCc0c62A1Ef060d45415F9",
+			"0x740665ECfD321ee5E172eAb31544727D660764C2",
+			"0x87e36223Ff92C0FCBE037005bD31ab69A5C5B735",
+			"0xcd2f810A8002e930b8A8bD0D1582C95fd0945E42",
+			"0xE68c0A419C6784D62A596a41ec626C612440c303",
+			"0xBc41f977ff2a441F799Fa2Eb8Eb21129440Ad18e",
+			"0xc491e4Cd8Ca4d9F53f8ce01f798B32009E1cd84a",
+			"0x98aE5A2f6C3C39B4171a84beE4c36C80e024053D",
+			"0x24264BbF05022eC9589CF44CB532822dAa261c74",
+			"0xCCE781158453dC91db4b30E693406C2B2B2159c2",
+			"0x35f3e7CeCC2e29C05C32A9eCE006ea365D5Ad927",
+			"0xA902619D86c37F1c797D3CE1e449e7061bc6E0A1",
+			"0x534f53242b0D708B1cfe6D0a836A8A959B08C413",
+			"0x0cD7B8e41Df4F0e3bD78DF9e6e0280D4173d3757",
+			"0x7045605B0678752f647E925d63ce22A55266fc71",
+			"0x756ec2F17Db80317313550a2C4a061319D28CD0B",
+			"0x514b22D8202FbdfA2708669eF0741f403Aa720F6",
+			"0x25f97CB877e3c0f3bbB0d984c8006b0D1784879A",
+			"0xdb8E1F1eaE7cE0a27357989720D237DF8Dd7AA49",
+			"0xc18a46068EeeD3D53AfcA61B309f179BEE435118",
+			"0x7f0bB80D3108Db907a0e4cA900CD9d4469Bb7f3F",
+			"0xe3f95D7cea4bA1dfc0A42822e044553715CF2Db5",
+			"0x9fbCfd5A79eca1EFb8BF6A4c5207139ECe137f94",
+			"0x1088d1860f4E51A2e20440eD23619a1D0D59beB0",
+			"0x3E43fCede939c7e501473121b52090e2837A6cc2",
+			"0xddD362624ACb6f5AE6F920E75C7e92871dED81f8",
+			"0xB4d59BAcbDE1c7755E41b70774ad54991257e20D",
+			"0x71308F76cd290620F8824731739df92DF63816e5",
+			"0x3c5eEa1D374178C6Cd2A7c32b31cf4c72a323A6b",
+			"0xfBBb4d85158Fd37702303E456BF331D592C43d9F",
+			"0x1dCB05A912a2D636eE3Ea84047B0848B9c25e096",
+			"0xD4f278f325dd67e1e93d21F9B9A1e983AFc6a3F4",
+			"0xb9D896Bde3ebdf9571063F0D39715F29c01954CA",
+			"0xF29f5A11c9749f8b7C3f1795F410D4e80701EACb",
+			"0x1f825CCCD04A04293F72F6c99a9A58101b38aCAF",
+			"0xdB357553c2885c248c18F9503f9b49f57F32B6Ed",
+			"0x1d759F86c1D435FECDa9f7027277a11E1d2dC7B7",
+			"0xb32A3686e4fF1aCc673018F60C3557635663E5eD",
+			"0x641041c705331189B1a5B59EE3239De4828219C9",
+			"0x776c91B0048745df496A77299911b835f8424Fa3",
+			"0x5c5044ea5A006980DaEcC7dd9D33635F06099953",
+			"0xf94C3e0f0630a42350f32abC5B2eA118AABa8E8C",
+			"0x8bfFB5562ff30f555894E101E6DAD31D271EEd5a",
+			"0xAD9F5C4855b7cA234D60F25b977a274f050778e5",
+			"0xbcc57121B8A294FfCF6Ec68121406a18a3DFa383",
+			"0xe0AF9D90F503a6925b9AE556C7DdAf1aB82488a9",
+			"0xa5B1020DE06D21dd9F1209aC462e3c5C86375438",
+			"0xe3c9F05ea1DA002Be695e6697BcD40Eb626Eb2A2",
+			"0xc715F178070D4Dac5C906982f43B17EAa11c2382",
+			"0x8fE1e44f39e6834ca57E724cDBC457750EEC40b7",
+			"0x64ed487019FF6872EE2591637dB13D7eE1226db9",
+			"0xC76dFfAFafa46CC6A312EFf0774059977CAFE350",
+			"0x80706b443Bf9384ACe3ce161a475A1DE90111408",
+			"0xf54041eB92a7B6D1cf9145e44279Bec607b657b1",
+			"0x4f9fB7ff0F08ecCF7B6df4c6332666b4bE35c199",
+			"0xA27F405d57B13b72fA81f23E1Ca0EF31aCCA4b2C",
+			"0x3f03136Ecd2E6c6fB962318f85baD2C7B44430b0",
+			"0x7D4556A9443b1Bd7D08a79bE7cf289cb34647768",
+			"0x2CfAf0E237d1750C0CDEf96a704DD5E1BB57eD5c",
+			"0x6Ee6CcD64f61075fb3077CCb4E45A994105C592E",
+			"0x547f194e016Cc662DB4660fd9A88D432C1Fc3cC5",
+			"0x1Bd19caa894ae4a2Dc302457b7ca7a77af80b17f",
+			"0xc0A65a68D710A8566e62a3DB0E773b56c51437cE",
+			"0xAa09032b4f91F870D2644d56e28a14B97ceff9aa",
+			"0x8A89BBB0930BD94c0113f51631A8c6616C7B8488",
+			"0x8F9235c09Bb5ad56B6a9F443DeF9aCeBbec73C99",
+			"0x422eD3BEE2c176a2DB895D929B51c68A2E9DAfdc",
+			"0xAa3E03B59a2780727dB6A5c6f43bF1eaFB03618f",
+			"0x55B6f315eb0A32e067C90AcE4D2010a71b0A243A",
+			"0x32FDB88bf019b6CD8AB832112968e48572071a8c",
+			"0x78f3CF2731C66dfe2E29dE6B49CB587E52761Eaa",
+			"0x04F9a9c20ECDDa2eBFC4Da84368DF207487D4078",
+			"0x325b3a358916f863FE4C9d758367CbFb48a89Cd6",
+			"0x8317c460C22A9958c27b4B6403b98d2Ef4E2ad32",
+			"0x75912DA1C1C7F432087d51BF3A3D1B35D6A33527",
+			"0x6951EBaAbc7f8dc66525F22586F7dAA0E3CA724B",
+			"0xd9721E3369C86476f8CfE64569d55c9d4D5Dd759",
+			"0x654989C8388AD3aE166565B97C4953E35aF1dFc1",
+			"0xdF81889adfB8CE92142f0089388Cc9E5edfee765",
+			"0xC5C9449b5a295C38142e907d2C3Eb1448870aFDB",
+			"0x83f009a5Dddcd272cA96B955978bA2A2B6BF9B6b",
+			"0xAEC1a6d823cd786c073cA39B3c2b65BACEDF00F1",
+			"0x1B14a33f519DDcfb1612CC33f3b7C95f04FFE138",
+			"0x424BF5Ec4D1647f9f5F07e14584935323524eAdf",
+			"0x6a0eb514C8F6b30693Cd1872A5C0A10a1Bd8a752",
+			"0x02Aa20e26367Fb77D43dd5c023A69D4b8aE90065",
+			"0xc93c2ac34Bd2054B4e91D2414110B4F875cd8FB1",
+			"0x85AEEb6e66Bc66cc4f59be59ce60cFC078dfDaFd",
+			"0xEaAdD17983842db731Bd4b4dC0DbD005F4444eB6",
+			"0xA30621e53122977a5236FF7b10D896e3aaE6572E",
+			"0xA6B545201ad860eA46CA338FC262529C96f1140F",
+			"0xc2e39a7F30AA635C65A8e58659aC8b4ccb9b0126",
+			"0x096A13ACf03294778D2819C53379Efb2378390D0",
+			"0x383d9298b31433a063efE7d5C0B32B560E3D67F2",
+			"0xFa046AEb03E111C73673Ec45B0E0ACE313846ff3",
+			"0x78E335916F14363f8dB7c14475d227C6a2cc4BEa",
+			"0x5Ea68518DA7b739bF01016723e7964938c3A8aC1",
+			"0x8F6c9E3D1F76c6d5eBD858823e3cAa6CE5300032",
+			"0x6E4ff7c7dBAbaf53408428421eeA4bD26Dc8A52B",
+			"0x4Ec56aD5A8916c62f8d4723034E7A7310cfCEdb9",
+			"0x0Db0da204eFf0FFc2A6D1fC7d5E83D7846A862c7",
+			"0xad01A48076fb3D1A4a3A09A204E28ba061df716D",
+			"0xF9B8F0e48939D8Ad785D5736457Ef5a78B9D92E9",
+			"0xaE261aBea8A5e52b897135709e7D0d2536a3e793",
+			"0x8fE9745B8603887827033F45eB93351414a97550",
+			"0xb3ca41D538998f884D9f8cE33703A7f49F112E98",
+			"0xd95B8d039C150A54945207D2605eD92450bcCb95",
+			"0xB13a639727a50a28FDc600DbC741f26F2332740F",
+			"0x74b2d1f992722c941098d9aD3e9e0F28fbc84dab",
+			"0xdce5741a14C1B67a3D84Ad85F662E4DeA4320665",
+			"0xe4964c56f46063F25f2a77269B36a773140Ab325",
+			"0x2A6043a292B0E9495EE7A9b4Ca855F3b08D815aA",
+			"0xCf126a453F6B96fF424Fa5d78677e6671D5B9636",
+			"0x0B3576C34b2574889e7e456Cf2ABa965B8443b49",
+			"0xCA3F1E755BB2d36F8Eb3964562AB1Cf40A75920A",
+			"0x09806A23e8585182299750867934767d423F7B09",
+			"0x5A94eb47cA469830b2F9f56eb9cE9D6a11Aa9FCD",
+			"0x2cb21376Dc7aE0b7D1aE90975bedFE7C6B37B327",
+			"0x4DD45e2256A1914BE622Fc3D7d4accb8dC3c943E",
+			"0xA3698E208c3784895CDE220c1efdD726148AA3a3",
+			"0x83F7AfEDA326ab4d2d31C357cEC5Ea3539f03c6f",
+			"0x89C940d38dc0FFF95046977d23d642fBF675eD4c",
+			"0xF929b1072d88e37F49c58515b05286610293e6F9",
+			"0x9fD8b224B8e4f34c6eef4643c22669F1bEe3006C",
+			"0x94338021cC43c35a621434272c0437D3325E7D5e",
+			"0x0e313382017C2034edD51EBb73429C455aa9fe0E",
+			"0x5F227dce0baaFECF49ac4987fB5c07A993d36291",
+			"0x68985C601133c7be474E5d2eBa08c57cD128a642",
+			"0x6d0d3D13A478070faC4C281a7D3a3647f3D9A4Cc",
+			"0xF909649F05E26211eC16D4131349492F066cAe7A",
+			"0x292D678cbfb051a63F3827CEb00FA9a9D6A6eFe2",
+			"0x53e0e51b5Ed9202110D7Ecd637A4581db8b9879F",
+			"0xB3ca4D73b1e0EA2c53B42173388cC01e1c226F40",
+			"0x849b547BAf4404F6cf5c9235185969391860F40c",
+			"0x11dfb849285ceE04b331456129c8F5783B8D32C7",
+			"0xF29acE1FE5f36389d0dDe450a0195A30c3770245",
+			"0x62c1A0d92B09D0912F7BB9c96C5ecdC7F2b87059",
+			"0xFfBe36E6edd8422351b22AFf5ac8121dB556fb3F",
+			"0x27F1Bbe74688CA26041F1D8AD3741219750AaF22",
+			"0x4f26F5acCDADA1B4e76cedC7338C55f2FdC33256",
+			"0xE037d12345dA559C2Ed052Bc67545e967b8639bA",
+			"0x0b75058c129f17eA40e5D9b3A6485E943ddcaBea",
+			"0x4eC3432d9443f05022e2Ff4E54fC7514BE2359e0",
+			"0x44Bd27A4ea98b9855AAfC8d3e5c9DC37b5Ec6f23",
+			"0x98Cc2Cd55Ca2092034146EBD8eb043F9f976623a",
+			"0x754A84a1F7aaaB47C353283f4d07640fB153f739",
+			"0xBdd7CE63a3C438fbf986BE1E5fa091E553720c4c",
+			"0xdef145C89EE38d8e86fD40A9af466038D8f0252f",
+			"0x5B8e040510E1B9Ca0265c1cDc61d5cA5ec4A6A59",
+			"0x2B8f5653475d8528824DC551a7207160Bb789A8f",
+			"0x2884439c046836Aee4fee4F270cD163b37da55A9",
+			"0x9eEecC93b114a016257199F85489c608234F422A",
+			"0x70D319F74090d3BB6bfEA496Bea73692C59B1AD1",
+			"0x7A68FDA8EEc0ecb32DBC5B6A812A5B1Ad7978C15",
+			"0x96009c6330CBf561Abc9a988439c98d8Bf9686D3",
+			"0x9a5d0fB31Fb21198F3c9043f65DF761510831Fc9",
+			"0x5d45533683F60B05FE9EB04898B8f874FE7484Ec",
+			"0x5707Abce3fb176e34797602292B70b81De1707dc",
+			"0x6B45064F128cA5ADdbf79825186F4e3e3c9E7EB5",
+			"0x02D6bEbeE2bB75ebdbfEE4d25bd8fD281746FBb7",
+			"0xD3a5d478c9a407b357269eDB1557004704c4Cee0",
+			"0xeC65EFD8d990847E072333B0b832aa2ED9aE1776",
+			"0xC2426F2018f13Fdc5b260Bd0A88be753ee7FaFc5",
+			"0xDa31896795Ab3d89752A52E872Ea3ebb5247c24F",
+			"0x2FbE952dd04D4D24f960ECfB09a92FBa70914484",
+			"0xA968b236b0d5a38a30DBb88CD973A78Ffc19F861",
+			"0x493Fd12fd51554A2c1817D6Be2c7F3CB35ee8C2e",
+			"0xF926e6FeDc4944e44a115EBB3AcFE00236F89aa5",
+			"0x240D5f55aEc4Bd8927DA2fe31ce75c64a3C66eFb",
+			"0x9d57e4ac9145795703CE407cD4D378525bfe7B22",
+			"0xC8595392B8ca616A226dcE8F69D9E0c7D4C81FE4",
+			"0x42280C25391d5A0C4dEE8CE63596000242337835",
+			"0x073696030E75Aa269a7cCDD5C625bB49E2176c11",
+			"0x7Bd5461A20604B429E3546e375280D1311969d97",
+			"0xE6D27d0182fc07Ee7C6B17e59A7D4d7926543b9b",
+			"0x03A6C4E35739903ae1Dd92516035D07da06D056E",
+			"0x400913a196BfBDcE181B0Fd4e910b7bAD8b578F5",
+			"0x936e4fD4ADE51d694c6dF68987fd90dAA68058cc",
+			"0x8404d326C4BF82075C50567123683BF798C3725f",
+			"0x30610ad6c220738CE4964448E8be6EE737d19A98",
+			"0xB099D7dbC415bf5491B0ca6fdCcFddF8b7EDCE12",
+			"0xeE97acdBeF6424d4f98b39d96752C0bb92eCe85b",
+			"0xfA1e7160B908F0c34d7B6d7822F41310a6F5b0A4",
+			"0x611491938a3a1aB7df8bBd7e17e11dd1F9eC7824",
+			"0x0AE84c1A6E142Ed90f8A35a7E7B216CB25469E37",
+			"0x173DB0CB2620D26B58A2A9797AFc8e854143e0f9",
+			"0x509c97d4A7b98C91f1264304f228e3639416F118",
+			"0x989e555316F2cEF1949d782Fd875995F83b65FA0",
+			"0x540501A2eBDbC62Ec646b03Ee3B315D6E1708Dc7",
+			"0xD1D2052246Fe24b19cd15601A5Db4B2750bF1E53",
+			"0x8B4FDc4f7cbe2296Fb44dAd943Fa399B47DB9C05",
+			"0x70Ce114cd13a4e1d1B72Fbe00D6a0a6ed4b37B59",
+			"0x0AD0909a5163F03623E5CF5528e247DAff554d40",
+			"0xD7b05f15be01fFa97E5Ed64CEc8d99636049d39D",
+			"0xacF3093C3E8D63e51f9B901f1297c569161fb40c",
+			"0x3870D09F59564d8b86B052b1FB1e27b961f9BC73",
+			"0xD2892d12ee6f76564DA57792D97ae0c4A1fd3a45",
+			"0xD3C5617d60Cab1121Fb5c1171584139A201a739E",
+			"0x2F30baB00425D480b5E00c2172Cd049F7F01eB64",
+			"0x6b85bc3dB85d4Bd1fE511F21ee33cEFbd962ed6d",
+			"0x10a604B4C77C7a5E28D8D90468f99c8BF0b634c4",
+			"0x5588959dcF0b7587b0d877d5Ed29B77bFE8E730F",
+			"0x95A37CB2618B82cDB09F6f2F4c880C45972D3e9C",
+			"0xc25033218D181b27D4a2944Fbb04FC055da4EAB3",
+			"0x31fb1b5dd7d55cFD4ca31D64C5F5d8b8cfD4Dd16",
+			"0x7f5f6E492BDC2dfec3346eF0788bB83Ec99F9f1B",
+			"0xd47A4dBF580FB2C7D745aEDa3C9Ed1BF9AF0d5f1",
+			"0x472bed8dc54E1735eEC6EB4CC466bDFfC9E1FE45",
+			"0x85CD4913537eC4d187fc85150d28A40892304Fe1",
+			"0xcBAB548C95Ce4093d5077FC8C1A5F0387693c22B",
+			"0x0BE43929ACD985CaCF6385596cA283E265C1B52e",
+			"0xbD537A5afBB63295F1cab9A7A670415e153a91B9",
+			"0xef9EB14770f04A960572254b942827f16f67A3Fa",
+			"0x601AB3cCc7B2de314Bc007Cde7D59A3f604016E7",
+			"0xF93EF4Bea974A57d94852E741A27dD8f1a7aeEcB",
+			"0x67f3421e702C04e43512b47b055E80EAFeba4216",
+			"0xC9a0F685F39d05D835c369036251ee3aEaaF3c47",
+			"0xA8E06a0c42ef12d1d07b9Db1E64586d20737ebab",
+			"0x5307E81E2141ddF4f0339e387c888Be57E4f9D6f",
+			"0xDAb669A83dDe77Cf0F3836ae3BEF0E247e64Df67",
+			"0xF6C637ffcDDbdD0BA8440CaCa00483A830005Dd1",
+			"0x1D0EAa1038BA77270EF9698Ec41d26b0d83eb705",
+			"0x6D950294954E9d3891f108e7A991756b89c0Ef38",
+			"0xfF4CEf88372a9139d92141049caD7288F37A6cAB",
+			"0xE01b4151db36D732C31e089D66d703db7250b159",
+			"0x315B95dB090a78079194De404b9f4c17C5a8087f",
+			"0xcEAD1662d943DbaABe9Aa36a2610465009f8Fc4f",
+			"0xf625DF2BE53Cac287fFbaaCbE3A2E5B9d0994885",
+			"0x075CD0f61da233588Da67bBBEcb076Fa56b5F2fb",
+			"0xF6247E67A202474876d9985E2277e9c85e195932",
+			"0x744A4c9c9F40A443ac2A5747D4f3b773e5d86763",
+			"0x9493556ffD7320a2D9b993083274b270158512a7",
+			"0x0Fa0544003C3Ad35806d22774ee64B7F6b56589b",
+			"0x470cBFB236860eb5257bBF78715FB5bd77119C2F",
+			"0x21710E02f466ee8a83B4467Bd7b0f42bdaEF7452",
+			"0x8252296230017A9401f3e0fC56099Dcf3BdD0817",
+			"0xf2f19B265EEf10Ea4c3d86187445A9dbb0Bf0269",
+			"0x9FF4E3c40B200F286934A83ECA8d274B267E7ad5",
+			"0x5009633C920ACf842E3845e3BF4e4c1fC5178980",
+			"0xEf73D3166Efed7ce0F34DdA8cB3C305385Df9e2f",
+			"0x0f09EfbB0Bac79942936Da2c4b8e9A0E36E301B6",
+			"0xe4E3E7f05349F8EbAb9F6EeaDbE4A118E26E426d",
+			"0x5fd814793958f25119946eb3d01f341165885a05",
+			"0x3986a445d66b91188F0Ae3C2136D909161eD2f09",
+			"0x6B00d7877A5fFEe3f986205FE6201c3541af9AA8",
+			"0xB158A623A5e890154980693C218c932721BE2C82",
+			"0xbf7F8332a6C6BA66D11C42De3649E5943fa01655",
+			"0x72059e2C7Aa81d01B4eA0aa3348D6947BD2fEc44",
+			"0x75b64c38e328Cf4Bf55e8D8F1E55E80c893c6f55",
+			"0x60f40fA375BB1BD1293D1929f24C92c243473105",
+			"0x77874f119360EE305Fe423FDf269Bab6a80AbfB7",
+			"0xb3094e4B85229e8339A2408520e2dcbca1d37dBe",
+			"0xD48A648E815f3d7Fc207F06078eD0046F3961601",
+			"0x633F5137eDd80de18EE14f659D3D3304F8Ca20D9",
+			"0x52AA2664774B045011C98e6F41dEEaFC27e60C5E",
+			"0xb8F5c051C61E46CF46967774f7E1a6e28718FCbb",
+			"0x030692D7231a588BAdC72f3A2a089aB204d79Ea0",
+			"0x0B0aa8f1c7d368508e1cb2BEc7283480CF723567",
+			"0xe3292d326F23f32C887c6a4C74489c6c485Fce31",
+			"0xA9D8D4d49E8375f3D1EDF6818Fe54Bca0801A7c7",
+			"0x07B6368a056eFC23f3fE37D0Ee7B1dC755ff2541",
+			"0xB4983D67F69f64Ced61a3C01372D93e8e7fDAFDD",
+			"0x9869674E80D632F93c338bd398408273D20a6C8e",
+			"0x565A2dA8a42122A7c8cae807680a63A1902Fe61E",
+			"0xc4D2221157723d3254Ccc25259395E3Dc90372cB",
+			"0x7EB12034a8A2FD9c0A35Cde91Aa97DB5E155dC2b",
+			"0x3fb4034D846212642a9312eac20DCD95DE67ed04",
+			"0x0928D0631c6DaF0e0f0bA4fD8282b53f997De839",
+			"0x90540AD84F7547a02da739AcEdE2670Dd0302B52",
+			"0x98E43d71De3159E18aA5447E6433FDbCe6575Cd8",
+			"0x11B6dd0A864690500A160a92EF8eDC00Da12B034",
+			"0xA093dEC3E3A9895E5D3F0a30548f82a47860249D",
+			"0x7E88665f2BE01670c375d43bC6513bAF653c0Ab0",
+			"0xef2327E5284345974623D88E4eC431Cee62a9BAd",
+			"0xA5F0f04a2Ae38F28c6A2Cd3d505584B07726F04b",
+			"0x073662D53eb41F1362c03Fe07cC3942dc85f8784",
+			"0xF38dB397CAdD097A0C85ad2EC0aDd8EDE10036C7",
+			"0x3E705b985fAbC4C3d6d0674F005d5d24CfddD1f5",
+			"0xE65B0F7Ad5Ff15Fa94201c2919BDC14D5e8D9F18",
+			"0xFC4B23ee03cb2947bF063d0CdcF21e25F23aa58D",
+			"0xf910f3b0FEBeBa61C1a9Faf302bAaEFE4d29a157",
+			"0x637315757AC58Ee020Ef2e27e24482873011f21C",
+			"0xA4456B39FC8Adb455Ccb56B4587920a3AfF6ae49",
+			"0x6AC68913d8FcCD52d196B09e6bC0205735A4be5f",
+			"0x4538F78f6436cADca18307B73229abfe0CaB328b",
+			"0x24cb7c33773342851F1C4c14a059826C154F36F7",
+			"0x6476008C612dF9F8Db166844fFE39D24aEa12271",
+			"0x45DB4D02d3353148337Cf682c45a5CBAf07CB99e",
+			"0xe4d629d2DF66714eD0dd4EF8e27B3c69746d72e3",
+			"0x044CB36EACDB6e090b44226f5E7832a3eEfE53aa",
+			"0xF246d08Ec48757AD8752Af9b0986a7831Bd3E703",
+			"0x2Fa0e4cCAD9554bFf3813eac05320df7D5034E3e",
+			"0x5A5900485903d19D7f010a64F02864a9C3272E4A",
+			"0xb2F645d57671f346Dae16B725354c3082EfdD0D2",
+			"0x7C64EcCE9C92bd3C7a9Ca0d5BEeA904fc5F191e8",
+			"0x46Ab076929E4fbdFBA490D96D44a47Cf7FCeD06c",
+			"0x140ECe3E81EA384372e89317189E94bF7f30603f",
+			"0xc85527770E3f9b14E29F24Ff71A8570dD1C4823a",
+			"0x1b9248AD376800aCa986DA82837E610E43F7bbf6",
+			"0x1c2E887EB8Fd77a4A85e4660D7536B59F3e90e4B",
+			"0xe81c71579D7F5a4bde7b5389C5D8a3A69D6F2381",
+			"0xfF3975c44abB7066751e4ad45cDC0E94398886D1",
+			"0xc515d10Be9Ce6F084817a0fBE1862cBbbD4a4285",
+			"0x954E8FEA816201929447E5806A5BD0808A8801E1",
+			"0xFa2b29c2318e7195c18FeD608E09Abef3afc236a",
+			"0x9955610A29d1eD88704A727f242Ba37aa5055CFB",
+			"0xaA3F26b5A37fFa6323246123AA9f85AC3Be99e78",
+			"0xd646E168D59B317036D97971183a35223d31f7ef",
+			"0xC0f6BB66e2DA1d26a68e11e046C19E29dB484ea0",
+			"0xCA46003f47bDb18f2846A743d67F0C10261Ea4F9",
+			"0x53aEeDfdFaE2b5461457764E85e377b4bF49f358",
+			"0x458e2F89E3abb9B3915d29eff2EFcCcc7bE10993",
+			"0x67F755137E0AE2a2aa0323c047715Bf6523116E5",
+			"0xa2c5C324E0Eb1F0C9aEf6A820f8D29540881F2dC",
+			"0xFb059794aa72382474fdABEA963f08d2B3D1B58F",
+			"0xa4c7928E1B6EcB8b3464EE875EaC66571C3F2f06",
+			"0xb34DA672837aFe372eceF419b25a357A36f59F6f",
+			"0x17f4a746A7BF05C3e24A2BB7d7d25e4d3e5BBE3e",
+			"0x20B4143821c2Ee4ecaf35A2fa1D8456f4aF72DC9",
+			"0x92680A6F071BA355EA19F0063723486DC4ccd7Aa",
+			"0x9B7277e2b6dE4Db23Bf6a5CC63F5C491425ea22c",
+			"0x1e92B655F98e7Be5794367D3229ED0057628aE46",
+			"0x334F8DB1336ef1956Bb00aCC951ceB03784b3b07",
+			"0x0DFf890124097491d0b1582fF181e712ff679193",
+			"0xFF5E4bE7147054fa69639D22B84794f9855477ce",
+			"0x2813203479c8D52a0a7BF4620b9325a753Fda9f8",
+			"0xA2c24Ac72EFC40b6921c11ae0fd75f6642CC9988",
+			"0xd6429EcaDA2eCB126Ce6C16F5cEC7a42609897c0",
+			"0x9d8866e0AAFf915B82227e9C4bE0f0AE21937361",
+			"0x5F75d4329148764539cE28Ef62fA735c8ACA0a4B",
+			"0x9695906B4502D5397E6D21ff222e2C1a9e5654a9",
+			"0xa8cfC71634285FbdAA776Fd48756015a469F586F",
+			"0x3F814625Bf710E83565a52cbB460E089E0C034c2",
+			"0x4169Db906fcBFB8b12DbD20d98850Aee05B7D889",
+			"0x0759c10C7CDD92388a7A7B2d540A028DdE9Dab81",
+			"0xa7329fa0f3f4F2C2231292E3BFaE0f6AD3355d45",
+			"0x3c38a5479EcEA68DBEb3FEbd295266A19FE27782",
+			"0x976A7B475EA70e28A49E35d5db4aa2c78c33a089",
+			"0xEbddE1058be2D4c48C09E06f97C475a5eA839391",
+			"0x047D1856f9286324f7E3AdaFc0C1e025dE1a35d0",
+			"0xF4c2F68aDee2c824fd79F2Df414DA5b4d9E91B1F",
+			"0x9fd7764e2303E7748736D115304350eC64E403B2",
+			"0xdDb716ca82E96253aE88Fb9A976f5bb05b93324f",
+			"0x104f7a49c12a260B0DAA2459Dd27A985FFB43431",
+			"0x331d857ad39B8721111d8CbDf26002C4299e9783",
+			"0xAF63942498807ecD4C582581118a421dC46C0A48",
+			"0xe24b39bAFD326B012D6139C38905A8D6eF3a4662",
+			"0x3194403EDFDA78671289b251Dc75913984AaFDFF",
+			"0xAc208907d258637f0B616A66b88CCcD872da49b3",
+			"0x9675ab739F526e45CFd49C5CE359d6839AE31fdA",
+			"0x425194E48300D01F03541075931845f56bab4930",
+			"0x13d17451f83c25Da090A20C9E25f25A2f72ce5b6",
+			"0x170986eA2c7517bd9B3083705A2eeaF6401ee23a",
+			"0x0194E4a30F6DF6eb04c3F83c4837232c77F9B926",
+			"0xC17425514704dBbAC3EbeD53cA379021feC5952D",
+			"0x59a1F9b461113b0547b7215eE8b9d55B892B150b",
+			"0xF7F8f1054Ce54114887CfBD20FF4a24706174FF1",
+			"0x7fa85d9c88AE1F7133041391B585D0Ae7dAb4434",
+			"0x589634784Db8fE44d4DA8F9125642DA2e69e977F",
+			"0x0080F00C277A35f971b2E42766F376548BC7d536",
+			"0x022C703E11576fB5D740bcd5dD13F4A61B36f9E3",
+			"0x516b9DcF0C89D307D033b6d5a6ec1633a0dF10bD",
+			"0x2dA4B481026C1671E6cEE32A462607c37c9FedD7",
+			"0xF81Fa4fA444BC9e736BE73b314Ea06Dd80ac2C4e",
+			"0xA0518E138F0594f546b4Ce423376ef587Cd3A2Ab",
+			"0xF938C1735A32F4288CFEEe0eA60bd7e89E5aa0D9",
+			"0x63530632e8fE40aCf8f1f4324f7645256263b64f",
+			"0x4C5ee59D359FD1C84f2b76dcE042AFcF345C9E4C",
+			"0xCcA81b6E4189786595f3b663315c6dfca7c95FC7",
+			"0x1355A5E85bDE471B9Aa418F869f936446357748B",
+			"0xf1FAbEF358Cb96fd50f307861fCA5492cCE12B56",
+			"0xB83C3C271b082518A95e64805a78dE8dFE86A661",
+			"0xCec274180eD52b041e4359DfC016aAc2642E82e8",
+			"0xfD49F164018811e4596FF8575Dfa74EEd2a2cb50",
+			"0x670aC159373c205433C50b63b7C5D73374557740",
+			"0xc5D7F15324245eBbB7be0FF63ff79f3448abD101",
+			"0x306b5284311DD78e9c3E4591C04828c99F2F9690",
+			"0x6540Bd387b21E8f6A5DFF462a0c2C8D98057f034",
+			"0xf9A3aAE2930a47f8F5a51ec69E0B5b6ccE796477",
+			"0xa335c00f8729AAEB3e71e4845505C1Dc4462ee26",
+			"0xbddFEE27ca7068dFC3432dC3Bee41fE46c4F1Bef",
+			"0xAB33f47F710804158101Dc0727532be21E5Ac603",
+			"0x7729E73eb80Ac48e5880E7e4cbB75495b8190279",
+			"0x2DB482b9e0B73a7f68E9cc43bc22d9B6eAa120Bf",
+			"0x8a0b80392BEe0b895cE25981Afd5caE34E241824",
+			"0xc94de6C3e9b2057acDC1d79e9f261a1F8a321F5D",
+			"0xAC11E1B764a0E3B266c1e4b3A0BC7D0C5AF44bE6",
+			"0x7e9BA7DFcc0270aAA4c7aa903BfF300c46226f43",
+			"0x4f8Ba59a5e17cFea20F0Ede44dFebe1Be2936bF0",
+			"0x72324FA4B1c0e8996054a067Dc295a9bF75dc78f",
+			"0xe977Cb6C10210A08a1ff0b91168B54bdc5776cCB",
+			"0xF36995139Ecd8d7C6265670e5A1201B8E3B62E22",
+			"0x2969a7a1C1DA5a77C05e21869fD90A2a52d4153b",
+			"0x04076eF269eC3F01cB8b11171e6279b3c4B4E689",
+			"0xf19a873C13E219006D03507dfA02973538764553",
+			"0x1fDD56AdfBeC55C1321eAFa918A9423a2c46E679",
+			"0x3c6681330E4450c35F4a7e96931d37086716E523",
+			"0x33141e87ad2DFae5FBd12Ed6e61Fa2374aAeD029",
+			"0x0Ca699158FB8302c9939f26a033ebB0808f741fd",
+			"0xbF4c0d66Db59Ae8276e0cB7E1Ed36fC4Ac8C1d68",
+			"0x901c0967DF19fA0Af98Fd958E70F30301d7580dD",
+			"0x53299BF3476B1a0ea9B11598eE37076a116Ff19E",
+			"0x77138e515c91c7264880bAfd2B8a610d16962eC8",
+			"0x7B4c09696B0ba6e1DB03763Ac0d3b1127C6763D5",
+			"0x4bAa3F9f24B97aa67B0A0f080bA3A9c994c6AE93",
+			"0xFA7FC3eEc36c4F305B08BE06C466e2cbBBE51602",
+			"0x6C1369f4b62fbc6C8E1D612d9d4F56aBCC587986",
+			"0x39255DA12f96Bb587c7ea7F22Eead8087b0a59ae",
+			"0x1956005bA9690B7A00b09c78769675C0cE1457AF",
+			"0x59aDF757FC36dc5bb676B609C619daEB51D39b0c",
+			"0x3cBbe49C119e71A3693E324Dd77100DD8CeA415e",
+			"0x892Bf9Ce84E2B13732581eFFe8Bf0fbf0f7AFEA8",
+			"0x9688BbFEd5D3a6106C52596c77Fd3d41deaD3f64",
+			"0xf520407E9BcdD806B2415af433DF52f7Fc2Dd991",
+			"0x1D033A37577a40518be21d57F7B5331b2aD5B515",
+			"0x879EAD67C92ec2bFa70fa9d157F500B7b31b64AB",
+			"0x08db5361d0051A77F4FF7c570cfF1c474060FCfC",
+			"0x1cE0BAB195aedbC2ad32FCFE1301d7a6E92FB3dB",
+			"0x1CCe2BcA5712160D68888f61aFC3114AAc4dca12",
+			"0x8d5d7839AbB34B4ef3ad6bD981a37488b6BBA439",
+			"0x2ed640D902616f21e3A2C38c2648323Ac488C814",
+			"0x69f53DeB161040F0Bac64220db931dD01aE330Bd",
+			"0x0Bea275bBA1b2d344F5A594207964Bceaa0E3350",
+			"0x770A0d6150cd5A6E4C9F3Ba27432bB3B4FD08832",
+			"0x3aE40a98632578140f350219b28004c03118061c",
+			"0x44fd2AFEf1B57fCC1A8Dd409A8009124a889385A",
+			"0x4cd0244DA2f51fDCB70028a8551C71bA28Ff0413",
+			"0x7211A977306bd046234F5A9b795003edBFd452E5",
+			"0x82F4345E728beC537C08d3b82A825DC228F1b172",
+			"0x03D5933A28D8b97B08525045F5602F2CE27aC784",
+			"0xE74CfFDB580d4584cE79A94f9e586274eab59364",
+			"0xe4D96Cf7f47656200FBC3b6110DC8E785216EF0E",
+			"0xCCcc90D2d2f3d3a0B90Da3Bbed6Ac8D0DfbbB069",
+			"0x5cC379bB406429728Fab995B9C6Ce731Afa976b8",
+			"0x097cC8c9ba4935Ca13976C664c0497567611F4a6",
+			"0x2e07C266f652095E2ce988C759AFE9Cc2Fd9Af8c",
+			"0xdf83b40f905e9BF467F224c910D06dAda96E07bc",
+			"0x2eBa135774F406BfdF6F28e874A125D77B3E29d8",
+			"0xcDAeC65495Fa5c0545c5a405224214e3594f30d8",
+			"0x811089Bf8a34F963672811852516199188a42740",
+			"0x62ea7425Eb52725c8a5fb876F09bA600C3665953",
+			"0x999d08e4A2825d6A6b9454Bb62f228956C7ed6cc",
+			"0xd35927BC6b13678EE33048eF92C45b9CFC43F5dc",
+			"0x6aB5b50C674Ad724c3C3B6a8d852c5C07AA14362",
+			"0x48186E0a949EBA50E7cb9FED8F50AD02c240d628",
+			"0x7Da7927cD7AD4DeF90f084496CC69723345D7a42",
+			"0x6Fc86482379FFfb9Fd829f4fBe33BBd47bA07A87",
+			"0xDB9F1e7738c2f2aE89C520f76F2937EE52bC8209",
+			"0xDd32a4Ee93a83Ff50D219BAe069234eeE3B95Bc8",
+			"0x98410a884F20392778DC3eAF99581B6F923D1933",
+			"0xBcfA32CF40d0139084e42daAf00bF914e221fD7e",
+			"0x5b599155aDE1F59f549a4a1297ddb5A4951b1B27",
+			"0x5EF29c49e30A4de6bc01393521F7Cc4d517E731D",
+			"0x7ce836317204567e180e34e141802301C4208103",
+			"0x0267Db959951f145850910CcD394170A34525b1E",
+			"0x92B26Ef5998C73236d8157A437CA09ABE6df77f4",
+			"0xC0b4cCa71A0Ba3E96631390Ebb9A370BD95CbE24",
+			"0x931a2CD8003B6AAc060A02df31f451BA64e850B7",
+			"0xfaA9119B13D7567abe98Cd1A4A6f591B94fbEE1D",
+			"0xc5511F7C38a5DDCa62c11c4F9183556791548860",
+			"0xC494Afbf282f3E56D66a5fAfAa2a28A4a1Ee3118",
+			"0xeB3b47E033A37FA5E44B8e75C4843a40e7B4B20D",
+			"0xD56930D79f1F805C135fa1a4C309a600131dE69F",
+			"0xb8717aBa8D1BB5d779b7D7707b70a64B189DE080",
+			"0x860bAf682f9512FF11bd87ebbc33Af5CD9cf4A8C",
+			"0x20a304a7d126758dfe6B243D0fc515F83bCA8431",
+			"0x2Ec705D306b51e486B1bC0D6ebEE708E0661ADd1",
+			"0x9769aC7Da62f2419FC99BfE7F1427BC501864871",
+			"0x2249019cd814ed66C1034a8E6Bce44cD1D464AcE",
+			"0x45a6B8de03494d6d673050b81B021ECFE422963d",
+			"0x3781A018b0831eB4ad3d819703981DEAE3cfFe03",
+			"0xb3a5830a7a70669Cf4cfBaAB52515737670aD984",
+			"0x45738C687B1704bef8Ddb67D32Fc63a55638e5B9",
+			"0xEda3C1e85c7bf8ec9094b6291b90Bc355B0047Df",
+			"0x4Fb52fa0Fb86AcC043967A9A3aa002F304a2cD8e",
+			"0x635FbFB990895583Dc098ac6b0c6b830F0F7B6F6",
+			"0x4a994da131CcE3256E48616720ea79aE94391b44",
+			"0x39037C80c38Cd7bD93EBf1Acd34C73f553002bd3",
+			"0x1A5B58a60CB63693fbbA1542AAe71112b4eC16a1",
+			"0x058143926705D95350C3B2eb368D77DE08d74755",
+			"0xa0a78208A5893C3fe325ECfDf16959d0B2737446",
+			"0x1033E2dE84F1342D56576F01FA0971fc403FdEC4",
+			"0x3AbB7B9ebA58770F84861adD4dF5aFd9C796b9dB",
+			"0xa5A13A64e529F533d344E65d39bE0AF81ae4aBbE",
+			"0x9D15d0d737e06a875F3d46621fB52fe79ffA6136",
+			"0x071574D20Ab4764A3a2C3BacfFdBa16218c8b320",
+			"0x1cEa6e22e6D0d1442876c9D0C467cb67D776C266",
+			"0x96357C5e8F1fA8D7D120a0EAf437E4Dc76A304ef",
+			"0xe87A5318b4117046C50213370591E63F7eCC14d4",
+			"0x8Cd204dE298916EE6278d82014D696Cf2f9Ec228",
+			"0x9aE8082DC884BE36d2e92976aCD348Ce647DE99A",
+			"0x47fFdcb95ae8f0540a22Ea708F43cB18d083F370",
+			"0x5350Cc4C63c6f2eB903544132dfCF90D9eb97aBC",
+			"0x3ce280971a934384111d9369af8c0b6F9f036201",
+			"0x04673f393bA0e77491CB33a3ec6faE84836F2380",
+			"0x45873876897F8E79AD925D27B62A18BAe36F8591",
+			"0x77a3A4b832763A34a9757c1A1279BE633222ce6b",
+			"0x108498523DAEB3480274d82E9fb0DCBb13865C31",
+			"0x6331958fE15E2Ef35A333bdAEa3Ac91932B7DBfd",
+			"0xd4ae6eCA985340Dd434D38F470aCCce4DC78D109",
+			"0xAa5f24519dfA6A428faa7c12770f635b97591B31",
+			"0xa6340fE7D9fF3E55D614c72356689Cd3A054Bb48",
+			"0xb6c9c58873a8bb1D99B2f045Bae305B2C0Ea68F9",
+			"0x26A492441427BF8Cd7Ead64813EBaF9D13427CE7",
+			"0x50989E02b78c6CcED31b273B8334610B9F63a295",
+			"0x9b227720516458CaeE798797Fcd1Ed986c4AB703",
+			"0x31e63bcD9829EbDB8dB68dcf2F218Ecc01676267",
+			"0x17e69B8bbBB6CFF94F18325dBD26D6c97826FE2b",
+			"0x1f3F1ca0732463C0C103D4eD2b924eea658C7dd9",
+			"0xA74eec617a92B98775efbC1e95b7a765b69E4f3D",
+			"0xb4f5A2a98223A8f5818315336Fac9E2dED50c491",
+			"0xE91F720CA3eB35e99fA5dbB77bA46C44F6ea26D8",
+			"0x255EcA0C8e6457c1A94fB7608e8f764CE9842097",
+			"0x220785dB476964b43F710681996F6D49EBF666A8",
+			"0xD486D0846812266D21e1Ab6c57FCF202DF836dc8",
+			"0x655dB694371C980Eb8eb9FDB54aa0747a85C1dc9",
+			"0x2f54966712d6D5a643E5B91c647eCB2a867132F6",
+			"0x87cE2b6B0745161126B6cFf136D7ab66268DC994",
+			"0xCD2F7C5a98C04090e285d1874Eb802Ce103Cb35a",
+			"0x41ccEe5908ADC14A448A9f99B91fcdFc41490340",
+			"0x8508d61841C15d8d9563a4b884F4D2AD6D9F6674",
+			"0x3Cb18fF114F948f92d65858418d909425AfF16Fb",
+			"0x34a4b9060438c4465c8C2A25b6620C5c614635BA",
+			"0x89Fa1974120d2a7F83a0cb80df3654721c6a38Cd",
+			"0x11a2e73BADA26f184e3d508186085C72217Dc014",
+			"0x47c8C4efae4696AAc1C09DB8912032e0bAffdA3b",
+			"0xB6E87C0cD75DEFc3Eda9D001a4951c6aBaF51B95",
+			"0x72132F1344D9D09C2a6BEfFb9Cfcf5f7379cAe4F",
+			"0xc80213fDe91568788608616795250a17Be125EBf",
+			"0x57670B267b59139106fA795ffeB185B20FF96684",
+			"0x72376eE645bC50E4B23Fd57864Da483f8C0112Ce",
+			"0x210f11B28abD69249141770E7145a7E8Ff7526b2",
+			"0xebbEe840F9b034993C72ca37c3158e7F0ae852BA",
+			"0x7b8A01B39D58278b5DE7e48c8449c9f4F5170613",
+			"0xB971eF87ede563556b2ED4b1C0b0019111Dd85d2",
+			"0x32681814957e0C13117ddc0c2aba232b5c9e760f",
+			"0x253A911e560c0BBcA9c6eBb416d88Aa9db7E03d1",
+			"0x24330e967d1CFd8Ddf608806C063ec0eBc2f304B",
+			"0x87F540906417c087596Cce5A201b2320bAA00f5a",
+			"0xED4BfEaa8540836779cA330D3994929B05dce337",
+			"0x44865f3cdAc61f421eaEC98a460ba39a809172D9",
+			"0x11ba4F4A419Fc73bCF59Bf2092Aa5983b4D50cEa",
+			"0x47715f01238f52fAD6FD6cA5822db4d68F1F35C0",
+			"0xb5A75b1DDb571a26B7794D78868CDdC23A1CD541",
+			"0x18034aA1FaC89B72d4B314073CdF44b3cF9d52bc",
+			"0x01DA755E5C278a2FD1dcE3723752f256Bcc7A87E",
+			"0x8c9e8325ADE9243a86316d3562fd39D536168Df2",
+			"0xb9c1ec45f7269abd252f2263bd91f81f3Aa601CF",
+			"0xfD5A4151Af87284da6072f62E33dAA205554C08d",
+			"0xd34e851011d1BCf8745Dd227781b5be95974f158",
+			"0x710856Bf65790f7807682899f94fE1fb1adFe2Ee",
+			"0x4EEdcdD79C257C0924D90Ae6c53F21573218156b",
+			"0x89fc437d346219c527a45e0025Cc15F5C1Bc5720",
+			"0x85da8a87C9e7593f3b4EbbEF0C9571Eaf0df0514",
+			"0x21a46Fa39729f3016055E1a01cABe4439Cb55cd7",
+			"0x88370fD148f052bB54bCdFE76AA37A5172f4Ddb4",
+			"0x36f2c06A5C5564911F6AF7053cda03d60Aeeb5Ab",
+			"0x0508dBAA0cb57C1e50C1AA79Cc48f30743dED5aD",
+			"0xE04c84057D3521eb274B14C926a3216A050906a7",
+			"0xE8C1C61A23Dc7b1C0BA96ee8C5de112fAc1024DB",
+			"0x254fDc4E98E347eAF3Ca978994F7AD9145fa00Fa",
+			"0x0E155a98eaa4931421b6769C2B6f51EA24Bef9D8",
+			"0xA0d09B7F6866507408d28d32E1485C28dB6654DF",
+			"0x7Dd51e0CFd5946F93659fDBCEB60c94E534AFCB6",
+			"0x0e632Bf81ef328bC89Ea02944c10f72722c2Add3",
+			"0xA83155595546A5DA24A260CC56bBC2b82758CC71",
+			"0x1d17b0fB5989318c9e7F522A2162C5B99289852A",
+			"0x13f4EA83D0bd40E75C8222255bc855a974568Dd4",
+			"0x844BC1496c2E12f24AD11c61F75d6f38b71Da51e",
+			"0x99457C3e6112B802C790F62dc21963CE9e2a174e",
+			"0xCCd8281Bd7b39bbA61497212d56950451EBD87Fd",
+			"0xea1CF3995A5977375f9Df5E18Ba42dA9A9B2A34b",
+			"0x934AD72eEd420bfC7CCE614f7E9b8Ea8Ff7F694A",
+			"0x894dEB5840E146A1c823F7e0381F1B783feaD342",
+			"0x13147820401f455e3318db8686958D457ad2E7b0",
+			"0x28AE1E9501273ad2454cf1d25c7Bc15F16914674",
+			"0x244B3a18C6C2bc4DDC430A024F3F0DEbCDfed214",
+			"0x238479d9E64c1a040Ad91a78E832737fC59D8B29",
+			"0x645516a9519CD5DA39e7d309d1bae9a4f2b40844",
+			"0x8E197cD2E299F6EA02300dCf381DD04bF08D9749",
+			"0xcADC24Dd1447163bcff71AB815413205300C94dE",
+			"0x566FCeEB419C8a42feb990c62038A9A124A7343f",
+			"0x3c8A66cA8141E2B47BEFF09Eca068bd1049F4e70",
+			"0x99273A590E9D11076AD6cAfD2B2f8706B6B11837",
+			"0xA4DB99e60400aDC69d1c1681AE5d8844C9Fce775",
+			"0x92EbeD756678634AD372F6FE7e1aA271C8cDCd5e",
+			"0x12cf3cAA170c05148e24C790E23B204693254d74",
+			"0xab6D9d991E0420B8A8b79f328c5Dfc8C8B502278",
+			"0x2A52419c6fbD249F7Ad0Ce887E1a793765d177A0",
+			"0xe30D25D8ef5178B7fCc836De2E811e4fCDF01718",
+			"0xb3d815bBA0E6EF099acfc51062C666FeAaB35AEd",
+			"0xDf0Af06E4d532c3297cBCe05445fDe16b45bEB17",
+			"0x46797F24f555F83D03de895feade40c18D28c1Fe",
+			"0xF5C19cE97D3C2eb913AcfcC82C0B30D72Ae49FfC",
+			"0x9a04b598CA32D3e9CDF366f34B2B4d41EF5f027F",
+			"0x8C6B251DfDd2fd5a83780Bf3Ef090812073D6816",
+			"0xD87B2556B1c4ce71BA50c35cB25CcC865eCFd558",
+			"0x622E94DCc718e0809bAC23f2984011a6219d673A",
+			"0x8C06ac03783647C10ebc42D9Ff2D8d9f4bB314Bb",
+			"0x56F7a104d59Aeae585757cA91F809FaAB0E26B1D",
+			"0x41467F4D8C54196228E889843e625e23025F700d",
+			"0x3B980A96258A37acEBd3569B02D75081a7fE9195",
+			"0x6B56784Ba87EEB6D9b0B6289780D3A164e6757A3",
+			"0x016073980B9C355Ab36de19E4b4030A1c9409603",
+			"0x5AB68C322b49899292c4E38A17cA85497719Aac2",
+			"0x8f7A246CDc1E47ACDE76613E8bBD372e569bE98E",
+			"0xB3f86EBcFb1444976a644e73d772d12bf08CAda9",
+			"0x38e707b495526b9ADEb52E6DA7487569863FEDEC",
+			"0x091F32841819F3f580c24A8B818bf75D3a8Eba78",
+			"0x7F884B22A41a6a3c03983DB948e7460c1F7BC9D4",
+			"0xD0fbd3AF3B1630E231843e4Fd12010D92f8AC129",
+			"0x59E1d068eD03dd79328B24D0B13C2BBF1ee81d06",
+			"0x21231db2e791f0b4A5CedAFAE6d631911F12845d",
+			"0xc0Fa0d61C28ab5AD4AA75A4618D0e74120e72dA6",
+			"0xAf252b3A8F8C091a8bAabCb7A345cB3F065FD90c",
+			"0xC6Cc0a53845F60d0310f02D17D4BEDC36D8997Ba",
+			"0x72D0c8cE95aF737d0BDe307af7dF9EDF13Ad7Fcf",
+			"0xD34060c29338cA5323BEa9A493051Ed2C16dB995",
+			"0x026F924F3E2E13fFbF985A5F23B0E9D1e06aFDaa",
+			"0x340B0084202d87Adf6c514542d973a1a86B49D7E",
+			"0xF8273AfC87F6517f3DF84416e5c8cbde4aF22A2A",
+			"0xc3FfcB3D5745BbA5ada6928D0F5238830A358943",
+			"0xB6996E9e7e5665BbFbBb71e941597B6791048071",
+			"0xDbC781b6dA48e14ac6b34AFf1E16da2aC55B1358",
+			"0x821090d965011F5BcdEC64d133dBE9F0c2E2C5D0",
+			"0xf5577856a153D28f50C100833E778E208f4B84Fd",
+			"0xF28D0Db79124a8B1A47d8F404806cD2f75CCf035",
+			"0x4E9661153139e7D07C62f6C7459e99cb9240a888",
+			"0x142B88EbBF4031797933De24CB4456F6676c89fb",
+			"0x87D14cb4d647EC28380F0374074FEa433044BC02",
+			"0x9997E9b0aF4Edd7c375666aEB3bA81932D8E753E",
+			"0xeA3D5A6c7e2dF244a3226BceCa818e6831Ca37b6",
+			"0xBDDFA43dbBfb5120738C922fa0212ef1E4a0850B",
+			"0xebC25C81050b22d765b39b8D17a3457404cfc4cD",
+			"0x45936F6739F5ECC46E9bc63ab3dd553067fa0Af4",
+			"0x94f16e0Dd043db30FC813Efb9194ecFfd79AF7e1",
+			"0xe99aBbaaC8923d4E0dE5DC0D565a921A9aCe3581",
+			"0x4C661a0E9819A2830Fcea1E1D66C862bb5be34C4",
+			"0xB5C6bC0728284A32aEeD11d9f7BFACC2bc4B45F5",
+			"0x7D5d9A29298B1A069a5D9cb9A2eb9E065d524eB5",
+			"0xe2a3216373A7700C49b02916B60bA0718ffb4F0e",
+			"0xdEf7086Ff406050eE90c0b1c89616f8e5724588e",
+			"0x968a8bEF199369fbF2Dc1acCd808a867C86676BD",
+			"0x9821bAF31d0F621e275A1533a2ab48CdD7e1Fcf9",
+			"0x3abae5a94773bBF7E922DfF1400254fe005992Cb",
+			"0x99e6a1557Fa597DCe21bfAA5104B11b44642cDB2",
+			"0x6F7C563e0B171DA6E23Bd30e33E7218fD8863AE3",
+			"0x70F2157C581DB58C9427850FD1c9B95E4D725b24",
+			"0x1492f6Ff2CF746559B9E65B27e116a83Bd57C178",
+			"0xcF2E3a026De834c352cF35e721b0bab3d7568ADB",
+			"0xa3591c10c71c196c9f10c9928659775eFf4FC26D",
+			"0x76ab14116029F2A8EE85Aa233f215eee0E4170E3",
+			"0xea835cf2219A0f6C229c215dcBbe75560CcF9Ccb",
+			"0x7bB1eC65eaA3225647B5eb79662eB676BA475524",
+			"0xd9ec5C26750F6caDE5D44DBCB471C55F042643Fa",
+			"0x6B20D6e6cf606C7D6769608d3adb7692d56381CD",
+			"0x84885bEF8bF3e8128BD114754e34de113eDe00ca",
+			"0xE572bBf479F22f81be2F0E34d78f1C6e509580fc",
+			"0x70a6a4dEE1a692E7E2cef649DA3192Ff83CA9781",
+			"0x6cF9E1407c0C9802336Cc308A0aa6cc2c1AE1372",
+			"0xA2c30A81bbdC9363F0f10d3A74ce14Cd8422799E",
+			"0x972fa83d0E52Dc4ee1E4bd2e7589BE36b7fd9346",
+			"0x7cacA044DD034456dCD05b389aC78E4c13269d0f",
+			"0xFa5FCb21bd9db3A5d3691628c10cAcbE513196Ed",
+			"0xacAf38Fc52E202f434A0B13c4A5A388AcF2378bB",
+			"0x885B50DaaCdf75bFD125BC7eeb1e5E7a37294d81",
+			"0xF062f63b1076a448B0cb333Cd264bF4F82473c1C",
+			"0x71530D5CFef739D57F84Ea0233eF5c16F9Ad2D0B",
+			"0x467444a9c010996E50e5BD7b81186ddD78333490",
+			"0x8Aa4cAFA526D4d07B121E0b02485952146C0361c",
+			"0x069D8adF4eFA9fC0060FC3eDda58c3E2D70051d0",
+			"0x94b9b3A2F02f42C4f443df7f097BFE550720a367",
+			"0x5c2519588dF5d732eFa5B8c30948f7029f312F51",
+			"0xd6006Fa2b80365f518851f14E84D05E8Bfe0eD4F",
+			"0xff543aC06E6a914D8f08A0aAF3Fa82572F830e6b",
+			"0x64fd2CAcf2889da0776161f2b68dD1DA348a8516",
+			"0xba1917Dc146168334955c7dAaB73cf079f1C4F8b",
+			"0xa07fAc2C60712B52e91ffad308d6d329D412AC51",
+			"0x504fBAE04BEE6f8cAD077d42fE4Be791E1c6b2B0",
+			"0x9f8560CA3F68e5f5B43F35eE59Abc3ABCd411285",
+			"0xE23AEd682E1736f28832908fED56B3f6ee268884",
+			"0x47b43DBb5456800d5Fc852e6F2A2de127f7C6265",
+			"0x89b0A11cF540f9cEa55165748097D8104c88e4ae",
+			"0x1b32C77A9C121bd49a05DE32C445DD14CAf8a395",
+			"0x9b4c8ec146dD00617CFB85Cd97b479dc315c6a5C",
+			"0xe67D259c6362BeEF20375e33D5456ADB3016cd15",
+			"0x5410cb8151e65e1e8CAB964632d2B0d9A9d51c9d",
+			"0x654F131605Fb2e8DB48bAD8a4077973DfFDDA01C",
+			"0x445F728b05464147Bf886a57F5796FB7aE6d6cAD",
+			"0xbaD461a281b54c927999936cDc19A3f977337332",
+			"0xfAAa91E0E743139cB440E4282897C057D67Ef78A",
+			"0x5ba4A2f5F50A596bba9191e22026Dd48fd4a400A",
+			"0x1b81D678ffb9C0263b24A97847620C99d213eB14",
+			"0x7DE8063E9fB43321d2100e8Ddae5167F56A50060",
+			"0x36c96246946Df91F0BAb0891A7Ae4964736a8213",
+			"0x267F9D6fA6b889d53914705585b7219dB0eBA98e",
+			"0x09315EdF46c84367D8A6EF5e16248139346c9faC",
+			"0x6B3aFB7c10e4757117ae424B5a302Fe33FE794b2",
+			"0x0861055eeDC5C13105de9E75066f53b1908584F0",
+			"0x640A43Fca925247c870DaeB03E4AF9027dd9A2b7",
+			"0xB09c41FBdc03ED0d5901961f93afF6EC56e15D80",
+			"0x04fC1C3FE7C6147765956Eb3321c261d32F4c407",
+			"0x2A16095350CcB7865fbB2d0373fCdcFF3190b676",
+			"0xA993c696436c0665d77fc9E58f5957A9A312413a",
+			"0x53a7b84f073eC1b8E3571BF7966ee2d2F5b59F0F",
+			"0x6da35Ed1F5789E08d17d3BBF0422604B6dFBd97B",
+			"0xc43378a3379AeaDc11F070a668c7626B91909d4F",
+			"0xA9E386aA85a109CEB76A864C71101985DBa8F1EC",
+			"0x6c7E3CB60771D55b3e7cC9f687c8529bB0DBD938",
+			"0x3bDeFc5B9CD74629F3B6E899D3c31a52D323CAFa",
+			"0xA022AC516D453Aa8ea91a1891cb10783e1552468",
+			"0x5be88e17c8d2E9391b81E796Ce938AbD350c3C37",
+			"0xe980DCCB993C869005DC7d74E0cc26D307dBFC85",
+			"0x8143941ea738987c7461592f519E235aD77c6d2B",
+			"0xd29929471230Ba38936D25E1786A96fca95A1A33",
+			"0x08eF16A1C5B80fE2B90bae8eE8Ec63cb6D237d73",
+			"0xe08c4778Dd3cD3cbafe56D9CDdFA020514491Ae2",
+			"0xDEbD515AaFb60386aB5FEf71Dd5E9a0Cf3eCe45e",
+			"0xE4B3e36A5bE5D183A82512706a3df5E092FeAcE6",
+			"0x0e8757DF6cA40F737C93924743c9D4c50497BB29",
+			"0xC21B96Cc592e31c682A945B6beE7ACDf95F8dCad",
+			"0xeAc690cdD391665987FC8AF5d962DCD16C5B66E7",
+			"0xCc7f81170a9604636d96F53df46a6024c9DF6674",
+			"0xDCa329928f3EF256Ea5822BD4FCc008963Ab520a",
+			"0xB548C027387E174939391Ee812F0585bBfe48158",
+			"0x0ddb9D635cB92720896dE709d18069450942dbBA",
+			"0xfb75Ff2B0A4A0F55AADb62F7be43Bc9722d2d837",
+			"0x8eA6450c0616dEF16cfBeB0854FDaa8b64622F06",
+			"0x106e9389E0A205a3d3342618ac3ECFB9752C7Ee2",
+			"0xE6A6431eBC3310AF53e79FBf95B601Ef211fB5d7",
+			"0xA8B5D59E36E26769925B22B73Cb1E2c568e2F570",
+			"0xb86FE5042Ad550A84056Dc0B10F0F1977D87c2F4",
+			"0x13f4EA83D0bd40E75C8222255bc855a974568Dd4",
+		},
+	}
 }
@@ -4,6 +4,10 @@ package config
 
 var WETH_ADDRESS = "0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2"
 
+// 原生币(eth/bnb)转账时使用的token地址, 沿用业内通用的 0xEeee... 写法
+var NATIVE_TOKEN_ADDRESS = "0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE"
+var NATIVE_TOKEN_SYMBOL = "ETH"
+
 // 固定的quote币种列表
 // wbtc去掉, 因为区块中只有eth价格
 var QuoteEthCoinList = []string{
@@ -93,3 +97,40 @@ var FamousRouters = []string{
 	"0xf6A86EAA9Ea0F38F66B3718114AE79acaf35e02F",
 	"0xfE277C6B684e5ed527Ae36c4fcfc7014302fe45e",
 }
+
+// eth 主网默认配置, 直接使用上面的地址集合
+func ethChainConfig() *ChainConfig {
+	return &ChainConfig{
+		Name:         "eth",
+		ChainId:      1,
+		WsAddr:       WS_ADDR,
+		ArchiveAddr:  ARCHIVE_ADDR,
+		DatabaseName: DatabaseName,
+		BlockTime:    12,
+
+		NativeTokenSymbol: NATIVE_TOKEN_SYMBOL,
+		WethAddress:       WETH_ADDRESS,
+
+		// uniswap v3 usdc/weth 0.05%
+		NativePricePool: NativePricePool{
+			Address:        "0x88e6A0c2dDD26FEEb64F039a2c41296FcB3f5640",
+			Type:           "v3",
+			NativeIsToken0: false,
+			Decimal0:       6,
+			Decimal1:       18,
+		},
+
+		// uniswap v3 usdc/weth 0.05%, 0.3%, usdt/weth 0.05%, v2 usdc/weth
+		NativePriceRefPools: []string{
+			"0x88e6A0c2dDD26FEEb64F039a2c41296FcB3f5640",
+			"0x8ad599c3A0ff1De082011EFDDc58f1908eb6e6D8",
+			"0x11b815efB8f581194ae79006d24E0d814B7697F6",
+			"0xB4e16d0168e52d35CaCD2c6185b44281Ec28C9Dc",
+		},
+
+		QuoteEthCoinList:   QuoteEthCoinList,
+		QuoteUsdCoinList:   QuoteUsdCoinList,
+		BlackHoleAddresses: BlackHoleAddresses,
+		FamousRouters:      FamousRouters,
+	}
+}
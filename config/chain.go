@@ -0,0 +1,267 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+)
+
+// 用于获取原生币(eth/bnb等)usd价格的池子
+type NativePricePool struct {
+	Address        string `json:"address"`
+	Type           string `json:"type"`           // v2 或 v3
+	NativeIsToken0 bool   `json:"nativeIsToken0"` // 原生币是否为 token0, 另一边为稳定币
+	Decimal0       uint8  `json:"decimal0"`
+	Decimal1       uint8  `json:"decimal1"`
+}
+
+// 单个rpc节点, 支持 http 和 ws, 只有 ws 节点可以订阅区块头
+type RPCEndpoint struct {
+	URL       string `json:"url"`
+	Archive   bool   `json:"archive"`   // 是否支持历史状态查询
+	RateLimit int    `json:"rateLimit"` // 每秒请求数上限, 0为不限制
+}
+
+// 单条链的全部配置, 每条链使用独立的数据库
+type ChainConfig struct {
+	Name    string `json:"name"` // 同时也是 api 中的 /:chain
+	ChainId int64  `json:"chainId"`
+
+	WsAddr      string `json:"wsAddr"`
+	ArchiveAddr string `json:"archiveAddr"` // 支持历史高度查询的节点
+
+	// 节点池, 配置后忽略 wsAddr 和 archiveAddr
+	Endpoints []RPCEndpoint `json:"endpoints"`
+
+	DatabaseName string `json:"databaseName"`
+	BlockTime    int    `json:"blockTime"` // 出块时间, 单位s
+
+	NativeTokenSymbol string          `json:"nativeTokenSymbol"`
+	WethAddress       string          `json:"wethAddress"`     // wrapped 原生币
+	NativePricePool   NativePricePool `json:"nativePricePool"` // 价格序列有缺口时, 从该池子链上读取
+
+	// 原生币与稳定币的参考池子, 由其中的swap生成原生币价格序列, 多个池子取中位数
+	// 为空时只使用 nativePricePool
+	NativePriceRefPools []string `json:"nativePriceRefPools"`
+
+	QuoteEthCoinList   []string `json:"quoteEthCoinList"`
+	QuoteUsdCoinList   []string `json:"quoteUsdCoinList"`
+	BlackHoleAddresses []string `json:"blackHoleAddresses"`
+	FamousRouters      []string `json:"famousRouters"`
+}
+
+// 未指定 CHAIN_CONFIG_FILE 时, 当前目录存在该文件则自动加载
+const defaultChainConfigFile = "chains.json"
+
+// 本进程正在处理的链
+var CurrentChain *ChainConfig
+
+// 已知的所有链, key 为 Name, 注册后不再修改
+var chainConfigs = make(map[string]*ChainConfig)
+
+// env 中的节点和数据库配置, 只用于 CHAIN 选择的链
+// 通过 -chain 指定的链(如多链的子进程)只使用链配置
+var envChain struct {
+	wsAddr       string
+	archiveAddr  string
+	databaseName string
+}
+
+func init() {
+	// 内置配置, 配置文件中的同名链会覆盖
+	RegisterChainConfig(ethChainConfig())
+	RegisterChainConfig(bscChainConfig())
+	RegisterChainConfig(avaxChainConfig())
+
+	CurrentChain = chainConfigs["eth"]
+}
+
+// avax 目前只用于 api 查询, 未配置原生币价格池
+func avaxChainConfig() *ChainConfig {
+	return &ChainConfig{
+		Name:         "avax",
+		ChainId:      43114,
+		DatabaseName: "avax",
+		BlockTime:    2,
+
+		NativeTokenSymbol: "AVAX",
+		WethAddress:       "0xB31f66AA3C1e785363F0875A1B74E27b85FD66c7",
+
+		QuoteEthCoinList: []string{
+			"0xB31f66AA3C1e785363F0875A1B74E27b85FD66c7",
+		},
+		QuoteUsdCoinList: []string{
+			"0xB97EF9Ef8734C71904D8002F8b6Bc66Dd9c48a6E", // usdc
+			"0x9702230A8Ea53601f5cD2dc00fDBc13d4dF4A8c7", // usdt
+		},
+		BlackHoleAddresses: []string{
+			"0x0000000000000000000000000000000000000000",
+			"0x000000000000000000000000000000000000dEaD",
+		},
+	}
+}
+
+// 没有配置节点池时, 使用 wsAddr 和 archiveAddr 组成节点池
+func (c *ChainConfig) RPCEndpoints() []RPCEndpoint {
+	if len(c.Endpoints) > 0 {
+		return c.Endpoints
+	}
+
+	var endpoints []RPCEndpoint
+	if c.WsAddr != "" {
+		endpoints = append(endpoints, RPCEndpoint{URL: c.WsAddr})
+	}
+	if c.ArchiveAddr != "" {
+		endpoints = append(endpoints, RPCEndpoint{URL: c.ArchiveAddr, Archive: true})
+	}
+
+	return endpoints
+}
+
+func (c *ChainConfig) PriceRefPools() []string {
+	if len(c.NativePriceRefPools) > 0 {
+		return c.NativePriceRefPools
+	}
+
+	if c.NativePricePool.Address != "" {
+		return []string{c.NativePricePool.Address}
+	}
+
+	return nil
+}
+
+func RegisterChainConfig(cfg *ChainConfig) {
+	chainConfigs[cfg.Name] = cfg
+}
+
+func GetChainConfig(name string) (*ChainConfig, bool) {
+	cfg, ok := chainConfigs[name]
+	return cfg, ok
+}
+
+func GetChainNames() []string {
+	names := make([]string, 0, len(chainConfigs))
+	for name := range chainConfigs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// 从json文件加载链配置, 文件内容为 ChainConfig 数组
+func LoadChainConfigs(file string) error {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+
+	var cfgs []*ChainConfig
+	if err := json.Unmarshal(data, &cfgs); err != nil {
+		return fmt.Errorf("parse %v error: %v", file, err)
+	}
+
+	for _, cfg := range cfgs {
+		if cfg.Name == "" || cfg.DatabaseName == "" {
+			return fmt.Errorf("chain name and databaseName are required in %v", file)
+		}
+
+		// 这两项会覆盖全局变量, 缺少时会沿用 eth 的配置
+		if cfg.NativeTokenSymbol == "" || cfg.WethAddress == "" {
+			return fmt.Errorf("chain %v: nativeTokenSymbol and wethAddress are required in %v", cfg.Name, file)
+		}
+
+		if cfg.WethAddress != "" && len(cfg.QuoteEthCoinList) == 0 {
+			cfg.QuoteEthCoinList = []string{cfg.WethAddress}
+		}
+
+		RegisterChainConfig(cfg)
+	}
+
+	return nil
+}
+
+// 把链配置同步到全局变量, 一个进程只处理一条链
+// 多链时由 sfilter 为每条链启动一个子进程
+// 全部字段都覆盖, 不能残留默认链(eth)的配置
+// CurrentChain 为副本, 之后修改(如 -db)不影响注册的配置
+func ApplyChainConfig(cfg *ChainConfig) {
+	c := *cfg
+	cfg = &c
+
+	CurrentChain = cfg
+
+	BlockChain = cfg.Name
+	DatabaseName = cfg.DatabaseName
+
+	WS_ADDR = cfg.WsAddr
+	ARCHIVE_ADDR = cfg.ArchiveAddr
+
+	NATIVE_TOKEN_SYMBOL = cfg.NativeTokenSymbol
+	WETH_ADDRESS = cfg.WethAddress
+
+	QuoteEthCoinList = cfg.QuoteEthCoinList
+	QuoteUsdCoinList = cfg.QuoteUsdCoinList
+	BlackHoleAddresses = cfg.BlackHoleAddresses
+	FamousRouters = cfg.FamousRouters
+
+	log.Printf("[ ApplyChainConfig ] Using chain: %v, db: %v", cfg.Name, cfg.DatabaseName)
+}
+
+// 加载链配置文件并选择当前链, 由 CHAIN 指定, 默认eth
+func initChainConfig() {
+	file := os.Getenv("CHAIN_CONFIG_FILE")
+	if file == "" {
+		if _, err := os.Stat(defaultChainConfigFile); err == nil {
+			file = defaultChainConfigFile
+		}
+	}
+
+	if file != "" {
+		if err := LoadChainConfigs(file); err != nil {
+			log.Fatalf("[ initChainConfig ] LoadChainConfigs failed: %v", err)
+		}
+		log.Printf("[ init ] Loaded chain config file: %v, chains: %v", file, GetChainNames())
+	}
+
+	name := os.Getenv("CHAIN")
+	if name == "" {
+		name = BlockChain
+	}
+
+	cfg, ok := GetChainConfig(name)
+	if !ok {
+		log.Fatalf("[ initChainConfig ] unknown chain: %v, valid chains: %v", name, GetChainNames())
+	}
+
+	ApplyChainConfig(cfg)
+	applyEnvChainConfig()
+}
+
+// env 配置覆盖当前链的同名项
+func applyEnvChainConfig() {
+	if envChain.archiveAddr != "" {
+		log.Printf("[ init ] Using archive addr: %v", envChain.archiveAddr)
+		ARCHIVE_ADDR = envChain.archiveAddr
+		CurrentChain.ArchiveAddr = envChain.archiveAddr
+	}
+
+	if envChain.wsAddr != "" {
+		log.Printf("[ init ] Using ws addr: %v", envChain.wsAddr)
+		WS_ADDR = envChain.wsAddr
+		CurrentChain.WsAddr = envChain.wsAddr
+	}
+
+	if envChain.databaseName != "" {
+		log.Printf("[ init ] Using db: %v", envChain.databaseName)
+		DatabaseName = envChain.databaseName
+		CurrentChain.DatabaseName = envChain.databaseName
+	}
+}
+
+// -chain 指定链时不使用 env 中的节点和数据库, 提示一下
+func HasEnvChainConfig() bool {
+	return envChain.wsAddr != "" || envChain.archiveAddr != "" || envChain.databaseName != ""
+}
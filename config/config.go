@@ -19,19 +19,41 @@ var (
 
 	API_AES_DATA_KEY = "deepeye@leafan16"
 
-	// 用于获取历史高度上的eth价格.. 如果是回溯的时候，10个区块才调用一次即可(特殊处理)
-	INFURA_API_KEY = "https://mainnet.infura.io/v3/06a6594cfd1a404591470c2f81a7ac93"
+	// 支持历史高度查询的节点, 可选; 原生币价格优先使用价格序列, 只有缺口时才查询历史高度
+	ARCHIVE_ADDR = ""
 
 	WS_ADDR = "ws://127.0.0.1:8546"
 
-	RetriveOldBlockNum         = BlocksPerDay * 3
-	GetPriceIntervalForRetrive = 20 // 每隔多少个区块获取一次eth价格
+	RetriveOldBlockNum = BlocksPerDay * 3
+
+	// 确认深度, 距离链头超过该深度的区块视为最终确认, 不再参与回滚
+	ConfirmationBlockNum = 12
+
+	// 获取 receipt 的参数: 不支持 eth_getBlockReceipts 时, 每批 batch 请求的数量
+	ReceiptBatchSize   = 100
+	ReceiptRetryTimes  = 3
+	ReceiptCallTimeout = 10 // 单位s, 单次rpc调用超时时间
+
+	// rpc 节点池: 健康检查间隔(s), 落后最高块超过该值视为不健康, 连续失败多少次视为不健康
+	RPCHealthCheckInterval = 10
+	RPCMaxHeadLag          = 5
+	RPCMaxFailures         = 3
+
+	// 普通节点只保留最近的状态, 查询更早高度时需要 archive 节点
+	RPCArchiveDepth = 128
+
+	// 事件总线: mongo 为跨进程(sfilter → wiser/api), local 仅进程内
+	EventBusTransport = "mongo"
+
+	// 是否通过 debug_traceBlockByHash 获取合约内部的原生币转账, 节点不支持时自动跳过
+	TraceInternalTransfers = true
 
 	SwapSaveTime          = int32(SecondsForOneMonth * 3)
 	TransferTableSavetime = int32(SecondsForOneMonth * 3)
 
 	Kline1MinTableSaveTime  = int32(SecondsForOneWeek * 3)
 	Kline1HourTableSaveTime = int32(SecondsForOneMonth * 6)
+	Kline1DayTableSaveTime  = int32(SecondsForOneYear * 3)
 
 	TokenTableSavetime = int32(SecondsForOneYear * 3)
 
@@ -61,50 +83,64 @@ func init() {
 func initEnvConfig() {
 	if err := godotenv.Load(".env"); err != nil {
 		log.Printf("no env file, pass..")
+		initChainConfig()
 		return
 	}
 
-	chain := os.Getenv("CHAIN")
-	if chain == "bsc" {
-		// 解析bsc版本
-		initBscChain()
-	}
-
 	mongoAddr := os.Getenv("MONGO_ADDR")
 	if mongoAddr != "" {
 		log.Printf("[ init ] Using mongo addr: %v", mongoAddr)
 		MONGO_ADDR = mongoAddr
 	}
 
-	infura_apikey := os.Getenv("INFURA_API_KEY")
-	if infura_apikey != "" {
-		log.Printf("[ init ] Using infura_apikey: %v", infura_apikey)
-		INFURA_API_KEY = infura_apikey
-	}
+	// 节点和数据库只覆盖 CHAIN 选择的链, 见 initChainConfig
+	envChain.archiveAddr = os.Getenv("ARCHIVE_ADDR")
+	envChain.wsAddr = os.Getenv("WS_ADDR")
+	envChain.databaseName = os.Getenv("DB_NAME")
 
-	ws_addr := os.Getenv("WS_ADDR")
-	if ws_addr != "" {
-		log.Printf("[ init ] Using ws addr: %v", ws_addr)
-		WS_ADDR = ws_addr
-	}
-
-	dbName := os.Getenv("DB_NAME")
-	if dbName != "" {
-		log.Printf("[ init ] Using db: %v", dbName)
-		DatabaseName = dbName
-	}
+	initChainConfig()
 
 	retriveNum := os.Getenv("RETRIVE_OLD_BLOCK_NUM")
 	if retriveNum != "" {
 		tmpNum, err := strconv.Atoi(retriveNum)
 		if err != nil {
-			log.Fatal("Wrong param of RETRIVE_OLD_BLOCK_NUM: %v", retriveNum)
+			log.Fatalf("Wrong param of RETRIVE_OLD_BLOCK_NUM: %v", retriveNum)
 		}
 
 		log.Printf("[ init ] Using RetriveOldBlockNum: %v", tmpNum)
 		RetriveOldBlockNum = tmpNum
 	}
 
+	confirmNum := os.Getenv("CONFIRMATION_BLOCK_NUM")
+	if confirmNum != "" {
+		tmpNum, err := strconv.Atoi(confirmNum)
+		if err != nil || tmpNum < 0 {
+			log.Fatalf("Wrong param of CONFIRMATION_BLOCK_NUM: %v", confirmNum)
+		}
+
+		log.Printf("[ init ] Using ConfirmationBlockNum: %v", tmpNum)
+		ConfirmationBlockNum = tmpNum
+	}
+
+	ReceiptBatchSize = getEnvInt("RECEIPT_BATCH_SIZE", ReceiptBatchSize)
+	ReceiptRetryTimes = getEnvInt("RECEIPT_RETRY_TIMES", ReceiptRetryTimes)
+	ReceiptCallTimeout = getEnvInt("RECEIPT_CALL_TIMEOUT", ReceiptCallTimeout)
+
+	RPCHealthCheckInterval = getEnvInt("RPC_HEALTH_CHECK_INTERVAL", RPCHealthCheckInterval)
+	RPCMaxHeadLag = getEnvInt("RPC_MAX_HEAD_LAG", RPCMaxHeadLag)
+	RPCMaxFailures = getEnvInt("RPC_MAX_FAILURES", RPCMaxFailures)
+
+	eventBus := os.Getenv("EVENT_BUS_TRANSPORT")
+	if eventBus != "" {
+		log.Printf("[ init ] Using EventBusTransport: %v", eventBus)
+		EventBusTransport = eventBus
+	}
+
+	if os.Getenv("TRACE_INTERNAL_TRANSFERS") == "false" {
+		log.Printf("[ init ] Internal transfers trace disabled")
+		TraceInternalTransfers = false
+	}
+
 	listenAddr := os.Getenv("API_LISTEN_PORT")
 	if listenAddr != "" {
 		log.Printf("[ init ] Using ApiListenAddrPort: %v", listenAddr)
@@ -117,3 +153,19 @@ func initEnvConfig() {
 	}
 
 }
+
+// 读取正整数类型的env, 没有配置则返回默认值
+func getEnvInt(key string, defaultValue int) int {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	tmpNum, err := strconv.Atoi(value)
+	if err != nil || tmpNum <= 0 {
+		log.Fatalf("Wrong param of %v: %v", key, value)
+	}
+
+	log.Printf("[ init ] Using %v: %v", key, tmpNum)
+	return tmpNum
+}
@@ -18,6 +18,8 @@ const SleepIntervalforRetrive = 100 // 单位ms, 每隔多久取一次区块
 const ProxyFromIp = "192.168.2.101"
 
 const BlockProceededTableName = "block"
+const BackfillTableName = "backfill"
+const NativePriceTableName = "nativeprice"
 const SwapTableName = "swap"
 const PairTableName = "pair"
 const TokenTableName = "token"
@@ -31,6 +33,7 @@ const LiquidityEventSaveTime = int32(SecondsForOneYear)
 
 const Kline1MinTableName = "kline1min"
 const Kline1HourTableName = "kline1hour"
+const Kline1DayTableName = "kline1day"
 
 const GlobalTrendTableName = "trend"
 const GlobalTrendTableSaveTime = SecondsForOneWeek
@@ -44,6 +47,47 @@ const CheckTrackAddressInterval = 60 * time.Minute // 定时检查用户记录
 const MaxConcurrentRoutineNums = 10   // 最大并行的协程数, 避免节点扛不住
 const GlobalUpdateIntervalBlocks = 10 // 每隔多少个区块update一次全局24h趋势数据
 
+const BackfillReportInterval = 10 * time.Second // 回填时打印进度并保存checkpoint的间隔
+const BackfillQueryBatchSize = 1000             // 回填时每次从db查询已处理区块的数量
+const BackfillRetryTimes = 3                    // 回填时单个区块的重试次数
+
+const KlineEngineIdleTime = 30 * time.Minute     // k线引擎中超过该时间没有交易的行移出内存
+const KlineEngineEvictInterval = 1 * time.Minute // k线引擎检查并移出过期行的间隔
+const KlineMaxBars = 2000                        // k线接口一次最多返回的柱子数
+
+// 各链地址相同
+const Multicall3Address = "0xcA11bde05977b3631167028862bE2a173976CA11"
+const ERC1820RegistryAddress = "0x1820a4B7618BdE71Dce8cdc73aAB6C95905faD24"
+
+const NativePriceMaxGap = 100 // 价格序列中早于该区块数的价格不再使用, 回退到链上读取
+
+const PricingMinLiquidityUsd = 10000.0 // 参与定价的 pair 最低 usd 流动性
+const PricingMaxAge = 24 * time.Hour   // pair 超过该时间没有成交, 不参与定价
+const PricingMaxHops = 3               // 定价路径最多经过的 pair 数
+
+const BacktestInitialCapital = 10000.0   // 回测初始资金(usd)
+const BacktestPositionSize = 1000.0      // 回测每次买入的金额(usd)
+const BacktestFeeRate = 0.003            // pair 没有记录手续费时的默认费率
+const BacktestEquityInterval = time.Hour // 回测资金曲线的记录间隔
+
+const StrategyJobBufferSize = 4 // 每个策略排队等待执行的任务数, 超过后跳过
+
+const PaperInitialCapital = 10000.0        // 模拟盘初始资金(usd)
+const PaperPositionSize = 1000.0           // 模拟盘每次买入的金额(usd)
+const PaperGasUsd = 5.0                    // 模拟盘每次成交的 gas 成本(usd)
+const PaperMarkInterval = 10 * time.Minute // 模拟盘按k线估值并记录资金曲线的间隔
+
+const PipelineMaxPending = 4                  // 流水线中每个 worker 最多领先写入的区块数
+const PipelineMaxWait = 2 * time.Minute       // 等待缺失区块超过该时间时告警, 不会跳过
+const PipelineRetryInterval = 5 * time.Second // 区块处理失败后重试的间隔
+
+const StreamSendBufferSize = 256            // 每个订阅者的发送缓冲, 满了视为慢消费者直接断开
+const StreamPingInterval = 30 * time.Second // websocket 心跳间隔
+
+const EventBusBufferSize = 1024               // 进程内事件总线的缓冲大小
+const EventBusRetryInterval = 3 * time.Second // 事件接收出错后的重试间隔
+const EventTableSize = 256 * 1024 * 1024      // 事件表为 capped collection, 超过大小自动删除最老的事件
+
 const MONGO_LIMIT_UPPER = 50          // 普通用户一页的limit大小
 const MONGO_APIKEY_LIMIT_UPPER = 1000 // apikey 用户一页的limit大小
 const MONGO_LIMIT_DOWN = 5
@@ -53,15 +97,6 @@ const MONGO_FIND_TIMEOUT = 5
 
 var BaseFactor1e18 = big.NewInt(1000000000000000000)
 
-// 合法的chain, 只支持这些链
-var ValidChains = []string{
-	"eth",
-	"avax",
-	// "optimism",
-	// "arbi",
-	// "bsc",
-}
-
 // facet 定义
 const FacetTableName = "facet"
 const FacetSaveTime = int32(SecondsForOneMonth)
@@ -79,6 +114,26 @@ const BiTradeTableName = "trades"
 
 const HotPairRankTableName = "hrank"
 
+// wiser 增量统计的进度与持仓
+const WiserCursorTableName = "wcursor"
+const DealPositionTableName = "dposition"
+
+// 地址之间转移的 lots, 转入方统计完即可删除, 保留一个月
+const MovedLotsTableName = "wmoved"
+const MovedLotsSaveTime = int32(SecondsForOneMonth)
+
+const WiserWorkerNum = 8 // 同时统计的地址数
+
+const WiserMovedLotsWaitRounds = 3 // 转入方最多等待转出方几轮, 避免同一区块互相转账时一直等待
+
+// 策略模拟盘
+const PaperAccountTableName = "paccount"
+const PaperPositionTableName = "pposition"
+const PaperEquityTableName = "pequity"
+
+// 跨进程的事件总线
+const EventTableName = "event"
+
 // chain 定义
 const Quoter_Contract_Address = "0xb27308f9F90D607463bb33eA1BeBb41C27CE5AB6"
 
@@ -2,7 +2,7 @@ package config
 
 type WiserConfig struct {
 	DebugMode           bool
-	WiserSearchInterval int // 每隔多久执行一遍地址更新
+	WiserSearchInterval int // 每隔多久执行一遍地址更新, 只统计新的交易
 
 	DbBlockReadSize int64 // 每次从db读取的最大数量, 避免卡死db
 	MongoTimeout    int   // mongo 延迟时间
@@ -32,6 +32,9 @@ type WiserConfig struct {
 	DealDefiniteWin  float64 // 盈利多少倍, 无论其是否有交易, 都结算
 	DealDefiniteLoss float64 // 亏损多少倍, 无论其是否有交易, 都结算
 
+	DealCostMethod string  // 卖出时的成本计算方式, fifo 或 avg
+	DealDustRatio  float64 // 剩余数量低于买入总量的该比例时, 视为已全部卖出
+
 	// for debug or sth..
 	DebugAccount              string // 调试账号
 	ForceUpdatePairHackStatus bool   // 是否强制更新pair的是否通缩币状态等
@@ -45,10 +48,44 @@ type WiserConfig struct {
 	MaxPriceIncreament   float64 // 价格增长最大幅度
 
 	HotPairHookUrl string
+
+	// 各个热点币策略的配置, key 为策略名
+	Strategies map[string]*StrategyConfig
+
+	// 规则策略目录, 目录下的 <name>.yaml 即名为 name 的策略
+	RuleDir string
+}
+
+type StrategyConfig struct {
+	HookUrl string // 信号推送地址, 为空时使用 HotPairHookUrl
+	Params  string // json 格式, 覆盖策略的默认参数
+
+	Capital      float64 // 模拟盘初始资金(usd), 为0时使用 PaperInitialCapital
+	PositionSize float64 // 模拟盘每次买入的金额(usd), 为0时使用 PaperPositionSize
+}
+
+// 策略配置, 没有配置的项使用默认值
+func (c *WiserConfig) Strategy(name string) *StrategyConfig {
+	conf := StrategyConfig{}
+	if found, ok := c.Strategies[name]; ok {
+		conf = *found
+	}
+
+	if conf.HookUrl == "" {
+		conf.HookUrl = c.HotPairHookUrl
+	}
+	if conf.Capital <= 0 {
+		conf.Capital = PaperInitialCapital
+	}
+	if conf.PositionSize <= 0 {
+		conf.PositionSize = PaperPositionSize
+	}
+
+	return &conf
 }
 
 var DefaultWiserConfig = &WiserConfig{
-	WiserSearchInterval: 60 * 60 * 24,
+	WiserSearchInterval: 60 * 60,
 	DbBlockReadSize:     5000,
 	MongoTimeout:        60 * 5, // 5min
 
@@ -76,6 +113,9 @@ var DefaultWiserConfig = &WiserConfig{
 	DealDefiniteWin:  5,   // 盈利超过x倍, 直接结算
 	DealDefiniteLoss: 0.2, // 亏损超过x%, 直接结算
 
+	DealCostMethod: "fifo",
+	DealDustRatio:  0.001,
+
 	ForceUpdatePairHackStatus: false,
 
 	HotPairCheckInterval: 60 * 60 * 24,
@@ -87,4 +127,12 @@ var DefaultWiserConfig = &WiserConfig{
 
 	HotPairHookUrl: "https://qyapi.weixin.qq.com/cgi-bin/webhook/send?key=08871391-4500-4d47-8a0a-480652b2161a", // 热点币策略  robot
 	// HotPairHookUrl: "https://qyapi.weixin.qq.com/cgi-bin/webhook/send?key=ef2ee7d0-e90a-4559-b915-3fb9f73233ff",
+
+	Strategies: map[string]*StrategyConfig{
+		"hb": {},
+		"hs": {},
+		"hn": {},
+	},
+
+	RuleDir: "rules",
 }
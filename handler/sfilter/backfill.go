@@ -0,0 +1,204 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"sfilter/config"
+	"sfilter/schema"
+	"sfilter/services/backfill"
+	service_block "sfilter/services/block"
+	"sfilter/utils"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// 回填 [from, to] 区间内的历史区块, 进度保存在db中, 重启相同参数即可继续
+// to <= 0 表示回填到链头(减去确认深度), 链头在第一次运行时确定并保存在checkpoint中
+func (h *Handler) Backfill(from, to int64, workers int) {
+	if to < 0 {
+		to = 0
+	}
+
+	if from <= 0 || (to > 0 && from > to) {
+		utils.Fatalf("[ Backfill ] wrong block range, from: %v, to: %v", from, to)
+	}
+
+	if workers <= 0 {
+		workers = config.MaxConcurrentRoutineNums
+	}
+
+	// 以命令行参数作为任务标识, 省略 to 时重启也是同一个任务
+	taskId := fmt.Sprintf("%v_%v", from, to)
+	cp, err := backfill.GetCheckpoint(taskId, h.DB)
+	if err == mongo.ErrNoDocuments {
+		cp, err = h.newBackfillCheckpoint(taskId, from, to)
+	}
+	if err != nil {
+		utils.Fatalf("[ Backfill ] get checkpoint of task %v err: %v", taskId, err)
+	}
+	to = cp.ToBlock
+
+	if cp.Finished {
+		utils.Infof("[ Backfill ] task %v already finished, pass..", taskId)
+		return
+	}
+
+	// 上次失败的区块都在 NextBlock 之后, 本次重新处理
+	cp.FailedBlocks = nil
+
+	utils.Infof("[ Backfill ] start task: %v, next block: %v, workers: %v", taskId, cp.NextBlock, workers)
+
+	results := make(chan backfillResult, workers*2)
+
+	go h.dispatchBackfillBlocks(cp.NextBlock, to, workers, results)
+
+	// 统计与checkpoint在当前协程处理, 无需加锁
+	done := make(map[int64]bool)
+	firstFailed := int64(-1)
+	start := time.Now()
+	startBlock := cp.NextBlock
+
+	ticker := time.NewTicker(config.BackfillReportInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case res, ok := <-results:
+			if !ok {
+				cp.Finished = cp.NextBlock > to
+				backfill.SaveCheckpoint(cp, h.DB)
+
+				utils.Infof("[ Backfill ] task %v finished, blocks: %v, failed: %v, time elapsed: %v",
+					taskId, cp.NextBlock-startBlock, len(cp.FailedBlocks), time.Since(start))
+				if len(cp.FailedBlocks) > 0 {
+					utils.Warnf("[ Backfill ] task %v has failed blocks from %v, run it again to retry", taskId, cp.NextBlock)
+				}
+				return
+			}
+
+			// 失败的区块不算完成, checkpoint 停在第一个失败的区块, 重启后从这里重新处理
+			// 之后已写入的区块重启时由 GetProceededBlockNos 跳过
+			if res.err != nil {
+				cp.FailedBlocks = append(cp.FailedBlocks, res.blockNo)
+				if firstFailed < 0 || res.blockNo < firstFailed {
+					firstFailed = res.blockNo
+				}
+				continue
+			}
+			if firstFailed >= 0 && res.blockNo > firstFailed {
+				continue // checkpoint 已经无法越过失败的区块
+			}
+
+			// 只有连续完成的区块才推进checkpoint, 保证重启后不遗漏
+			done[res.blockNo] = true
+			for done[cp.NextBlock] {
+				delete(done, cp.NextBlock)
+				cp.NextBlock++
+				cp.Proceeded++
+			}
+
+		case <-ticker.C:
+			backfill.SaveCheckpoint(cp, h.DB)
+			reportBackfillProgress(cp, startBlock, start)
+		}
+	}
+}
+
+func (h *Handler) newBackfillCheckpoint(taskId string, from, to int64) (*schema.BackfillCheckpoint, error) {
+	if to <= 0 {
+		head, err := h.Client.HeaderByNumber(context.Background(), nil)
+		if err != nil {
+			return nil, err
+		}
+		to = head.Number.Int64() - int64(config.ConfirmationBlockNum)
+	}
+
+	if from > to {
+		return nil, fmt.Errorf("wrong block range, from: %v, to: %v", from, to)
+	}
+
+	return &schema.BackfillCheckpoint{
+		TaskId:    taskId,
+		FromBlock: from,
+		ToBlock:   to,
+		NextBlock: from,
+	}, nil
+}
+
+type backfillResult struct {
+	blockNo int64
+	err     error
+}
+
+// 分批查询已处理的区块, 已处理的直接跳过, 其余交给流水线处理
+// 区块按顺序写入, 写入完成后把结果交给 Backfill 推进checkpoint
+func (h *Handler) dispatchBackfillBlocks(from, to int64, workers int, results chan backfillResult) {
+	p := newPipeline(h, from, workers, h.fetchBackfillBlock, func(blockNo int64, err error) {
+		results <- backfillResult{blockNo, err}
+	})
+
+	for batchStart := from; batchStart <= to; batchStart += config.BackfillQueryBatchSize {
+		batchEnd := batchStart + config.BackfillQueryBatchSize - 1
+		if batchEnd > to {
+			batchEnd = to
+		}
+
+		proceeded, err := service_block.GetProceededBlockNos(batchStart, batchEnd, h.DB)
+		if err != nil {
+			utils.Warnf("[ dispatchBackfillBlocks ] GetProceededBlockNos err: %v", err)
+			proceeded = make(map[int64]bool)
+		}
+
+		for i := batchStart; i <= batchEnd; i++ {
+			if proceeded[i] {
+				p.Skip(i)
+				continue
+			}
+
+			p.Submit(i, 0)
+		}
+	}
+
+	p.Close()
+	close(results)
+}
+
+// 每个区块的价格由 getBlock 从价格序列中读取, 失败时重试
+func (h *Handler) fetchBackfillBlock(blockNo int64, _ float64) (*schema.Block, error) {
+	var err error
+
+	for i := 0; i < config.BackfillRetryTimes; i++ {
+		var block *schema.Block
+		block, err = h.getBlock(big.NewInt(blockNo), 0)
+		if err == nil || err == errBlockProceeded {
+			return block, err
+		}
+	}
+
+	utils.Errorf("[ fetchBackfillBlock ] block: %v failed after %v retries, err: %v", blockNo, config.BackfillRetryTimes, err)
+	return nil, err
+}
+
+func reportBackfillProgress(cp *schema.BackfillCheckpoint, startBlock int64, start time.Time) {
+	elapsed := time.Since(start)
+	proceeded := cp.NextBlock - startBlock
+
+	var rate float64
+	if elapsed.Seconds() > 0 {
+		rate = float64(proceeded) / elapsed.Seconds()
+	}
+
+	remaining := cp.ToBlock - cp.NextBlock + 1
+	eta := "unknown"
+	if rate > 0 {
+		eta = (time.Duration(float64(remaining)/rate) * time.Second).String()
+	}
+
+	total := cp.ToBlock - cp.FromBlock + 1
+	utils.Infof("[ Backfill ] progress: %v/%v (%.2f%%), next block: %v, speed: %.2f blocks/s, eta: %v, failed: %v",
+		cp.NextBlock-cp.FromBlock, total, float64(cp.NextBlock-cp.FromBlock)*100/float64(total),
+		cp.NextBlock, rate, eta, len(cp.FailedBlocks))
+}
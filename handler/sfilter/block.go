@@ -3,25 +3,39 @@ package handler
 import (
 	"context"
 	"errors"
+	"fmt"
 	"math/big"
 
-	"sfilter/config"
 	"sfilter/schema"
 	service_block "sfilter/services/block"
-	"sfilter/services/chain"
+	"sfilter/services/nativeprice"
 	"sfilter/utils"
 
 	"time"
 
-	"github.com/cloudfresco/ethblocks"
-	"github.com/ethereum/go-ethereum/ethclient"
-	"go.mongodb.org/mongo-driver/mongo"
+	"github.com/ethereum/go-ethereum/core/types"
 )
 
-func getBlock(blockNumber *big.Int, client *ethclient.Client, mongodb *mongo.Client, ethPrice float64) (*schema.Block, error) {
+var errBlockProceeded = errors.New("proceeded")
+
+// 优先使用价格序列中该区块之前最近的价格, 序列有缺口时才去链上读取该高度的价格
+// 较老的高度由节点池路由到 archive 节点
+func (h *Handler) getNativePrice(blockNumber *big.Int) (float64, error) {
+	price, err := nativeprice.GetPrice(blockNumber.Int64(), h.DB)
+	if err == nil && price > 0 {
+		return price, nil
+	}
+
+	utils.Debugf("[ getNativePrice ] no native price in series for block: %v, read from chain", blockNumber)
+	return h.Service.GetBasicCoinPrice(blockNumber)
+}
+
+func (h *Handler) getBlock(blockNumber *big.Int, ethPrice float64) (*schema.Block, error) {
+	client, mongodb := h.Client, h.DB
+
 	if service_block.IsBlockProceeded(blockNumber.Int64(), mongodb) {
 		utils.Warnf("[ getBlock ] Block is proceeded number: %v", blockNumber)
-		return nil, errors.New("proceeded")
+		return nil, errBlockProceeded
 	}
 
 	start := time.Now()
@@ -31,20 +45,14 @@ func getBlock(blockNumber *big.Int, client *ethclient.Client, mongodb *mongo.Cli
 	oneBlk := new(schema.Block)
 
 	if ethPrice == 0 {
-		if config.DevelopmentMode {
-			ethPrice, err = chain.GetBasicCoinPrice(client, blockNumber, config.BlockChain)
-		} else {
-			// 直接通过本地链上读取, 不要走 infura
-			ethPrice, err = chain.GetBasicCoinPrice(client, nil, config.BlockChain)
-		}
-
+		ethPrice, err = h.getNativePrice(blockNumber)
 		if err != nil {
 			return nil, err
 		}
 	}
 	oneBlk.EthPrice = ethPrice
 
-	block, err := ethblocks.GetBlockByNumber(ctx, client, blockNumber)
+	block, err := client.BlockByNumber(ctx, blockNumber)
 	if err != nil {
 		utils.Errorf("[ getBlock ] GetBlockByNumber(%v) error: %v", blockNumber, err)
 		return nil, err
@@ -53,18 +61,26 @@ func getBlock(blockNumber *big.Int, client *ethclient.Client, mongodb *mongo.Cli
 	oneBlk.Block = block
 	utils.Debugf("Get block: %d now, tx num: %d, hash: %v, get txs time consumed: %v\n", blockNumber, len(block.Transactions()), block.Hash(), time.Since(start))
 
+	// 没有内容也没有转账金额的交易, 就pass掉
+	// 单纯的原生币转账需要保留, 用于记录 transfer
+	var txs []*types.Transaction
 	for _, tx := range block.Transactions() {
-		// 如果是单纯的transfer没有内容, 就pass掉
-		if len(tx.Data()) <= 0 {
-			// 单纯的transfer, continue掉
-			continue
+		if len(tx.Data()) > 0 || tx.Value().Sign() > 0 {
+			txs = append(txs, tx)
 		}
-		// utils.Debugf("[ getBlock ] tx: %v", tx.Hash())
+	}
 
-		receipt, err := ethblocks.GetTransactionReceipt(ctx, client, tx.Hash())
-		if err != nil {
-			utils.Errorf("[ getBlock ] GetTransactionReceipt: %v err: %v", tx.Hash(), err)
-			continue
+	receipts, err := getReceipts(client, block, txs)
+	if err != nil {
+		utils.Errorf("[ getBlock ] getReceipts(%v) error: %v", blockNumber, err)
+		return nil, err
+	}
+
+	for _, tx := range txs {
+		receipt, ok := receipts[tx.Hash()]
+		if !ok {
+			utils.Errorf("[ getBlock ] block: %v, no receipt for tx: %v", blockNumber, tx.Hash())
+			return nil, fmt.Errorf("no receipt for tx: %v", tx.Hash())
 		}
 
 		oneTx := new(schema.Transaction)
@@ -74,6 +90,12 @@ func getBlock(blockNumber *big.Int, client *ethclient.Client, mongodb *mongo.Cli
 		oneBlk.Transactions = append(oneBlk.Transactions, oneTx)
 	}
 
+	// 获取内部调用, 用于解析合约内部的原生币转账
+	if err := traceBlock(client, oneBlk); err != nil {
+		utils.Errorf("[ getBlock ] traceBlock(%v) error: %v", blockNumber, err)
+		return nil, err
+	}
+
 	oneBlk.BlockNo = block.NumberU64()
 	oneBlk.BlockTime = time.Unix(int64(block.Time()), 0)
 
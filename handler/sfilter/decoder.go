@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"sfilter/schema"
+	"sfilter/services/chain"
+	"sfilter/utils"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// dex 事件解析器, 以 topic0 注册
+// 新增一个dex只需要实现下面的接口, 并在 init 中调用 RegisterDecoder 即可
+type Decoder interface {
+	Name() string
+	Topics() []common.Hash // 需要处理的 topic0, 一个解析器可以处理多个事件签名
+}
+
+// 解析swap事件, 返回nil表示不是合法的swap
+type SwapDecoder interface {
+	Decoder
+	DecodeSwap(block *schema.Block, tx *schema.Transaction, l *types.Log, mongodb *mongo.Client, svc *chain.Service) *schema.Swap
+}
+
+// 解析添加/移除流动性事件
+type LiquidityDecoder interface {
+	Decoder
+	DecodeLiquidity(tx *schema.Transaction, l *types.Log, mongodb *mongo.Client, svc *chain.Service) *schema.LiquidityEvent
+}
+
+// 解析pair创建事件
+type PairDecoder interface {
+	Decoder
+	DecodePair(l *types.Log) *schema.Pair
+}
+
+// 只在 init 阶段写入, 之后只读, 无需加锁
+var decoders = make(map[common.Hash]Decoder)
+
+func RegisterDecoder(d Decoder) {
+	for _, topic := range d.Topics() {
+		if exist, ok := decoders[topic]; ok {
+			utils.Fatalf("[ RegisterDecoder ] topic: %v registered by both %v and %v", topic, exist.Name(), d.Name())
+		}
+
+		decoders[topic] = d
+	}
+}
+
+func getSwapDecoder(l *types.Log) (SwapDecoder, bool) {
+	if len(l.Topics) == 0 {
+		return nil, false
+	}
+
+	d, ok := decoders[l.Topics[0]].(SwapDecoder)
+	return d, ok
+}
+
+func getLiquidityDecoder(l *types.Log) (LiquidityDecoder, bool) {
+	if len(l.Topics) == 0 {
+		return nil, false
+	}
+
+	d, ok := decoders[l.Topics[0]].(LiquidityDecoder)
+	return d, ok
+}
+
+func getPairDecoder(l *types.Log) (PairDecoder, bool) {
+	if len(l.Topics) == 0 {
+		return nil, false
+	}
+
+	d, ok := decoders[l.Topics[0]].(PairDecoder)
+	return d, ok
+}
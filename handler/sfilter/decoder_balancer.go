@@ -0,0 +1,135 @@
+package handler
+
+import (
+	"math/big"
+	"sfilter/schema"
+	"sfilter/services/chain"
+	"sfilter/utils"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// balancer v2 所有池子的资金都在 vault 中, 事件也都由 vault 发出
+// poolId 的前20字节为池子地址
+// refer: https://etherscan.io/address/0xba12222222228d8ba445958a75a0704d566bf2c8#events
+
+var (
+	balancerVaultSwapTopic          = eventTopic("Swap(bytes32,address,address,uint256,uint256)")
+	balancerPoolBalanceChangedTopic = eventTopic("PoolBalanceChanged(bytes32,address,address[],int256[],uint256[])")
+)
+
+func init() {
+	RegisterDecoder(&balancerSwapDecoder{})
+	RegisterDecoder(&balancerLiquidityDecoder{})
+}
+
+func getBalancerPair(poolId common.Hash, tokenA, tokenB string, mongodb *mongo.Client, svc *chain.Service) *schema.Pair {
+	pool := common.BytesToAddress(poolId[:20]).String()
+
+	_pair := newVirtualPair(schema.SWAP_EVENT_BALANCERV2_LIKE, pool, poolId.Hex(), tokenA, tokenB)
+	ensurePair(_pair, mongodb, svc)
+
+	return _pair
+}
+
+type balancerSwapDecoder struct{}
+
+func (d *balancerSwapDecoder) Name() string {
+	return "balancer-v2"
+}
+
+func (d *balancerSwapDecoder) Topics() []common.Hash {
+	return []common.Hash{balancerVaultSwapTopic}
+}
+
+func (d *balancerSwapDecoder) DecodeSwap(block *schema.Block, tx *schema.Transaction, l *types.Log, mongodb *mongo.Client, svc *chain.Service) *schema.Swap {
+	if len(l.Topics) != 4 || len(l.Data) < 64 {
+		return nil
+	}
+
+	poolId := l.Topics[1]
+	pool := common.BytesToAddress(poolId[:20]).String()
+	tokenIn := common.HexToAddress(l.Topics[2].Hex()).String()
+	tokenOut := common.HexToAddress(l.Topics[3].Hex()).String()
+
+	// 与池子自身的 bpt 兑换, 本质是加减流动性, 不算swap
+	if tokenIn == pool || tokenOut == pool {
+		return nil
+	}
+
+	amountIn := new(big.Int).SetBytes(l.Data[0:32])
+	amountOut := new(big.Int).SetBytes(l.Data[32:64])
+
+	_pair := getBalancerPair(poolId, tokenIn, tokenOut, mongodb, svc)
+
+	swap := newSwapStruct(block, l, tx, _pair.Address, svc)
+	if swap == nil {
+		return nil
+	}
+	swap.SwapType = schema.SWAP_EVENT_BALANCERV2_LIKE
+
+	amount0, amount1 := poolDeltaBySide(_pair.Token0, tokenIn, amountIn, amountOut)
+	updateSwapByPoolDelta(swap, amount0, amount1, svc)
+
+	return swap
+}
+
+// 只处理2币池, deltas 为正表示加入池子
+type balancerLiquidityDecoder struct{}
+
+func (d *balancerLiquidityDecoder) Name() string {
+	return "balancer-v2-liquidity"
+}
+
+func (d *balancerLiquidityDecoder) Topics() []common.Hash {
+	return []common.Hash{balancerPoolBalanceChangedTopic}
+}
+
+func (d *balancerLiquidityDecoder) DecodeLiquidity(tx *schema.Transaction, l *types.Log, mongodb *mongo.Client, svc *chain.Service) *schema.LiquidityEvent {
+	if len(l.Topics) != 3 {
+		return nil
+	}
+
+	values, err := chain.UnpackDexEvent("PoolBalanceChanged", l.Data)
+	if err != nil || len(values) < 2 {
+		utils.Debugf("[ balancerLiquidityDecoder ] unpack err: %v, tx: %v", err, l.TxHash)
+		return nil
+	}
+
+	tokens, ok0 := values[0].([]common.Address)
+	deltas, ok1 := values[1].([]*big.Int)
+	if !ok0 || !ok1 || len(tokens) != 2 || len(deltas) != 2 {
+		return nil
+	}
+
+	// 一进一出的情况不属于加减流动性
+	if deltas[0].Sign()*deltas[1].Sign() < 0 {
+		return nil
+	}
+
+	_pair := getBalancerPair(l.Topics[1], tokens[0].String(), tokens[1].String(), mongodb, svc)
+
+	event := &schema.LiquidityEvent{
+		PoolAddress: _pair.Address,
+		Direction:   schema.DIRECTION_BUY_OR_ADD,
+		Type:        schema.SWAP_EVENT_BALANCERV2_LIKE,
+		Operator:    getTxSender(tx),
+	}
+
+	if deltas[0].Sign() < 0 || deltas[1].Sign() < 0 {
+		event.Direction = schema.DIRECTION_SELL_OR_DECREASE
+	}
+
+	amountA := new(big.Int).Abs(deltas[0]).String()
+	amountB := new(big.Int).Abs(deltas[1]).String()
+
+	if _pair.Token0 == tokens[0].String() {
+		event.Amount0, event.Amount1 = amountA, amountB
+	} else {
+		event.Amount0, event.Amount1 = amountB, amountA
+	}
+
+	return event
+}
@@ -0,0 +1,159 @@
+package handler
+
+import (
+	"fmt"
+	"math/big"
+	"sfilter/schema"
+	"sfilter/services/chain"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// curve 池子, 一个池子可能有多个币, 每个币对生成一个虚拟pair
+// refer: https://etherscan.io/address/0xbebc44782c7db0a1a60cb6fe97d0b483032ff1c7#events
+
+var (
+	curveTokenExchangeTopics = []common.Hash{
+		eventTopic("TokenExchange(address,int128,uint256,int128,uint256)"),                   // stable pool
+		eventTopic("TokenExchange(address,uint256,uint256,uint256,uint256)"),                 // crypto pool
+		eventTopic("TokenExchange(address,uint256,uint256,uint256,uint256,uint256,uint256)"), // tricrypto-ng
+	}
+
+	// 流动性只处理2币池, 多币池无法对应到单个pair
+	curveAddLiquidityTopic    = eventTopic("AddLiquidity(address,uint256[2],uint256[2],uint256,uint256)")
+	curveRemoveLiquidityTopic = eventTopic("RemoveLiquidity(address,uint256[2],uint256[2],uint256)")
+)
+
+// key: pool_index, value: coin 地址
+var curveCoins sync.Map
+
+func init() {
+	RegisterDecoder(&curveSwapDecoder{})
+	RegisterDecoder(&curveLiquidityDecoder{})
+}
+
+func getCurveCoin(pool string, index int64, svc *chain.Service) (string, error) {
+	key := fmt.Sprintf("%v_%v", pool, index)
+	if coin, ok := curveCoins.Load(key); ok {
+		return coin.(string), nil
+	}
+
+	coin, err := svc.GetCurvePoolCoin(pool, index)
+	if err != nil {
+		return "", err
+	}
+
+	curveCoins.Store(key, coin)
+	return coin, nil
+}
+
+func getCurvePair(pool string, indexA, indexB int64, mongodb *mongo.Client, svc *chain.Service) (*schema.Pair, string, error) {
+	tokenA, err := getCurveCoin(pool, indexA, svc)
+	if err != nil {
+		return nil, "", err
+	}
+
+	tokenB, err := getCurveCoin(pool, indexB, svc)
+	if err != nil {
+		return nil, "", err
+	}
+
+	_pair := newVirtualPair(schema.SWAP_EVENT_CURVE_LIKE, pool, "", tokenA, tokenB)
+	ensurePair(_pair, mongodb, svc)
+
+	return _pair, tokenA, nil
+}
+
+type curveSwapDecoder struct{}
+
+func (d *curveSwapDecoder) Name() string {
+	return "curve"
+}
+
+func (d *curveSwapDecoder) Topics() []common.Hash {
+	return curveTokenExchangeTopics
+}
+
+func (d *curveSwapDecoder) DecodeSwap(block *schema.Block, tx *schema.Transaction, l *types.Log, mongodb *mongo.Client, svc *chain.Service) *schema.Swap {
+	if len(l.Topics) != 2 || len(l.Data) < 128 {
+		return nil
+	}
+
+	soldId := new(big.Int).SetBytes(l.Data[0:32])
+	soldAmount := new(big.Int).SetBytes(l.Data[32:64])
+	boughtId := new(big.Int).SetBytes(l.Data[64:96])
+	boughtAmount := new(big.Int).SetBytes(l.Data[96:128])
+
+	if !soldId.IsInt64() || !boughtId.IsInt64() {
+		return nil
+	}
+
+	pool := l.Address.String()
+	_pair, tokenSold, err := getCurvePair(pool, soldId.Int64(), boughtId.Int64(), mongodb, svc)
+	if err != nil {
+		return nil
+	}
+
+	swap := newSwapStruct(block, l, tx, _pair.Address, svc)
+	if swap == nil {
+		return nil
+	}
+	swap.SwapType = schema.SWAP_EVENT_CURVE_LIKE
+
+	buyer := common.HexToAddress(l.Topics[1].Hex()).String()
+	swap.Sender = buyer
+	swap.Recipient = buyer
+
+	// 卖出的币转入池子, 买到的币转出池子
+	amount0, amount1 := poolDeltaBySide(_pair.Token0, tokenSold, soldAmount, boughtAmount)
+	updateSwapByPoolDelta(swap, amount0, amount1, svc)
+
+	return swap
+}
+
+type curveLiquidityDecoder struct{}
+
+func (d *curveLiquidityDecoder) Name() string {
+	return "curve-liquidity"
+}
+
+func (d *curveLiquidityDecoder) Topics() []common.Hash {
+	return []common.Hash{curveAddLiquidityTopic, curveRemoveLiquidityTopic}
+}
+
+func (d *curveLiquidityDecoder) DecodeLiquidity(tx *schema.Transaction, l *types.Log, mongodb *mongo.Client, svc *chain.Service) *schema.LiquidityEvent {
+	if len(l.Topics) != 2 || len(l.Data) < 128 {
+		return nil
+	}
+
+	_pair, coin0, err := getCurvePair(l.Address.String(), 0, 1, mongodb, svc)
+	if err != nil {
+		return nil
+	}
+
+	event := &schema.LiquidityEvent{
+		PoolAddress: _pair.Address,
+		Direction:   schema.DIRECTION_BUY_OR_ADD,
+		Type:        schema.SWAP_EVENT_CURVE_LIKE,
+		Operator:    getTxSender(tx),
+	}
+
+	if l.Topics[0] == curveRemoveLiquidityTopic {
+		event.Direction = schema.DIRECTION_SELL_OR_DECREASE
+	}
+
+	// token_amounts 按池子中 coin 的顺序排列, 需要对应到排序后的 token0/token1
+	amountA := new(big.Int).SetBytes(l.Data[0:32]).String()
+	amountB := new(big.Int).SetBytes(l.Data[32:64]).String()
+
+	if _pair.Token0 == coin0 {
+		event.Amount0, event.Amount1 = amountA, amountB
+	} else {
+		event.Amount0, event.Amount1 = amountB, amountA
+	}
+
+	return event
+}
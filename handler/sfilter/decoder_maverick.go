@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"math/big"
+	"sfilter/schema"
+	"sfilter/services/chain"
+	"sfilter/utils"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// maverick v1 每个池子独立合约, 但没有 token0()/token1(), 需要通过 tokenA()/tokenB() 获取
+// refer: https://docs.mav.xyz/technical-reference/contract-interfaces/v1-contracts/pool
+
+var maverickSwapTopic = eventTopic("Swap(address,address,bool,bool,uint256,uint256,int32)")
+
+// key: pool 地址, value: [2]string{tokenA, tokenB}
+var maverickTokens sync.Map
+
+func init() {
+	RegisterDecoder(&maverickSwapDecoder{})
+}
+
+func getMaverickTokens(pool string, svc *chain.Service) (string, string, error) {
+	if tokens, ok := maverickTokens.Load(pool); ok {
+		_tokens := tokens.([2]string)
+		return _tokens[0], _tokens[1], nil
+	}
+
+	tokenA, tokenB, err := svc.GetMaverickPoolTokens(pool)
+	if err != nil {
+		return "", "", err
+	}
+
+	maverickTokens.Store(pool, [2]string{tokenA, tokenB})
+	return tokenA, tokenB, nil
+}
+
+type maverickSwapDecoder struct{}
+
+func (d *maverickSwapDecoder) Name() string {
+	return "maverick"
+}
+
+func (d *maverickSwapDecoder) Topics() []common.Hash {
+	return []common.Hash{maverickSwapTopic}
+}
+
+func (d *maverickSwapDecoder) DecodeSwap(block *schema.Block, tx *schema.Transaction, l *types.Log, mongodb *mongo.Client, svc *chain.Service) *schema.Swap {
+	if len(l.Topics) != 1 || len(l.Data) < 7*32 {
+		return nil
+	}
+
+	pool := l.Address.String()
+	tokenA, tokenB, err := getMaverickTokens(pool, svc)
+	if err != nil {
+		utils.Debugf("[ maverickSwapDecoder ] get tokens err: %v, pool: %v", err, pool)
+		return nil
+	}
+
+	token0, token1 := sortTokens(tokenA, tokenB)
+	_pair := &schema.Pair{
+		InfoOnChain: schema.InfoOnChain{
+			Address: pool,
+			Token0:  token0,
+			Token1:  token1,
+		},
+
+		InfoOnPairCreated: schema.InfoOnPairCreated{
+			Type: schema.SWAP_EVENT_MAVERICK_LIKE,
+		},
+	}
+	ensurePair(_pair, mongodb, svc)
+
+	swap := newSwapStruct(block, l, tx, pool, svc)
+	if swap == nil {
+		return nil
+	}
+	swap.SwapType = schema.SWAP_EVENT_MAVERICK_LIKE
+
+	// data: sender, recipient, tokenAIn, exactOutput, amountIn, amountOut, activeTick
+	tokenIn := tokenB
+	if new(big.Int).SetBytes(l.Data[64:96]).Sign() != 0 {
+		tokenIn = tokenA
+	}
+
+	amountIn := new(big.Int).SetBytes(l.Data[128:160])
+	amountOut := new(big.Int).SetBytes(l.Data[160:192])
+
+	amount0, amount1 := poolDeltaBySide(_pair.Token0, tokenIn, amountIn, amountOut)
+	updateSwapByPoolDelta(swap, amount0, amount1, svc)
+
+	return swap
+}
@@ -0,0 +1,128 @@
+package handler
+
+import (
+	"sfilter/schema"
+	"sfilter/services/chain"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// uniswap v2/v3 及其fork, 如 sushi、pancake v3 等
+
+var (
+	uniswapV2SwapTopic   = common.HexToHash("0xd78ad95fa46c994b6551d0da85fc275fe613ce37657fb8d5e3d130840159d822")
+	uniswapV3SwapTopic   = common.HexToHash("0xc42079f94a6350d7e6235f29174924f928cc2ac818eb64fed8004e115fbcca67")
+	pancakeV3SwapTopic   = eventTopic("Swap(address,address,int256,int256,uint160,uint128,int24,uint128,uint128)")
+	uniswapV2MintTopic   = common.HexToHash("0x4c209b5fc8ad50758f13e2e1088ba56a560dff690a1c6fef26394f4c03821c4f")
+	uniswapV3MintTopic   = common.HexToHash("0x7a53080ba414158be7ec69b987b5fb7d07dee101fe85488f0853ae16239d0bde")
+	uniswapV2BurnTopic   = common.HexToHash("0xdccd412f0b1252819cb1fd330b93224ca42612892bb3f4f789976e6d81936496")
+	uniswapV3BurnTopic   = common.HexToHash("0x0c396cd989a39f4459b5fa1aed6a9a8dcdbc45908acfd67e028cd568da98982c")
+	uniswapV2PairCreated = common.HexToHash("0x0d3648bd0f6ba80134a33ba9275ac585d9d315f0ad8355cddefde31afa28d0e9")
+	uniswapV3PoolCreated = common.HexToHash("0x783cca1c0412dd0d695e784568c96da2e9c22ff989357a2e8b1d9b2b4e6b7118")
+)
+
+func init() {
+	RegisterDecoder(&uniswapV2Decoder{})
+	RegisterDecoder(&uniswapV3Decoder{name: "uniswap-v3", topic: uniswapV3SwapTopic})
+	RegisterDecoder(&uniswapV3Decoder{name: "pancakeswap-v3", topic: pancakeV3SwapTopic})
+	RegisterDecoder(&uniswapLiquidityDecoder{})
+	RegisterDecoder(&uniswapPairDecoder{})
+}
+
+type uniswapV2Decoder struct{}
+
+func (d *uniswapV2Decoder) Name() string {
+	return "uniswap-v2"
+}
+
+func (d *uniswapV2Decoder) Topics() []common.Hash {
+	return []common.Hash{uniswapV2SwapTopic}
+}
+
+func (d *uniswapV2Decoder) DecodeSwap(block *schema.Block, tx *schema.Transaction, l *types.Log, mongodb *mongo.Client, svc *chain.Service) *schema.Swap {
+	if len(l.Topics) != 3 || len(l.Data) < 128 {
+		return nil
+	}
+
+	swap := newSwapStruct(block, l, tx, l.Address.String(), svc)
+	if swap == nil {
+		return nil
+	}
+	swap.SwapType = schema.SWAP_EVENT_UNISWAPV2_LIKE
+
+	updateUniV2Swap(swap, l, svc)
+
+	return swap
+}
+
+// pancake v3 的 Swap 事件比 uniswap v3 多了 protocolFees 两个字段, 前面部分完全一致
+type uniswapV3Decoder struct {
+	name  string
+	topic common.Hash
+}
+
+func (d *uniswapV3Decoder) Name() string {
+	return d.name
+}
+
+func (d *uniswapV3Decoder) Topics() []common.Hash {
+	return []common.Hash{d.topic}
+}
+
+func (d *uniswapV3Decoder) DecodeSwap(block *schema.Block, tx *schema.Transaction, l *types.Log, mongodb *mongo.Client, svc *chain.Service) *schema.Swap {
+	if len(l.Topics) != 3 || len(l.Data) < 160 {
+		return nil
+	}
+
+	swap := newSwapStruct(block, l, tx, l.Address.String(), svc)
+	if swap == nil {
+		return nil
+	}
+	swap.SwapType = schema.SWAP_EVENT_UNISWAPV3_LIKE
+
+	updateUniV3Swap(swap, l, svc)
+
+	return swap
+}
+
+// v3 的 Mint/Burn 事件 pancake v3 与 uniswap v3 相同
+type uniswapLiquidityDecoder struct{}
+
+func (d *uniswapLiquidityDecoder) Name() string {
+	return "uniswap-liquidity"
+}
+
+func (d *uniswapLiquidityDecoder) Topics() []common.Hash {
+	return []common.Hash{uniswapV2MintTopic, uniswapV3MintTopic, uniswapV2BurnTopic, uniswapV3BurnTopic}
+}
+
+func (d *uniswapLiquidityDecoder) DecodeLiquidity(tx *schema.Transaction, l *types.Log, mongodb *mongo.Client, svc *chain.Service) *schema.LiquidityEvent {
+	switch l.Topics[0] {
+	case uniswapV2MintTopic:
+		return parseUniV2AddLiquidity(l, tx)
+	case uniswapV3MintTopic:
+		return parseUniV3AddLiquidity(l, tx)
+	case uniswapV2BurnTopic:
+		return parseUniV2RemoveLiquidity(l, tx)
+	case uniswapV3BurnTopic:
+		return parseUniV3RemoveLiquidity(l, tx)
+	}
+
+	return nil
+}
+
+type uniswapPairDecoder struct{}
+
+func (d *uniswapPairDecoder) Name() string {
+	return "uniswap-pair"
+}
+
+func (d *uniswapPairDecoder) Topics() []common.Hash {
+	return []common.Hash{uniswapV2PairCreated, uniswapV3PoolCreated}
+}
+
+func (d *uniswapPairDecoder) DecodePair(l *types.Log) *schema.Pair {
+	return parsePairCreatedEvent(l)
+}
@@ -0,0 +1,115 @@
+package handler
+
+import (
+	"math/big"
+	"sfilter/config"
+	"sfilter/schema"
+	"sfilter/services/chain"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// uniswap v4 所有池子都在 PoolManager 单例合约中, 以 poolId 区分
+// 因此用 PoolManager地址 + poolId 生成虚拟pair地址
+// refer: https://github.com/Uniswap/v4-core/blob/main/src/interfaces/IPoolManager.sol
+
+var (
+	uniswapV4InitializeTopic = eventTopic("Initialize(bytes32,address,address,uint24,int24,address,uint160,int24)")
+	uniswapV4SwapTopic       = eventTopic("Swap(bytes32,address,int128,int128,uint160,uint128,int24,uint24)")
+)
+
+func init() {
+	RegisterDecoder(&uniswapV4PairDecoder{})
+	RegisterDecoder(&uniswapV4SwapDecoder{})
+}
+
+func uniswapV4PairAddress(manager common.Address, poolId common.Hash) string {
+	return virtualPairAddress(manager.String(), poolId.Hex())
+}
+
+// v4 支持原生eth, 用 address(0) 表示, 统一换成 weth 方便计价
+func uniswapV4Currency(topic common.Hash) string {
+	currency := common.HexToAddress(topic.Hex())
+	if currency == (common.Address{}) {
+		return config.WETH_ADDRESS
+	}
+
+	return currency.String()
+}
+
+type uniswapV4PairDecoder struct{}
+
+func (d *uniswapV4PairDecoder) Name() string {
+	return "uniswap-v4-pair"
+}
+
+func (d *uniswapV4PairDecoder) Topics() []common.Hash {
+	return []common.Hash{uniswapV4InitializeTopic}
+}
+
+func (d *uniswapV4PairDecoder) DecodePair(l *types.Log) *schema.Pair {
+	if len(l.Topics) != 4 || len(l.Data) < 32 {
+		return nil
+	}
+
+	// 保持 currency0/currency1 的顺序, 不重新排序
+	// 原生eth换成weth后可能不再满足地址排序(如 eth/usdc), 重新排序会与 Swap 事件里 amount0/amount1、sqrtPrice 的顺序对不上
+	poolId := l.Topics[1]
+	token0 := uniswapV4Currency(l.Topics[2])
+	token1 := uniswapV4Currency(l.Topics[3])
+
+	_pair := &schema.Pair{
+		InfoOnChain: schema.InfoOnChain{
+			Address: uniswapV4PairAddress(l.Address, poolId),
+			Token0:  token0,
+			Token1:  token1,
+		},
+
+		InfoOnPairCreated: schema.InfoOnPairCreated{
+			Type:               schema.SWAP_EVENT_UNISWAPV4_LIKE,
+			PairCreatedBlockNo: l.BlockNumber,
+			PairCreatedHash:    l.TxHash.String(),
+			PairFee:            new(big.Int).SetBytes(l.Data[0:32]).Int64(),
+			PoolAddress:        l.Address.String(),
+			PoolId:             poolId.Hex(),
+		},
+	}
+
+	return _pair
+}
+
+type uniswapV4SwapDecoder struct{}
+
+func (d *uniswapV4SwapDecoder) Name() string {
+	return "uniswap-v4"
+}
+
+func (d *uniswapV4SwapDecoder) Topics() []common.Hash {
+	return []common.Hash{uniswapV4SwapTopic}
+}
+
+func (d *uniswapV4SwapDecoder) DecodeSwap(block *schema.Block, tx *schema.Transaction, l *types.Log, mongodb *mongo.Client, svc *chain.Service) *schema.Swap {
+	if len(l.Topics) != 3 || len(l.Data) < 160 {
+		return nil
+	}
+
+	// 没有 Initialize 记录的池子(如在开始同步之前创建的)无法得知 token, 直接跳过
+	swap := newSwapStruct(block, l, tx, uniswapV4PairAddress(l.Address, l.Topics[1]), svc)
+	if swap == nil {
+		return nil
+	}
+	swap.SwapType = schema.SWAP_EVENT_UNISWAPV4_LIKE
+
+	// v4 的 amount 是用户视角(正数表示用户收到), 取反后转为池子视角
+	// amount0/amount1 与 sqrtPrice 均按 currency0/currency1 顺序, 与 pair 的 token0/token1 一致
+	amount0 := math.S256(new(big.Int).SetBytes(l.Data[0:32]))
+	amount1 := math.S256(new(big.Int).SetBytes(l.Data[32:64]))
+
+	updateSwapByPoolDelta(swap, amount0.Neg(amount0), amount1.Neg(amount1), svc)
+	updateSwapPoolState(swap, l.Data[64:])
+
+	return swap
+}
@@ -0,0 +1,63 @@
+package handler
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"sfilter/config"
+	"sfilter/schema"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+const testUSDC = "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"
+
+// eth/usdc: currency0 为原生eth(address(0)), 换成weth后地址比usdc大
+func TestUniswapV4NativeEthPairKeepsCurrencyOrder(t *testing.T) {
+	l := &types.Log{
+		Address: common.HexToAddress("0x000000000004444c5dc75cB358380D2e3dE08A90"),
+		Topics: []common.Hash{
+			uniswapV4InitializeTopic,
+			common.HexToHash("0x01"),
+			common.BytesToHash(common.Address{}.Bytes()),
+			common.BytesToHash(common.HexToAddress(testUSDC).Bytes()),
+		},
+		Data: common.LeftPadBytes(big.NewInt(500).Bytes(), 32),
+	}
+
+	_pair := (&uniswapV4PairDecoder{}).DecodePair(l)
+	if _pair == nil {
+		t.Fatal("DecodePair returned nil")
+	}
+
+	if _pair.Token0 != config.WETH_ADDRESS || _pair.Token1 != testUSDC {
+		t.Fatalf("token order = %v/%v, want currency order %v/%v", _pair.Token0, _pair.Token1, config.WETH_ADDRESS, testUSDC)
+	}
+
+	if _pair.PairFee != 500 {
+		t.Fatalf("fee = %v, want 500", _pair.PairFee)
+	}
+
+	// sqrtPrice 为 currency1/currency0, 即 usdc/eth, 按 pair 的 token 顺序解出的 eth 价格应为 3000
+	sqrtPrice := new(big.Float).SetFloat64(math.Sqrt(3000 * 1e6 / 1e18))
+	sqrtPrice.Mul(sqrtPrice, new(big.Float).SetInt(new(big.Int).Lsh(big.NewInt(1), 96)))
+	sqrtPriceX96, _ := sqrtPrice.Int(nil)
+
+	data := append(common.LeftPadBytes(sqrtPriceX96.Bytes(), 32), make([]byte, 64)...)
+
+	swap := &schema.Swap{
+		Token0:       _pair.Token0,
+		Token1:       _pair.Token1,
+		MainToken:    config.WETH_ADDRESS,
+		PriceDecimal: "1",
+	}
+	swap.Decimal0 = 18
+	swap.Decimal1 = 6
+	updateSwapPoolState(swap, data)
+
+	if math.Abs(swap.Price-3000) > 0.01 {
+		t.Fatalf("price = %v, want 3000", swap.Price)
+	}
+}
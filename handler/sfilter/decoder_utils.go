@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"math/big"
+	"sfilter/config"
+	"sfilter/schema"
+	"sfilter/services/chain"
+	"sfilter/services/pair"
+	"sfilter/utils"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// 已确认存在于db中的pair, 避免每笔swap都查一次db
+var knownPairs sync.Map
+
+func eventTopic(signature string) common.Hash {
+	return crypto.Keccak256Hash([]byte(signature))
+}
+
+func getTxSender(tx *schema.Transaction) string {
+	sender, err := types.Sender(types.NewLondonSigner(tx.OriginTx.ChainId()), tx.OriginTx)
+	if err != nil {
+		utils.Warnf("[ getTxSender ] types.Sender err: %v, tx: %v\n", err, tx.OriginTx.Hash())
+		return ""
+	}
+
+	return sender.String()
+}
+
+// 多币池或单例合约中的币对没有独立地址, 用 池子标识 + token 哈希出一个虚拟地址
+// 保持地址格式, 方便沿用 kline、pair 等以地址为key的逻辑
+func virtualPairAddress(parts ...string) string {
+	var data []byte
+	for _, part := range parts {
+		data = append(data, common.FromHex(part)...)
+	}
+
+	return common.BytesToAddress(crypto.Keccak256(data)).String()
+}
+
+// 按地址大小排序, 与 uniswap 的 token0/token1 规则一致
+func sortTokens(tokenA, tokenB string) (string, string) {
+	if strings.ToLower(tokenA) > strings.ToLower(tokenB) {
+		return tokenB, tokenA
+	}
+
+	return tokenA, tokenB
+}
+
+// 多币池中的一个币对, poolId 为空时以池子地址区分
+func newVirtualPair(_type int, pool, poolId, tokenA, tokenB string) *schema.Pair {
+	token0, token1 := sortTokens(tokenA, tokenB)
+
+	poolKey := pool
+	if poolId != "" {
+		poolKey = poolId
+	}
+
+	return &schema.Pair{
+		InfoOnChain: schema.InfoOnChain{
+			Address: virtualPairAddress(poolKey, token0, token1),
+			Token0:  token0,
+			Token1:  token1,
+		},
+
+		InfoOnPairCreated: schema.InfoOnPairCreated{
+			Type:        _type,
+			PoolAddress: pool,
+			PoolId:      poolId,
+		},
+
+		CreatedAt: time.Now(),
+	}
+}
+
+// 非 uniswap 系的pair无法通过 token0()/token1() 从链上获取
+// 第一次碰到时由解析器直接写入db, 后续流程即可正常读取
+func ensurePair(_pair *schema.Pair, mongodb *mongo.Client, svc *chain.Service) {
+	if _, ok := knownPairs.Load(_pair.Address); ok {
+		return
+	}
+
+	_, err := pair.GetPairInfoForApi(_pair.Address, mongodb.Database(config.DatabaseName))
+	if err != nil {
+		updatePairTokenInfo(_pair, svc)
+		pair.UpSertPairCreatedInfo(_pair, mongodb)
+	}
+
+	knownPairs.Store(_pair.Address, true)
+}
+
+// 把 tokenIn 转入、tokenOut 转出 转换为池子视角的 amount0/amount1
+func poolDeltaBySide(token0, tokenIn string, amountIn, amountOut *big.Int) (*big.Int, *big.Int) {
+	out := new(big.Int).Neg(amountOut)
+
+	if tokenIn == token0 {
+		return new(big.Int).Set(amountIn), out
+	}
+
+	return out, new(big.Int).Set(amountIn)
+}
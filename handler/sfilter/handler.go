@@ -3,17 +3,21 @@ package handler
 import (
 	"context"
 	"math/big"
-	"sync"
+	"sync/atomic"
 	"time"
 
 	"sfilter/config"
 	"sfilter/schema"
 	service_block "sfilter/services/block"
 	"sfilter/services/chain"
+	"sfilter/services/eventbus"
+	"sfilter/services/kline"
+	"sfilter/services/nativeprice"
+	"sfilter/services/pricing"
+	"sfilter/services/rpcpool"
 	"sfilter/utils"
 
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/ethclient"
 	"go.mongodb.org/mongo-driver/mongo"
 )
 
@@ -21,7 +25,14 @@ import (
 
 type Handler struct {
 	DB     *mongo.Client
-	Client *ethclient.Client
+	Client *rpcpool.Pool // 节点池, 节点故障时自动切换
+
+	Service *chain.Service // 链上查询, 由外部注入
+	Bus     *eventbus.Bus  // 区块处理完成后发布事件, 为空则不发布
+	Klines  *kline.Engine  // 内存中聚合k线, 与区块一起写入
+	Prices  *pricing.Graph // 定价图, 非 weth/稳定币计价的 token 多跳换算 usd 价格
+
+	NativePrices *nativeprice.Series // 由参考池子的swap生成原生币价格序列
 
 	Tokens  schema.TokenMap
 	Pairs   schema.PairMap
@@ -32,10 +43,16 @@ type Handler struct {
 	SwapContracts map[string]bool // 把swap相关的地址全部存到一个地址, 方便查询
 }
 
-func NewHandler(client *ethclient.Client, db *mongo.Client) (*Handler, error) {
+func NewHandler(svc *chain.Service, client *rpcpool.Pool, bus *eventbus.Bus, db *mongo.Client) (*Handler, error) {
 	h := &Handler{
-		Client: client,
-		DB:     db,
+		Client:  client,
+		DB:      db,
+		Service: svc,
+		Bus:     bus,
+		Klines:  kline.NewEngine(),
+		Prices:  pricing.NewGraph(),
+
+		NativePrices: nativeprice.NewSeries(svc.Config().PriceRefPools()),
 	}
 
 	err := h.initMaps()
@@ -48,8 +65,7 @@ func (h *Handler) Run(block int64) {
 		return
 	}
 
-	go h.Retrive_old_blocks() // 先回溯
-
+	// 节点断开时由节点池切换节点并补发漏掉的区块头
 	headers := make(chan *types.Header)
 	sub, err := h.Client.SubscribeNewHead(context.Background(), headers)
 	if err != nil {
@@ -57,33 +73,41 @@ func (h *Handler) Run(block int64) {
 	}
 	utils.Infof("[ loop ] start SubscribeNewHead now..\n\n")
 
+	head, err := h.Client.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		utils.Fatalf("[ Run ] HeaderByNumber err: %v", err)
+	}
+
+	// 回溯的历史区块与实时区块在同一个流水线中按顺序写入
+	// 失败的区块一直重试, 不会跳过, 保证k线、价格序列等按区块顺序生成
+	start := h.getStartBlock(head.Number.Int64())
+	p := newPipeline(h, start, config.MaxConcurrentRoutineNums, h.fetchBlock, nil)
+	p.retry = true
+	p.watermark = true
+
+	feeder := newBlockFeeder(head.Number.Int64())
+	go h.feedBlocks(p, start, feeder)
+
 	for {
 		select {
 		case err := <-sub.Err():
-			utils.Warnf("[ Run ]SubscribeBlocks error: %v, reconnecting now...", err)
-
-			for {
-				sub, err = h.Client.SubscribeNewHead(context.Background(), headers)
-				if err != nil {
-					utils.Warnf("[ Run ] SubscribeNewHead err: %v, sleep now..", err)
-					time.Sleep(5 * time.Second)
-				} else {
-					utils.Infof("[ Run ] reconnect success..")
-					break
-				}
-
-			}
+			utils.Fatalf("[ Run ] SubscribeNewHead stopped: %v", err)
 
 		case header := <-headers:
 			utils.Infof("[ loop ] Get new header now. number: %v\n", header.Number)
-			go h.HandleBlock(header.Number)
+
+			// 重组在流水线写入区块前检测, 见 checkReorg
+			feeder.setHead(header.Number.Int64())
+
+			// 超过确认深度的区块标记为最终确认
+			service_block.ConfirmBlocks(header.Number.Int64()-int64(config.ConfirmationBlockNum), h.DB)
 		}
 
 	}
 }
 
 func (h *Handler) HandleBlock(blockNumber *big.Int) {
-	block, err := getBlock(blockNumber, h.Client, h.DB, 0)
+	block, err := h.getBlock(blockNumber, 0)
 
 	if err == nil {
 		h.handleOneBlock(block)
@@ -91,69 +115,63 @@ func (h *Handler) HandleBlock(blockNumber *big.Int) {
 }
 
 // 每次启动往回回溯n个区块, 防止某一次未处理
-// 回溯的时候, eth价格通过infura获取, 每10个区块更新一次价格
-func (h *Handler) Retrive_old_blocks() {
+func (h *Handler) getStartBlock(head int64) int64 {
 	if config.RetriveOldBlockNum < 0 {
-		utils.Infof("[ Retrive_old_blocks ] no retrive, return..")
-		return
+		utils.Infof("[ getStartBlock ] no retrive, start from block: %v", head)
+		return head
 	}
 
-	curBlkNo, err := h.Client.HeaderByNumber(context.Background(), nil)
-	if err != nil {
-		utils.Fatalf("[ Retrive_old_blocks ] HeaderByNumber err: %v", err)
-	}
+	start := head - int64(config.RetriveOldBlockNum)
+	utils.Infof("[ getStartBlock ] retrive now.. start block: %v", start)
 
-	startBlock := curBlkNo.Number.Int64() - int64(config.RetriveOldBlockNum)
-	utils.Infof("[ Retrive_old_blocks ] retrive now.. start block: %v", startBlock)
-
-	var ethPrice float64
-	times := 0
+	return start
+}
 
-	// 控制并发执行的协程数量
-	ch := make(chan struct{}, config.MaxConcurrentRoutineNums)
-	var wg sync.WaitGroup
+// 链头高度, 新区块头到达时更新并唤醒 feedBlocks
+type blockFeeder struct {
+	head   int64 // atomic
+	notify chan struct{}
+}
 
-	for i := startBlock; i < curBlkNo.Number.Int64(); i++ {
-		if service_block.IsBlockProceeded(i, h.DB) {
-			utils.Debugf("[ Retrive_old_blocks ] block %v has handled, pass..", i)
-			continue
-		}
+func newBlockFeeder(head int64) *blockFeeder {
+	return &blockFeeder{head: head, notify: make(chan struct{}, 1)}
+}
 
-		if times%config.GetPriceIntervalForRetrive == 0 {
-			ethPrice, err = chain.GetBasicCoinPrice(h.Client, big.NewInt(i), config.BlockChain)
-			if err != nil {
-				utils.Warnf("[ Retrive_old_blocks ] GetBasicCoinPrice err: %v", err)
-				continue // eth价格必须取到, 如果没取到, 回溯
-			}
+func (f *blockFeeder) setHead(head int64) {
+	for {
+		old := atomic.LoadInt64(&f.head)
+		if head <= old || atomic.CompareAndSwapInt64(&f.head, old, head) {
+			break
 		}
+	}
 
-		ch <- struct{}{} // 当协程处理不过来的时候, 这里会阻塞，写不进去
-		wg.Add(1)
-
-		go func(i int64, ethPrice float64) {
-			defer wg.Done()
-
-			block, err := getBlock(big.NewInt(i), h.Client, h.DB, ethPrice)
-			if err != nil {
-				return
-			}
-
-			<-ch // 处理完了, 释放一个协程位置
+	select {
+	case f.notify <- struct{}{}:
+	default:
+	}
+}
 
-			// 先释放协程, 再往后走, 因为这里面有 sleep
-			if block != nil {
-				h.handleOneBlock(block)
-			}
+// 按区块号依次交给流水线, 先追赶历史区块, 追上后等待新的区块头
+// 区块头跳号时(如节点切换)中间的区块也会依次提交, 不会遗漏
+func (h *Handler) feedBlocks(p *pipeline, start int64, f *blockFeeder) {
+	for next := start; ; next++ {
+		for next > atomic.LoadInt64(&f.head) {
+			<-f.notify
+		}
 
-		}(i, ethPrice)
+		if service_block.IsBlockProceeded(next, h.DB) {
+			utils.Debugf("[ feedBlocks ] block %v has handled, pass..", next)
+			p.Skip(next)
+			continue
+		}
 
-		times++
+		// 流水线处理不过来的时候, 这里会阻塞
+		p.Submit(next, 0)
 
-		time.Sleep(config.SleepIntervalforRetrive * time.Millisecond)
+		if next < atomic.LoadInt64(&f.head) {
+			time.Sleep(config.SleepIntervalforRetrive * time.Millisecond)
+		}
 	}
-
-	close(ch)
-	wg.Wait()
 }
 
 func (h *Handler) debugBlock(block int64) {
@@ -4,6 +4,8 @@ import (
 	"sfilter/config"
 	"sfilter/schema"
 	service_block "sfilter/services/block"
+	"sfilter/services/bulk"
+	"sfilter/services/eventbus"
 	"sfilter/services/pair"
 	"sfilter/services/token"
 	"sfilter/utils"
@@ -11,7 +13,8 @@ import (
 	"time"
 )
 
-var handler_Lock sync.Mutex
+// 解析区块时并行读取 map, 只有更新 map 时需要独占
+var handler_Lock sync.RWMutex
 
 // 更新 pair map和token map等, 防止程序未重新拉取db导致 数据没有及时更新
 func (h *Handler) updateMapBySwaps(swaps []*schema.Swap) {
@@ -22,7 +25,7 @@ func (h *Handler) updateMapBySwaps(swaps []*schema.Swap) {
 		// 更新pair map
 		_, ok := h.Pairs[_swap.PairAddr]
 		if !ok {
-			_pair, err := pair.GetPairInfoForRead(_swap.PairAddr)
+			_pair, err := pair.GetPairInfo(_swap.PairAddr, h.Service)
 			if err == nil {
 				h.Pairs[_swap.PairAddr] = _pair
 				h.SwapContracts[_swap.PairAddr] = true
@@ -41,43 +44,118 @@ func (h *Handler) updateMapBySwaps(swaps []*schema.Swap) {
 	}
 }
 
-// 串行处理即可, 因为跑到这里来的都是协程
+// 解析完成、等待写入的区块
+type blockResult struct {
+	blk   *schema.Block
+	b     *bulk.Batch
+	start time.Time
+
+	pairs     []*schema.Pair
+	liquidity *liquidityResult
+	swaps     []*schema.Swap
+	transfers []*schema.Transfer
+
+	indexedThrough int64 // 由流水线设置, 见 pipeline.watermark
+}
+
+// 不经过流水线, 直接处理一个区块, 用于调试
 func (h *Handler) handleOneBlock(blk *schema.Block) {
-	start := time.Now()
+	if err := h.applyBlock(h.decodeBlock(blk)); err != nil {
+		utils.Errorf("[ handleOneBlock ] block %v failed: %v", blk.Block.NumberU64(), err)
+	}
+}
 
-	HandlePairLogic(blk, h.DB)
-	HandleLiquidityLogic(blk, h.DB)
+// 只依赖本区块的解析, 可以多个区块并行执行
+// swap、transfer、流动性事件加入 batch, 在 applyBlock 中与k线及区块已处理标记一起写入
+// pair 等为幂等的覆盖更新, 区块重试时结果一致, 因此仍直接写入
+func (h *Handler) decodeBlock(blk *schema.Block) *blockResult {
+	res := &blockResult{
+		blk:   blk,
+		b:     bulk.NewBatch(config.DatabaseName),
+		start: time.Now(),
+	}
+
+	res.pairs = HandlePairLogic(blk, h.DB, h.Service)
+	res.liquidity = HandleLiquidityLogic(blk, res.b, h.DB, h.Service)
 
-	transfers := HandleTransfer(blk, h.DB) // 获取transfer信息
+	res.transfers = HandleTransfer(blk, h.DB, h.Service) // 获取transfer信息
 
-	swaps := HandleSwapAndKline(blk, h.DB) // 获取swaps
+	res.swaps = HandleSwap(blk, h.DB, h.Service) // 获取swaps
 	// 针对每一笔swap, 把里面碰到的 pair, token 都更新一下, 防止不及时
-	h.updateMapBySwaps(swaps)
+	h.updateMapBySwaps(res.swaps)
+
+	// 更新swap的trader等信息
+	UpsertSwapToDB(res.swaps, h.SwapContracts, res.transfers, res.b)
 
-	// 更新swap的trader等信息, 然后写入db
-	UpsertSwapToDB(swaps, h.SwapContracts, transfers, h.DB)
+	// 更新transfer的类型等信息, usd value 在 applyBlock 中计算
+	UpsertTransferToDB(res.transfers, res.swaps, res.b)
+
+	// 更新 facet 逻辑
+	// facet.HandleFacetLogic(blk, h.DB)
 
-	// 更新transfer的usd value等信息, 然后写入db
-	UpsertTransferToDB(transfers, swaps, h.DB)
+	// etc.. todo
+
+	return res
+}
+
+// k线、pool 状态、trade info 等聚合数据依赖之前区块的结果, 需要按区块号顺序执行
+// 返回错误时区块未标记为已处理, 重启回溯时会重新处理
+func (h *Handler) applyBlock(res *blockResult) error {
+	blk := res.blk
+
+	// 定价图按区块顺序更新, 本区块只能用到本区块及之前的成交
+	// swap、transfer 已加入 batch, 写入时才序列化, 在这里更新 usd 价格即可
+	PriceSwaps(blk, res.swaps, h.DB, h.Prices)
+	PriceTransfers(res.transfers, res.swaps, h.Prices, blk.EthPrice, h.DB)
+
+	// 池子流动性作为定价图的权重, 也需要按区块顺序更新
+	ApplyLiquidity(res.liquidity, blk, h.DB, h.Service, h.Prices)
+
+	// 更新v3等池子的当前状态
+	HandlePoolState(res.swaps, h.DB)
+
+	// record the proceeded block.
+	bps, err := h.persistBlock(blk, res.b, res.swaps)
+	if err != nil {
+		return err
+	}
 
 	// trade info 是更新最近24h或7天的数据, 因此老数据就别掺和了
+	// 依赖本区块已写入的 swap 和 k线, 因此放在写入之后
 	if time.Since(time.Unix(int64(blk.Block.Time()), 0)).Seconds() < config.SecondsForOneWeek {
-		HandleTradeInfo(blk, h.DB, swaps)
+		HandleTradeInfo(blk, h.DB, res.swaps, h.Service, h.Prices)
 		HandleGlobalInfo(blk, h.DB)
 	}
 
-	// 更新 用户跟踪地址逻辑
-	// HandleUserTrackSwaps(blk, h.DB, swaps)
+	// 用户跟踪地址逻辑改为订阅 SwapsPersisted 事件, 见 SubscribeUserTrackSwaps
 
-	// 更新 facet 逻辑
-	// facet.HandleFacetLogic(blk, h.DB)
+	// 数据都已写入db, 通知下游
+	h.publishBlockEvents(bps, res.pairs, res.liquidity.events, res.swaps, res.indexedThrough)
 
-	// etc.. todo
+	utils.Debugf("Handle block: %d finished, swap num: %v, records: %v, time elapsed: % v\n", blk.Block.NumberU64(), blk.TxNums, res.b.Len(), time.Since(res.start))
+	return nil
+}
 
-	// record the proceeded block.
-	h.setBlockToProceeded(blk)
+// k线需要读取db中已有的柱子, 读取和写入在同一把锁内完成, 与重组时重建k线互斥
+// 已处理标记最后加入, 不支持事务时也是最后写入
+func (h *Handler) persistBlock(blk *schema.Block, b *bulk.Batch, swaps []*schema.Swap) (*schema.BlockProceeded, error) {
+	kline_Lock.Lock()
+	defer kline_Lock.Unlock()
+
+	h.updateKlines(swaps, b)
+
+	// 价格序列依赖之前区块的价格, 在这里按顺序生成
+	h.NativePrices.Record(blk.Block.Number().Int64(), blk.BlockTime, swaps, b)
+
+	bps := h.setBlockToProceeded(blk, b)
+
+	if err := b.Flush(h.DB); err != nil {
+		// 内存中的k线已包含本区块, 丢弃后从db重新读取
+		h.Klines.Reset()
+		return bps, err
+	}
 
-	utils.Debugf("Handle block: %d finished, swap num: %v, time elapsed: % v\n", blk.Block.NumberU64(), blk.TxNums, time.Since(start))
+	return bps, nil
 }
 
 func (h *Handler) initMaps() error {
@@ -94,6 +172,11 @@ func (h *Handler) initMaps() error {
 		utils.Fatalf("[ InitMap ] GetPairMap failed: %v", err)
 	}
 
+	// 定价图加载失败不影响运行, 由之后的 swap 逐步补全
+	if err := h.Prices.Load(h.DB.Database(dbName)); err != nil {
+		utils.Warnf("[ InitMap ] load pricing graph failed: %v", err)
+	}
+
 	// 继续 routers 和 saddresss等
 	h.SAddresses = make(schema.SpecialAddressMap)
 	h.Routers = make(schema.RouterMap)
@@ -125,17 +208,39 @@ func (h *Handler) initMaps() error {
 	return nil
 }
 
-func (h *Handler) setBlockToProceeded(block *schema.Block) {
+func (h *Handler) setBlockToProceeded(block *schema.Block, b *bulk.Batch) *schema.BlockProceeded {
 	bps := &schema.BlockProceeded{
 		BlockNo:     block.Block.Number().Int64(),
 		Hash:        block.Block.Hash().String(),
+		ParentHash:  block.Block.ParentHash().String(),
 		BlockTime:   int64(block.Block.Time()),
 		TxNums:      block.TxNums,
 		VolumeByUsd: block.VolumeByUsd,
 
 		EthPrice: block.EthPrice,
+		Status:   schema.BLOCK_STATUS_UNCONFIRMED,
+
+		InternalTraceStatus: block.InternalTraceStatus,
 	}
 
-	service_block.SetBlockProceeded(bps, h.DB)
+	service_block.AddBlockProceededToBatch(bps, b)
+
+	return bps
+}
+
+// 没有内容的事件不发布, BlockProcessed 每个区块都发布
+func (h *Handler) publishBlockEvents(bps *schema.BlockProceeded, pairs []*schema.Pair, events []*schema.LiquidityEvent, swaps []*schema.Swap, indexedThrough int64) {
+	if len(pairs) > 0 {
+		h.Bus.Publish(eventbus.TopicPairCreated, bps.BlockNo, &eventbus.PairCreated{Pairs: pairs})
+	}
+
+	if len(events) > 0 {
+		h.Bus.Publish(eventbus.TopicLiquidityChanged, bps.BlockNo, &eventbus.LiquidityChanged{Events: events})
+	}
+
+	if len(swaps) > 0 {
+		h.Bus.Publish(eventbus.TopicSwapsPersisted, bps.BlockNo, &eventbus.SwapsPersisted{Swaps: swaps})
+	}
 
+	h.Bus.Publish(eventbus.TopicBlockProcessed, bps.BlockNo, &eventbus.BlockProcessed{Block: bps, IndexedThrough: indexedThrough})
 }
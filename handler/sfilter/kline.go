@@ -2,14 +2,18 @@ package handler
 
 import (
 	"sfilter/schema"
-	"sfilter/services/kline"
-
-	"go.mongodb.org/mongo-driver/mongo"
+	"sfilter/services/bulk"
+	"sync"
 )
 
-func UpdateKlines(swap *schema.Swap, mongodb *mongo.Client) {
-	kline.Update1MinKline(swap, mongodb)
-	kline.Update1HourKline(swap, mongodb)
+// k线在内存中聚合, 区块写入和回滚重建需要互斥, 重建时内存中不能有未写入的修改
+var kline_Lock sync.Mutex
+
+// 本区块的 swap 计入k线, 有修改的行加入 batch
+func (h *Handler) updateKlines(swaps []*schema.Swap, b *bulk.Batch) {
+	for _, swap := range swaps {
+		h.Klines.Update(swap, h.DB)
+	}
 
-	// update1DayKline(swap, mongodb)
+	h.Klines.Flush(b)
 }
@@ -3,8 +3,11 @@ package handler
 import (
 	"fmt"
 	"sfilter/schema"
+	"sfilter/services/bulk"
+	"sfilter/services/chain"
 	"sfilter/services/liquidity"
 	"sfilter/services/pair"
+	"sfilter/services/pricing"
 	"sfilter/utils"
 	"time"
 
@@ -12,22 +15,57 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 )
 
+// 本区块流动性事件的解析结果
+// 池子流动性依赖定价图, 首次添加流动性需要与之前的区块比较, 都在 ApplyLiquidity 中按区块顺序写入
+type liquidityResult struct {
+	events    []*schema.LiquidityEvent
+	balances  map[string]*poolBalance        // key 为 pair 地址
+	firstAdds map[string]*schema.InfoOnPools // key 为 pair 地址, 本区块最早的一次添加
+}
+
 // 先执行pair creat的操作
 // 在执行 handle liquidity 动作
-func HandleLiquidityLogic(block *schema.Block, mongodb *mongo.Client) {
+// 只读取链上数据, 不修改 db 和定价图, 可以多个区块并行执行
+func HandleLiquidityLogic(block *schema.Block, b *bulk.Batch, mongodb *mongo.Client, svc *chain.Service) *liquidityResult {
+	res := &liquidityResult{
+		balances:  make(map[string]*poolBalance),
+		firstAdds: make(map[string]*schema.InfoOnPools),
+	}
+
 	for _, tx := range block.Transactions {
 		if len(tx.Receipt.Logs) > 0 {
 			for _, _log := range tx.Receipt.Logs {
-				if len(_log.Topics) > 0 {
-					handleAddLiquidity(block, tx, _log, mongodb)
+				event := handleAddLiquidity(block, tx, _log, b, mongodb, svc, res)
+				if event != nil {
+					res.events = append(res.events, event)
 				}
 			}
 		}
 	}
+
+	return res
 }
 
-func handleAddLiquidity(block *schema.Block, tx *schema.Transaction, l *types.Log, mongodb *mongo.Client) {
-	event := parseLiquidityEvent(tx, l)
+// 更新池子流动性及首次添加流动性的信息, 在写入协程中, 本区块的 swap 定价之后执行
+func ApplyLiquidity(res *liquidityResult, block *schema.Block, mongodb *mongo.Client, svc *chain.Service, prices *pricing.Graph) {
+	for _, pb := range res.balances {
+		applyPoolLiquidity(pb, mongodb, block, prices)
+	}
+
+	for addr, info := range res.firstAdds {
+		_pair, err := pair.GetPairInfo(addr, svc)
+		if err != nil || _pair == nil {
+			continue
+		}
+
+		if _pair.FirstAddPoolBlockNo <= 0 || _pair.FirstAddPoolBlockNo > info.FirstAddPoolBlockNo {
+			pair.UpdatePoolInfo(addr, info, mongodb)
+		}
+	}
+}
+
+func handleAddLiquidity(block *schema.Block, tx *schema.Transaction, l *types.Log, b *bulk.Batch, mongodb *mongo.Client, svc *chain.Service, res *liquidityResult) *schema.LiquidityEvent {
+	event := parseLiquidityEvent(tx, l, mongodb, svc)
 
 	if event != nil {
 		event.EventBlockNo = l.BlockNumber
@@ -40,63 +78,49 @@ func handleAddLiquidity(block *schema.Block, tx *schema.Transaction, l *types.Lo
 		event.UpdatedAt = time.Now()
 		event.CreatedAt = time.Now()
 
-		_pair, err := pair.GetPairInfo(event.PoolAddress, mongodb)
+		_pair, err := pair.GetPairInfo(event.PoolAddress, svc)
 		if err != nil || _pair == nil {
 			utils.Warnf("[ handleAddLiquidity ] no pair?!! err: %v, tx: %v\n", err, event.EventTxHash)
-			return
+			return nil
 		}
 
 		// 修正流动性amount value
 		updateLiquidityEventValue(event, _pair, block)
 
-		// 修正流动性池子大小
-		UpdatePoolLiquidity(_pair, mongodb, block)
+		// 修正流动性池子大小, 同一个池子只读取一次余额
+		if _, ok := res.balances[_pair.Address]; !ok {
+			if pb := fetchPoolBalance(_pair, svc); pb != nil {
+				res.balances[_pair.Address] = pb
+			}
+		}
 
 		// 判断如果是第一次添加流动性, 则update pair的firstAdd字段
 		if event.Direction == schema.DIRECTION_BUY_OR_ADD {
 			// 同时需要确认我们也检测到了 pairCreat 事件, 否则可能是老pair
 			if _pair.PairCreatedBlockNo > 0 {
-				if _pair.FirstAddPoolBlockNo <= 0 || _pair.FirstAddPoolBlockNo > event.EventBlockNo {
-					info := &schema.InfoOnPools{
+				if _, ok := res.firstAdds[_pair.Address]; !ok {
+					res.firstAdds[_pair.Address] = &schema.InfoOnPools{
 						FirstAddPoolBlockNo: event.EventBlockNo,
 						FirstAddPoolTime:    event.EventTime,
 						FirstAddTxHash:      event.EventTxHash,
 						FirstAddGasPrice:    event.EventGasPrice,
 					}
-
-					pair.UpdatePoolInfo(_pair.Address, info, mongodb)
 				}
-
 			}
 
 		}
 
-		liquidity.SaveLiquidityEvent(event, mongodb)
-	}
-}
-
-func parseLiquidityEvent(tx *schema.Transaction, l *types.Log) *schema.LiquidityEvent {
-	var event *schema.LiquidityEvent
-
-	event = parseUniV2AddLiquidity(l, tx)
-	if event != nil {
-		return event
+		liquidity.AddLiquidityEventToBatch(event, b)
 	}
 
-	event = parseUniV3AddLiquidity(l, tx)
-	if event != nil {
-		return event
-	}
-
-	event = parseUniV2RemoveLiquidity(l, tx)
-	if event != nil {
-		return event
-	}
+	return event
+}
 
-	event = parseUniV3RemoveLiquidity(l, tx)
-	if event != nil {
-		return event
+func parseLiquidityEvent(tx *schema.Transaction, l *types.Log, mongodb *mongo.Client, svc *chain.Service) *schema.LiquidityEvent {
+	decoder, ok := getLiquidityDecoder(l)
+	if !ok {
+		return nil
 	}
 
-	return nil
+	return decoder.DecodeLiquidity(tx, l, mongodb, svc)
 }
@@ -8,8 +8,10 @@ import (
 	"sfilter/schema"
 	"sfilter/services/chain"
 	"sfilter/services/pair"
+	"sfilter/services/pricing"
 	"sfilter/utils"
 	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/core/types"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -124,6 +126,9 @@ func parseUniV3RemoveLiquidity(l *types.Log, tx *schema.Transaction) *schema.Liq
 func updateLiquidityEventValue(event *schema.LiquidityEvent, _pair *schema.Pair, block *schema.Block) {
 	event.PairName = _pair.PairName
 
+	event.Amount0Decimal = utils.TokenAmountToDecimal(utils.GetBigIntOrZero(event.Amount0), _pair.Decimal0)
+	event.Amount1Decimal = utils.TokenAmountToDecimal(utils.GetBigIntOrZero(event.Amount1), _pair.Decimal1)
+
 	var amount, amount0, amount1 float64
 
 	amount0 = utils.CalculateVolumeInUsd(_pair.Token0, utils.GetBigFloatOrZero(event.Amount0), _pair.Decimal0, block.EthPrice)
@@ -142,18 +147,50 @@ func updateLiquidityEventValue(event *schema.LiquidityEvent, _pair *schema.Pair,
 	event.AmountInUsd = amount
 }
 
-// 去链上获取流动性池子大小
-// 直接获取token0及token1的balance, 再确认价值币
-func UpdatePoolLiquidity(_pair *schema.Pair, mongodb *mongo.Client, block *schema.Block) {
-	token0BalanceInt, err0 := chain.BalanceOf(_pair.Address, _pair.Token0)
-	token1BalanceInt, err1 := chain.BalanceOf(_pair.Address, _pair.Token1)
+// 池子两边 token 的余额, 解析时从链上读取, 写入时再计算价值
+type poolBalance struct {
+	pair     *schema.Pair
+	balance0 *big.Float
+	balance1 *big.Float
+}
+
+// 去链上获取流动性池子大小, 并更新 pair 及定价图的流动性
+// 只在按顺序写入的阶段调用
+func UpdatePoolLiquidity(_pair *schema.Pair, mongodb *mongo.Client, block *schema.Block, svc *chain.Service, prices *pricing.Graph) {
+	if pb := fetchPoolBalance(_pair, svc); pb != nil {
+		applyPoolLiquidity(pb, mongodb, block, prices)
+	}
+}
+
+// 直接获取token0及token1的balance, 只读取链上数据, 可以在并行解析时调用
+func fetchPoolBalance(_pair *schema.Pair, svc *chain.Service) *poolBalance {
+	holder := getPoolFundHolder(_pair)
+	if holder == "" {
+		return nil
+	}
+
+	token0BalanceInt, err0 := svc.BalanceOf(holder, _pair.Token0)
+	token1BalanceInt, err1 := svc.BalanceOf(holder, _pair.Token1)
 	if err0 != nil || err1 != nil {
-		utils.Warnf("[ updatePoolLiquidity ] get balance err0: %v, err1: %v\n", err0, err1)
-		return
+		utils.Warnf("[ fetchPoolBalance ] get balance err0: %v, err1: %v\n", err0, err1)
+		return nil
+	}
+
+	return &poolBalance{
+		pair:     _pair,
+		balance0: utils.GetBigFloatOrZero(token0BalanceInt.String()),
+		balance1: utils.GetBigFloatOrZero(token1BalanceInt.String()),
 	}
+}
 
-	token0Balance := utils.GetBigFloatOrZero(token0BalanceInt.String())
-	token1Balance := utils.GetBigFloatOrZero(token1BalanceInt.String())
+// 确认价值币计算池子价值, 两边都不是价值币时, 通过定价图计算两边的价值
+// 依赖定价图, 在写入协程中按区块顺序执行
+func applyPoolLiquidity(pb *poolBalance, mongodb *mongo.Client, block *schema.Block, prices *pricing.Graph) {
+	_pair := pb.pair
+
+	// 下面的计算会修改余额, 复制一份
+	token0Balance := new(big.Float).Set(pb.balance0)
+	token1Balance := new(big.Float).Set(pb.balance1)
 
 	amount0 := utils.CalculateVolumeInUsd(_pair.Token0, token0Balance, _pair.Decimal0, block.EthPrice)
 	amount1 := utils.CalculateVolumeInUsd(_pair.Token1, token1Balance, _pair.Decimal1, block.EthPrice)
@@ -163,6 +200,13 @@ func UpdatePoolLiquidity(_pair *schema.Pair, mongodb *mongo.Client, block *schem
 	// 计算pool价值币value直接相加即可
 	_pair.ValueCoinLiquidity = amount0 + amount1
 
+	if amount0 == 0 && amount1 == 0 {
+		blockTime := time.Unix(int64(block.Block.Time()), 0)
+
+		amount0 = tokenValueInUsd(prices, _pair.Token0, token0Balance, _pair.Decimal0, block.EthPrice, blockTime)
+		amount1 = tokenValueInUsd(prices, _pair.Token1, token1Balance, _pair.Decimal1, block.EthPrice, blockTime)
+	}
+
 	// 计算 liquidity
 	// 1. 如果双方为0, 则为0
 	// 2. 如果双方均不为0, 则直接相加
@@ -208,4 +252,34 @@ func UpdatePoolLiquidity(_pair *schema.Pair, mongodb *mongo.Client, block *schem
 	// update pair liquidity info
 	// utils.Infof("[ updatePoolLiquidity ] update pair: %v liquidity now.. amount0: %v, amount1: %v", _pair.Address, amount0, amount1)
 	pair.UpSertOnChainInfo(_pair.Address, &_pair.InfoOnChain, mongodb)
+
+	prices.SetLiquidity(_pair.Address, _pair.LiquidityInUsd)
+}
+
+// 通过定价图计算 token 数量的 usd 价值, 无法定价时为0
+func tokenValueInUsd(prices *pricing.Graph, token string, balance *big.Float, decimal uint8, ethPrice float64, at time.Time) float64 {
+	quote, ok := prices.PriceInUsd(token, ethPrice, at)
+	if !ok {
+		return 0
+	}
+
+	exponent := new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimal)), nil))
+	amount, _ := new(big.Float).Quo(balance, exponent).Float64()
+
+	return amount * quote.PriceInUsd.Float64()
+}
+
+// 实际持有池子资金的地址
+// balancer vault 及 v4 PoolManager 中的资金为所有池子共享, 无法通过 balance 计算, 返回空
+func getPoolFundHolder(_pair *schema.Pair) string {
+	switch _pair.Type {
+	case schema.SWAP_EVENT_BALANCERV2_LIKE, schema.SWAP_EVENT_UNISWAPV4_LIKE:
+		return ""
+	}
+
+	if _pair.PoolAddress != "" {
+		return _pair.PoolAddress
+	}
+
+	return _pair.Address
 }
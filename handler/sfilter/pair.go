@@ -10,35 +10,48 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 )
 
-func HandlePairLogic(block *schema.Block, mongodb *mongo.Client) {
+// 返回本区块新建的 pair
+func HandlePairLogic(block *schema.Block, mongodb *mongo.Client, svc *chain.Service) []*schema.Pair {
+	var pairs []*schema.Pair
+
 	for _, tx := range block.Transactions {
 		if len(tx.Receipt.Logs) > 0 {
 			for _, _log := range tx.Receipt.Logs {
-				if len(_log.Topics) > 0 {
-					handlePairCreated(block, _log, mongodb)
+				_pair := handlePairCreated(block, _log, mongodb, svc)
+				if _pair != nil {
+					pairs = append(pairs, _pair)
 				}
 			}
 		}
 	}
+
+	return pairs
 }
 
-func handlePairCreated(block *schema.Block, _log *types.Log, mongodb *mongo.Client) {
-	_pair := parsePairCreatedEvent(_log)
+func handlePairCreated(block *schema.Block, _log *types.Log, mongodb *mongo.Client, svc *chain.Service) *schema.Pair {
+	decoder, ok := getPairDecoder(_log)
+	if !ok {
+		return nil
+	}
+
+	_pair := decoder.DecodePair(_log)
 	if _pair != nil {
 		utils.Debugf("[ handlePairCreated ] pair: %v, tx: %v", _pair, _log.TxHash)
 
 		block.PairCreatedNum++
 
-		updatePairTokenInfo(_pair, mongodb)
+		updatePairTokenInfo(_pair, svc)
 
 		// 插入或更新pair
 		pair.UpSertPairCreatedInfo(_pair, mongodb)
 	}
+
+	return _pair
 }
 
-func updatePairTokenInfo(_pair *schema.Pair, mongodb *mongo.Client) {
-	token0, err0 := chain.GetTokenInfo(_pair.Token0, mongodb)
-	token1, err1 := chain.GetTokenInfo(_pair.Token1, mongodb)
+func updatePairTokenInfo(_pair *schema.Pair, svc *chain.Service) {
+	token0, err0 := svc.GetTokenInfo(_pair.Token0)
+	token1, err1 := svc.GetTokenInfo(_pair.Token1)
 	if err0 != nil || err1 != nil {
 		// pair create的时候, 一般不会出错..
 		utils.Warnf("[ updatePairTokenInfo ] getTokenInfo error. token0: %v, err0: %v, token1: %v, err1: %v\n", _pair.Token0, err0, _pair.Token1, err1)
@@ -53,5 +66,5 @@ func updatePairTokenInfo(_pair *schema.Pair, mongodb *mongo.Client) {
 	_pair.Decimal0 = token0.Decimal
 	_pair.Decimal1 = token1.Decimal
 
-	pair.GeneratePairName(_pair, token0, token1)
+	pair.GeneratePairName(_pair, token0, token1, svc)
 }
@@ -6,6 +6,7 @@ import (
 	"sfilter/services/chain"
 	"sfilter/services/kline"
 	services_pair "sfilter/services/pair"
+	"sfilter/services/pricing"
 	"sfilter/services/token"
 	"sfilter/utils"
 	"time"
@@ -14,36 +15,58 @@ import (
 )
 
 // trade info 放到pair 中，方便直接查询读取等
-func HandleTradeInfo(block *schema.Block, mongodb *mongo.Client, swaps []*schema.Swap) {
-	pairs := make(map[string]int)      // 取出本次需要更新的pair信息
-	tokens := make(map[string]float64) // 取出本次需要更新的 token 信息
+func HandleTradeInfo(block *schema.Block, mongodb *mongo.Client, swaps []*schema.Swap, svc *chain.Service, prices *pricing.Graph) {
+	pairs := make(map[string]int)            // 取出本次需要更新的pair信息
+	tokens := make(map[string]utils.Decimal) // 取出本次需要更新的 token 信息
 
 	for _, swap := range swaps {
 		pairs[swap.PairAddr]++
-		tokens[swap.MainToken] = swap.PriceInUsd
+		tokens[swap.MainToken] = swap.PriceInUsdDecimal
 	}
 
 	// 更新pair信息
 	for key := range pairs {
-		updatePairTradeInfo(key, mongodb) // update trade info
+		updatePairTradeInfo(key, mongodb, svc) // update trade info
 
-		_pair, err := services_pair.GetPairInfo(key, mongodb)
+		_pair, err := services_pair.GetPairInfo(key, svc)
 		if err == nil {
-			UpdatePoolLiquidity(_pair, mongodb, block)
+			UpdatePoolLiquidity(_pair, mongodb, block, svc, prices)
 		}
 
 	}
 
 	// 更新token价格等
 	for _token, _price := range tokens {
-		if _price > 0 { // 防止某些pair双向token均为屌丝币而把价格覆盖掉
-			updateTokenInfo(_token, _price, mongodb)
+		if _price.Rat().Sign() > 0 { // 防止某些pair双向token均为屌丝币而把价格覆盖掉
+			updateTokenInfo(_token, _price, mongodb, svc)
 		}
 	}
 }
 
-func updateTokenInfo(_token string, _price float64, mongodb *mongo.Client) {
-	tokenObj, err := chain.GetTokenInfo(_token, mongodb)
+// 更新集中流动性池子的当前 tick、流动性等
+// 与 trade info 不同, 老区块也需要更新, 由 UpdatePoolState 保证不会覆盖新状态
+func HandlePoolState(swaps []*schema.Swap, mongodb *mongo.Client) {
+	poolStates := make(map[string]*schema.InfoOnPoolState)
+
+	for _, swap := range swaps {
+		// swaps 按交易顺序排列, 后面的覆盖前面的即为最新状态
+		if swap.SqrtPriceX96 != "" {
+			poolStates[swap.PairAddr] = &schema.InfoOnPoolState{
+				SqrtPriceX96:     swap.SqrtPriceX96,
+				ActiveLiquidity:  swap.Liquidity,
+				CurrentTick:      swap.Tick,
+				PoolStateBlockNo: swap.BlockNo,
+			}
+		}
+	}
+
+	for addr, state := range poolStates {
+		services_pair.UpdatePoolState(addr, state, mongodb)
+	}
+}
+
+func updateTokenInfo(_token string, _price utils.Decimal, mongodb *mongo.Client, svc *chain.Service) {
+	tokenObj, err := svc.GetTokenInfo(_token)
 	if err != nil {
 		return
 	}
@@ -52,8 +75,8 @@ func updateTokenInfo(_token string, _price float64, mongodb *mongo.Client) {
 	token.UpdateTokenPrice(tokenObj.Address, _price, mongodb)
 }
 
-func updatePairTradeInfo(_pair string, mongodb *mongo.Client) {
-	pair, err := services_pair.GetPairInfo(_pair, mongodb)
+func updatePairTradeInfo(_pair string, mongodb *mongo.Client, svc *chain.Service) {
+	pair, err := services_pair.GetPairInfo(_pair, svc)
 	if err != nil {
 		utils.Errorf("[ updatePairInfo ] GetPairInfo wrong, return.. err: %v\n\n", err)
 		return
@@ -0,0 +1,265 @@
+package handler
+
+import (
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"sfilter/config"
+	"sfilter/schema"
+	"sfilter/utils"
+)
+
+// 区块处理流水线
+// 拉取区块、解析 swap/transfer 等只依赖本区块, 由多个 worker 并行执行
+// k线、pair trade info、全局趋势等聚合数据是读改写, 按区块号顺序在单个协程中写入
+type pipeline struct {
+	h *Handler
+
+	fetch     func(blockNo int64, ethPrice float64) (*schema.Block, error)
+	onApplied func(blockNo int64, err error) // 区块写入完成后回调, 在写入协程中执行, 可以为空
+
+	// 失败的区块在写入协程中重新拉取, 直到成功才写入后面的区块, 保证按顺序且不遗漏
+	// 为 false 时失败的区块交给 onApplied 处理(如回填记录到 checkpoint)
+	retry bool
+
+	// 为 true 时区块从 start 开始连续写入, 写入的区块号即已完整入库的高度, 随 BlockProcessed 发布
+	// 回填等不连续的流水线不设置
+	watermark bool
+
+	lastHash string // 上一个写入的区块hash, 用于检测重组, 为空时从db读取
+
+	tasks   chan *blockTask
+	results chan *blockTask
+	slots   chan struct{} // 限制已提交未写入的区块数, 避免解析远远领先于写入
+
+	next      int64 // 下一个需要写入的区块
+	pending   map[int64]*blockTask
+	waitSince time.Time // 开始等待 next 区块的时间
+
+	wg   sync.WaitGroup
+	done chan struct{}
+}
+
+type blockTask struct {
+	blockNo  int64
+	ethPrice float64
+
+	skip bool // 已处理过或不需要处理, 只用于推进顺序
+	res  *blockResult
+	err  error
+}
+
+func newPipeline(h *Handler, start int64, workers int, fetch func(int64, float64) (*schema.Block, error), onApplied func(int64, error)) *pipeline {
+	p := &pipeline{
+		h:         h,
+		fetch:     fetch,
+		onApplied: onApplied,
+		tasks:     make(chan *blockTask, workers),
+		results:   make(chan *blockTask, workers),
+		slots:     make(chan struct{}, workers*config.PipelineMaxPending),
+		next:      start,
+		pending:   make(map[int64]*blockTask),
+		done:      make(chan struct{}),
+	}
+
+	for i := 0; i < workers; i++ {
+		p.wg.Add(1)
+		go p.work()
+	}
+	go p.run()
+
+	return p
+}
+
+func (h *Handler) fetchBlock(blockNo int64, ethPrice float64) (*schema.Block, error) {
+	return h.getBlock(big.NewInt(blockNo), ethPrice)
+}
+
+// 提交一个区块, 写入跟不上时阻塞
+// ethPrice 为 0 时由 getBlock 获取当前价格
+func (p *pipeline) Submit(blockNo int64, ethPrice float64) {
+	p.slots <- struct{}{}
+	p.tasks <- &blockTask{blockNo: blockNo, ethPrice: ethPrice}
+}
+
+// 不需要处理的区块也要告知流水线, 否则后面的区块要等到超时才能写入
+func (p *pipeline) Skip(blockNo int64) {
+	p.slots <- struct{}{}
+	p.results <- &blockTask{blockNo: blockNo, skip: true}
+}
+
+// 等待已提交的区块全部写入, 之后不能再提交
+func (p *pipeline) Close() {
+	close(p.tasks)
+	p.wg.Wait()
+
+	close(p.results)
+	<-p.done
+}
+
+func (p *pipeline) work() {
+	defer p.wg.Done()
+
+	for t := range p.tasks {
+		p.process(t)
+		p.results <- t
+	}
+}
+
+// 拉取并解析一个区块
+func (p *pipeline) process(t *blockTask) {
+	blk, err := p.fetch(t.blockNo, t.ethPrice)
+	switch {
+	case err == errBlockProceeded:
+		t.skip = true
+	case err != nil:
+		t.err = err
+	default:
+		t.res = p.h.decodeBlock(blk)
+	}
+}
+
+func (p *pipeline) run() {
+	defer close(p.done)
+
+	ticker := time.NewTicker(config.PipelineMaxWait / 4)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case t, ok := <-p.results:
+			if !ok {
+				p.drain()
+				return
+			}
+			p.receive(t)
+
+		case <-ticker.C:
+			p.warnMissing()
+		}
+	}
+}
+
+func (p *pipeline) receive(t *blockTask) {
+	// 已经写入过的区块, 乱序写入会破坏k线等聚合数据, 直接丢弃
+	if t.blockNo < p.next {
+		utils.Warnf("[ pipeline ] block %v arrived after block %v has been applied, drop it", t.blockNo, p.next-1)
+		p.release()
+		return
+	}
+
+	if len(p.pending) == 0 {
+		p.waitSince = time.Now()
+	}
+
+	if _, ok := p.pending[t.blockNo]; ok {
+		utils.Warnf("[ pipeline ] block %v submitted twice, use the latest one", t.blockNo)
+		p.release()
+	}
+	p.pending[t.blockNo] = t
+
+	p.applyPending()
+}
+
+func (p *pipeline) applyPending() {
+	for {
+		t, ok := p.pending[p.next]
+		if !ok {
+			return
+		}
+
+		delete(p.pending, p.next)
+		p.next++
+		p.waitSince = time.Now()
+
+		p.apply(t)
+	}
+}
+
+// next 区块等待太久时只告警, 继续等待
+// 跳过后该区块到达时只能乱序写入, k线、trade info、价格序列等都会出错
+func (p *pipeline) warnMissing() {
+	if len(p.pending) == 0 || time.Since(p.waitSince) < config.PipelineMaxWait {
+		return
+	}
+
+	utils.Warnf("[ pipeline ] block %v not arrived after %v, still waiting. pending blocks: %v", p.next, config.PipelineMaxWait, len(p.pending))
+	p.waitSince = time.Now()
+}
+
+// 结束时还有缺口, 剩余的区块仍按顺序写入
+func (p *pipeline) drain() {
+	blockNos := make([]int64, 0, len(p.pending))
+	for blockNo := range p.pending {
+		blockNos = append(blockNos, blockNo)
+	}
+	sort.Slice(blockNos, func(i, j int) bool { return blockNos[i] < blockNos[j] })
+
+	for _, blockNo := range blockNos {
+		p.apply(p.pending[blockNo])
+		delete(p.pending, blockNo)
+	}
+}
+
+func (p *pipeline) apply(t *blockTask) {
+	defer p.release()
+
+	err := p.applyWithRetry(t)
+
+	if p.onApplied != nil {
+		p.onApplied(t.blockNo, err)
+	}
+}
+
+func (p *pipeline) applyWithRetry(t *blockTask) error {
+	err := p.applyTask(t)
+	for err != nil && p.retry {
+		utils.Warnf("[ pipeline ] block %v failed: %v, retry after %v", t.blockNo, err, config.PipelineRetryInterval)
+		time.Sleep(config.PipelineRetryInterval)
+
+		// 重新拉取解析, 写入失败时 batch 可能已部分写入, 不能复用
+		t = &blockTask{blockNo: t.blockNo, ethPrice: t.ethPrice}
+		p.process(t)
+		err = p.applyTask(t)
+	}
+
+	return err
+}
+
+func (p *pipeline) applyTask(t *blockTask) error {
+	if t.res == nil {
+		p.lastHash = ""
+		return t.err
+	}
+
+	// 之前的区块都已写入, 在这里检测重组不会因为父区块还在流水线中而漏掉
+	p.checkReorg(t.res.blk)
+
+	if p.watermark {
+		t.res.indexedThrough = t.blockNo
+	}
+
+	if err := p.h.applyBlock(t.res); err != nil {
+		p.lastHash = ""
+		return err
+	}
+
+	p.lastHash = t.res.blk.Block.Hash().String()
+	return nil
+}
+
+// 重组回滚后重新处理主链上的区块, 在写入协程中按顺序执行
+func (p *pipeline) reprocess(blockNo int64) {
+	t := &blockTask{blockNo: blockNo}
+	p.process(t)
+
+	if err := p.applyWithRetry(t); err != nil {
+		utils.Errorf("[ pipeline ] reprocess block %v failed: %v", blockNo, err)
+	}
+}
+
+func (p *pipeline) release() {
+	<-p.slots
+}
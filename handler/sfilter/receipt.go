@@ -0,0 +1,163 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"sfilter/config"
+	"sfilter/services/rpcpool"
+	"sfilter/utils"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// 节点不支持 eth_getBlockReceipts 时置为 true, 之后直接走 batch 请求
+var blockReceiptsUnsupported atomic.Bool
+
+// 一次性获取区块中指定交易的 receipt
+// 优先使用 eth_getBlockReceipts, 一个请求拿到全部; 不支持时退化为 batch 请求
+func getReceipts(client *rpcpool.Pool, block *types.Block, txs []*types.Transaction) (map[common.Hash]*types.Receipt, error) {
+	if len(txs) == 0 {
+		return make(map[common.Hash]*types.Receipt), nil
+	}
+
+	if !blockReceiptsUnsupported.Load() {
+		receipts, err := getBlockReceipts(client, block)
+		if err == nil {
+			return receipts, nil
+		}
+
+		if isMethodNotFound(err) {
+			utils.Warnf("[ getReceipts ] eth_getBlockReceipts not supported, use batch call instead. err: %v", err)
+			blockReceiptsUnsupported.Store(true)
+		} else {
+			utils.Warnf("[ getReceipts ] getBlockReceipts(%v) err: %v, try batch call", block.Number(), err)
+		}
+	}
+
+	return getReceiptsByBatch(client, block, txs)
+}
+
+func getBlockReceipts(client *rpcpool.Pool, block *types.Block) (map[common.Hash]*types.Receipt, error) {
+	var receipts []*types.Receipt
+	var err error
+
+	blockHash := rpc.BlockNumberOrHashWithHash(block.Hash(), true)
+	for i := 0; i < config.ReceiptRetryTimes; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(config.ReceiptCallTimeout)*time.Second)
+		receipts, err = client.BlockReceipts(ctx, blockHash)
+		cancel()
+
+		if err == nil || isMethodNotFound(err) {
+			break
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(receipts) != len(block.Transactions()) {
+		return nil, fmt.Errorf("receipt num mismatch, want: %v, got: %v", len(block.Transactions()), len(receipts))
+	}
+
+	result := make(map[common.Hash]*types.Receipt, len(receipts))
+	for _, receipt := range receipts {
+		result[receipt.TxHash] = receipt
+	}
+
+	return result, nil
+}
+
+// 按 ReceiptBatchSize 分批请求 eth_getTransactionReceipt, 失败的部分单独重试
+func getReceiptsByBatch(client *rpcpool.Pool, block *types.Block, txs []*types.Transaction) (map[common.Hash]*types.Receipt, error) {
+	result := make(map[common.Hash]*types.Receipt, len(txs))
+
+	pending := make([]common.Hash, 0, len(txs))
+	for _, tx := range txs {
+		pending = append(pending, tx.Hash())
+	}
+
+	var lastErr error
+	for i := 0; i < config.ReceiptRetryTimes && len(pending) > 0; i++ {
+		var failed []common.Hash
+
+		for start := 0; start < len(pending); start += config.ReceiptBatchSize {
+			end := start + config.ReceiptBatchSize
+			if end > len(pending) {
+				end = len(pending)
+			}
+
+			hashes := pending[start:end]
+			receipts, err := batchGetReceipts(client, hashes)
+			if err != nil {
+				lastErr = err
+				failed = append(failed, hashes...)
+				continue
+			}
+
+			for j, receipt := range receipts {
+				if receipt == nil {
+					failed = append(failed, hashes[j])
+					continue
+				}
+				result[hashes[j]] = receipt
+			}
+		}
+
+		pending = failed
+	}
+
+	// 缺少 receipt 的区块不能处理, 交给上层重试整个区块
+	if len(pending) > 0 {
+		return nil, fmt.Errorf("block: %v, %v receipts missing, last err: %v", block.Number(), len(pending), lastErr)
+	}
+
+	return result, nil
+}
+
+// 返回的切片与 hashes 一一对应, 单个失败时对应位置为 nil
+func batchGetReceipts(client *rpcpool.Pool, hashes []common.Hash) ([]*types.Receipt, error) {
+	receipts := make([]*types.Receipt, len(hashes))
+	elems := make([]rpc.BatchElem, len(hashes))
+
+	for i, hash := range hashes {
+		elems[i] = rpc.BatchElem{
+			Method: "eth_getTransactionReceipt",
+			Args:   []interface{}{hash},
+			Result: &receipts[i],
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(config.ReceiptCallTimeout)*time.Second)
+	defer cancel()
+
+	err := client.BatchCallContext(ctx, elems)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, elem := range elems {
+		if elem.Error != nil {
+			receipts[i] = nil
+		}
+	}
+
+	return receipts, nil
+}
+
+func isMethodNotFound(err error) bool {
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) && rpcErr.ErrorCode() == -32601 {
+		return true
+	}
+
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "method not found") || strings.Contains(msg, "does not exist")
+}
@@ -0,0 +1,263 @@
+package handler
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"sfilter/config"
+	"sfilter/schema"
+	service_block "sfilter/services/block"
+	"sfilter/services/eventbus"
+	"sfilter/services/kline"
+	"sfilter/services/liquidity"
+	"sfilter/services/nativeprice"
+	"sfilter/services/pair"
+	service_swap "sfilter/services/swap"
+	"sfilter/services/transfer"
+	"sfilter/utils"
+
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// k线柱子, 以 pair + 柱子开始时间 作为唯一标识
+type klineSlot struct {
+	pair string
+	unix int64
+}
+
+// 写入区块前检测链重组: 区块的 parentHash 必须与上一个写入的区块 hash 一致
+// 在流水线按顺序写入的协程中执行, 此时之前的区块都已写入
+func (p *pipeline) checkReorg(blk *schema.Block) {
+	num := blk.Block.Number().Int64()
+
+	parentHash := p.lastHash
+	if parentHash == "" {
+		stored, err := service_block.GetBlockProceeded(num-1, p.h.DB)
+		if err != nil {
+			return // 上一个区块没有处理过, 无从比较
+		}
+		parentHash = stored.Hash
+	}
+
+	if blk.Block.ParentHash().String() == parentHash {
+		return
+	}
+
+	// 重新处理的区块从db读取共同祖先的hash比较
+	p.lastHash = ""
+	p.h.handleReorg(blk.Block.Header(), p.reprocess)
+}
+
+// 不一致则往回找到共同祖先, 回滚孤块的数据, 再通过流水线重新处理主链上的区块
+func (h *Handler) handleReorg(header *types.Header, reprocess func(blockNo int64)) {
+	orphans := h.findOrphanedBlocks(header)
+	if len(orphans) == 0 {
+		return
+	}
+
+	utils.Warnf("[ handleReorg ] reorg detected at block: %v, orphaned blocks: %v", header.Number, orphans)
+
+	txHashes := h.rollbackBlocks(orphans)
+
+	// 通知下游作废孤块已发布的事件
+	h.Bus.Publish(eventbus.TopicBlockReorged, orphans[len(orphans)-1], &eventbus.BlockReorged{Blocks: orphans, TxHashes: txHashes})
+
+	// 从低到高重新处理, 当前区块由流水线接着写入
+	for i := len(orphans) - 1; i >= 0; i-- {
+		if orphans[i] == header.Number.Int64() {
+			continue
+		}
+
+		reprocess(orphans[i])
+	}
+}
+
+// 返回需要回滚的区块号, 从高到低排列
+// 只在确认深度内往回查找, 超过确认深度的区块视为最终数据
+func (h *Handler) findOrphanedBlocks(header *types.Header) []int64 {
+	var orphans []int64
+
+	num := header.Number.Int64()
+
+	// 同高度已处理过但hash不一致, 说明该高度也被替换了
+	stored, err := service_block.GetBlockProceeded(num, h.DB)
+	if err == nil && stored.Hash != header.Hash().String() {
+		orphans = append(orphans, num)
+	}
+
+	minBlock := num - int64(config.ConfirmationBlockNum)
+	parentHash := header.ParentHash.String()
+
+	for num--; num >= minBlock; num-- {
+		stored, err := service_block.GetBlockProceeded(num, h.DB)
+		if err != nil {
+			break // 没有处理过, 无从比较
+		}
+
+		if stored.Hash == parentHash {
+			break // 找到共同祖先
+		}
+
+		if stored.Status == schema.BLOCK_STATUS_CONFIRMED {
+			utils.Errorf("[ findOrphanedBlocks ] confirmed block: %v is reorged, check ConfirmationBlockNum", num)
+			break
+		}
+
+		orphans = append(orphans, num)
+
+		canonical, err := h.Client.HeaderByNumber(context.Background(), big.NewInt(num))
+		if err != nil {
+			utils.Warnf("[ findOrphanedBlocks ] HeaderByNumber(%v) err: %v", num, err)
+			break
+		}
+		parentHash = canonical.ParentHash.String()
+	}
+
+	return orphans
+}
+
+// 删除孤块产生的 swap, transfer, 流动性事件和pair, 减去计入全局趋势的数据,
+// 然后用剩余数据重建受影响的k线柱子、pair trade info、池子状态与定价图
+// 返回被删除的 swap 所在的交易
+func (h *Handler) rollbackBlocks(blocks []int64) []string {
+	pairs := make(map[string]bool)
+	minSlots := make(map[klineSlot]bool)
+	hourSlots := make(map[klineSlot]bool)
+	daySlots := make(map[klineSlot]bool)
+
+	var txHashes []string
+	seenTx := make(map[string]bool)
+
+	fromBlock := uint64(blocks[len(blocks)-1])
+
+	for _, num := range blocks {
+		blockNo := uint64(num)
+
+		stored, err := service_block.GetBlockProceeded(num, h.DB)
+		if err != nil {
+			utils.Warnf("[ rollbackBlocks ] GetBlockProceeded(%v) err: %v", num, err)
+		}
+
+		swaps, err := service_swap.GetSwapsByBlock(blockNo, h.DB)
+		if err != nil {
+			utils.Warnf("[ rollbackBlocks ] GetSwapsByBlock(%v) err: %v", num, err)
+		}
+
+		for _, swap := range swaps {
+			pairs[swap.PairAddr] = true
+
+			if !seenTx[swap.TxHash] {
+				seenTx[swap.TxHash] = true
+				txHashes = append(txHashes, swap.TxHash)
+			}
+
+			// 入库前的 swapTime 为本地时区, 计算k线key时保持一致
+			t := swap.SwapTime.Local()
+			minStart := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), 0, 0, time.Local)
+			hourStart := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, time.Local)
+			dayStart := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+
+			minSlots[klineSlot{swap.PairAddr, minStart.Unix()}] = true
+			hourSlots[klineSlot{swap.PairAddr, hourStart.Unix()}] = true
+			daySlots[klineSlot{swap.PairAddr, dayStart.Unix()}] = true
+		}
+
+		service_swap.DeleteSwapsByBlock(blockNo, h.DB)
+		transfer.DeleteTransfersByBlock(blockNo, h.DB)
+		liquidity.DeleteLiquidityEventsByBlock(blockNo, h.DB)
+		nativeprice.DeleteByBlock(num, h.DB)
+
+		deleted := pair.RollbackPairsByBlock(blockNo, h.DB)
+		h.removePairsFromMap(deleted)
+		for _, key := range deleted {
+			h.Prices.RestorePair(key, nil)
+		}
+
+		if stored != nil {
+			rollbackGlobalTrend(stored, len(deleted), h.DB)
+		}
+
+		service_block.SetUnProceeded(num, h.DB)
+	}
+
+	h.rebuildKlineSlots(minSlots, kline.TIMEFRAME_1MIN)
+	h.rebuildKlineSlots(hourSlots, kline.TIMEFRAME_1HOUR)
+	h.rebuildKlineSlots(daySlots, kline.TIMEFRAME_1DAY)
+
+	for key := range pairs {
+		updatePairTradeInfo(key, h.DB, h.Service)
+		h.restorePairState(key, fromBlock)
+	}
+
+	utils.Infof("[ rollbackBlocks ] rollback finished. blocks: %v, affected pairs: %v", blocks, len(pairs))
+
+	return txHashes
+}
+
+// 用回滚后 pair 最近的一笔 swap 恢复定价图的边、池子状态和 token 价格
+func (h *Handler) restorePairState(key string, fromBlock uint64) {
+	latest, err := service_swap.GetLatestSwapByPair(key, h.DB)
+	if err != nil {
+		utils.Warnf("[ restorePairState ] GetLatestSwapByPair(%v) err: %v", key, err)
+		return
+	}
+
+	h.Prices.RestorePair(key, latest)
+
+	var state *schema.InfoOnPoolState
+	if latest != nil && latest.SqrtPriceX96 != "" {
+		state = &schema.InfoOnPoolState{
+			SqrtPriceX96:     latest.SqrtPriceX96,
+			ActiveLiquidity:  latest.Liquidity,
+			CurrentTick:      latest.Tick,
+			PoolStateBlockNo: latest.BlockNo,
+		}
+	}
+	pair.RestorePoolState(key, state, fromBlock, h.DB)
+
+	if latest != nil && latest.PriceInUsdDecimal.Rat().Sign() > 0 {
+		updateTokenInfo(latest.MainToken, latest.PriceInUsdDecimal, h.DB, h.Service)
+	}
+}
+
+func (h *Handler) rebuildKlineSlots(slots map[klineSlot]bool, timeframe string) {
+	kline_Lock.Lock()
+	defer kline_Lock.Unlock()
+
+	for slot := range slots {
+		start := time.Unix(slot.unix, 0)
+
+		var end time.Time
+		switch timeframe {
+		case kline.TIMEFRAME_1MIN:
+			end = start.Add(time.Minute)
+		case kline.TIMEFRAME_1HOUR:
+			end = start.Add(time.Hour)
+		default:
+			end = start.AddDate(0, 0, 1)
+		}
+
+		swaps, err := service_swap.GetSwapsByPairAndTime(slot.pair, start, end, h.DB)
+		if err != nil {
+			utils.Warnf("[ rebuildKlineSlots ] GetSwapsByPairAndTime err: %v, pair: %v", err, slot.pair)
+			continue
+		}
+
+		h.Klines.RebuildSlot(timeframe, slot.pair, start, swaps, h.DB)
+	}
+}
+
+func (h *Handler) removePairsFromMap(pairs []string) {
+	if len(pairs) == 0 {
+		return
+	}
+
+	handler_Lock.Lock()
+	defer handler_Lock.Unlock()
+
+	for _, key := range pairs {
+		delete(h.Pairs, key)
+		delete(h.SwapContracts, key)
+	}
+}
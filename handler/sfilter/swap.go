@@ -6,7 +6,10 @@ import (
 	"math/big"
 	"sfilter/config"
 	"sfilter/schema"
+	"sfilter/services/bulk"
+	"sfilter/services/chain"
 	"sfilter/services/pair"
+	"sfilter/services/pricing"
 	service_swap "sfilter/services/swap"
 	"sfilter/services/token"
 	"sfilter/utils"
@@ -17,42 +20,27 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 )
 
-func HandleSwapAndKline(block *schema.Block, mongodb *mongo.Client) []*schema.Swap {
+// k线在区块写入时统一更新, 见 persistBlock
+// usd 价格依赖定价图, 在区块按顺序写入时计算, 见 PriceSwaps
+func HandleSwap(block *schema.Block, mongodb *mongo.Client, svc *chain.Service) []*schema.Swap {
 	var swaps []*schema.Swap
 
 	for _, tx := range block.Transactions {
 		if len(tx.Receipt.Logs) > 0 {
 			for _, _log := range tx.Receipt.Logs {
-				if len(_log.Topics) > 0 {
-					_type := checkSwapEvent(_log.Topics)
-
-					// 发现有swap交易
-					if _type > 0 {
-						// log.Printf("[ Swap_handle ] swap tx now. type: %v, tx: %v\n\n", _type, tx.OriginTx.Hash())
-
-						swap := newSwapStruct(block, _log, tx)
-						if swap == nil {
-							// new有错误, continue掉
-							continue
-						}
-						swap.SwapType = _type
-
-						if _type == schema.SWAP_EVENT_UNISWAPV2_LIKE {
-							updateUniV2Swap(swap, _log, mongodb)
-						} else if _type == schema.SWAP_EVENT_UNISWAPV3_LIKE {
-							updateUniV3Swap(swap, _log, mongodb)
-						}
-
-						updateUsdInfo(swap, mongodb)
-
-						UpdateKlineBySwap(swap, mongodb)
-
-						updateBlockInfo(block, swap)
+				decoder, ok := getSwapDecoder(_log)
+				if !ok {
+					continue
+				}
 
-						swaps = append(swaps, swap)
-					}
+				// 发现有swap交易, 由对应dex的解析器生成swap
+				swap := decoder.DecodeSwap(block, tx, _log, mongodb, svc)
+				if swap == nil {
+					// 解析有错误, continue掉
+					continue
 				}
 
+				swaps = append(swaps, swap)
 			}
 		}
 	}
@@ -60,6 +48,17 @@ func HandleSwapAndKline(block *schema.Block, mongodb *mongo.Client) []*schema.Sw
 	return swaps
 }
 
+// 按顺序更新定价图并计算 usd 价格, 每笔swap先更新兑换比例再计算
+// 在写入协程中执行, 定价图只包含本区块及之前的成交
+func PriceSwaps(block *schema.Block, swaps []*schema.Swap, mongodb *mongo.Client, prices *pricing.Graph) {
+	for _, swap := range swaps {
+		prices.UpdateBySwap(swap)
+		updateUsdInfo(swap, mongodb, prices)
+
+		updateBlockInfo(block, swap)
+	}
+}
+
 func updateBlockInfo(blk *schema.Block, swap *schema.Swap) {
 	blk.TxNums++
 	blk.VolumeByUsd += swap.VolumeInUsd
@@ -178,6 +177,11 @@ func creatTokenTransferMap(transfers []*schema.Transfer, swapContracts map[strin
 	ttm := make(tokensTransferMap)
 
 	for _, _transfer := range transfers {
+		// 原生币转账不参与 trader 判断, 见上面第2点说明
+		if _transfer.Category != schema.TRANSFER_CATEGORY_ERC20 {
+			continue
+		}
+
 		_, ok := ttm[_transfer.TxHash]
 		if !ok { // 先初始化 tokenTransfer
 			tt := make(tokenTransfer)
@@ -257,16 +261,17 @@ func creatTokenTransferMap(transfers []*schema.Transfer, swapContracts map[strin
 	return ttm
 }
 
-func UpsertSwapToDB(swaps []*schema.Swap, swapContracts map[string]bool, transfers []*schema.Transfer, mongodb *mongo.Client) {
-	handler_Lock.Lock()
-	defer handler_Lock.Unlock()
+func UpsertSwapToDB(swaps []*schema.Swap, swapContracts map[string]bool, transfers []*schema.Transfer, b *bulk.Batch) {
+	handler_Lock.RLock()
+	defer handler_Lock.RUnlock()
 
 	ttm := creatTokenTransferMap(transfers, swapContracts)
 
 	for _, _swap := range swaps {
 		updateTrader(_swap, ttm)
-		service_swap.SaveSwapTx(_swap, mongodb)
 	}
+
+	service_swap.AddSwapsToBatch(swaps, b)
 }
 
 func updateTrader(swap *schema.Swap, ttm tokensTransferMap) {
@@ -396,41 +401,49 @@ else if sell:
 
 // }
 
-func UpdateKlineBySwap(swap *schema.Swap, mongodb *mongo.Client) {
-	// 数据为最近一周才update kline
-	if time.Since(swap.SwapTime).Seconds() < config.SecondsForOneWeek {
-		UpdateKlines(swap, mongodb)
-	}
-}
-
 // 更新 volume 的usd value
 // 更新 price 的法币价格
-func updateUsdInfo(swap *schema.Swap, mongodb *mongo.Client) {
+func updateUsdInfo(swap *schema.Swap, mongodb *mongo.Client, prices *pricing.Graph) {
 	// 找到quoteToken, 更新 VolumeInUsd.
-	// 如果quoteToken为eth, 则乘以区块中eth价格; 如果为u, 直接加; 其他情况为0
+	// 如果quoteToken为eth, 则乘以区块中eth价格; 如果为u, 直接加; 其他情况通过定价图多跳换算
 	quoteToken := swap.Token1
 	if swap.MainToken == swap.Token1 {
 		quoteToken = swap.Token0
 	}
 
+	swap.PriceHops = 0
+	swap.PriceUpdatedAt = swap.SwapTime
+
 	if utils.CheckExistString(quoteToken, config.QuoteUsdCoinList) {
-		swap.PriceInUsd = swap.Price
+		swap.PriceInUsdDecimal = swap.PriceDecimal
 	} else if utils.CheckExistString(quoteToken, config.QuoteEthCoinList) {
-		swap.PriceInUsd = swap.Price * swap.CurrentEthPrice
+		swap.PriceInUsdDecimal = swap.PriceDecimal.Mul(utils.NewDecimalFromFloat(swap.CurrentEthPrice))
+	} else if quote, ok := prices.PriceInUsd(quoteToken, swap.CurrentEthPrice, swap.SwapTime); ok {
+		swap.PriceInUsdDecimal = swap.PriceDecimal.Mul(quote.PriceInUsd)
+		swap.PriceHops = quote.Hops
+		if quote.UpdatedAt.Before(swap.PriceUpdatedAt) {
+			swap.PriceUpdatedAt = quote.UpdatedAt
+		}
 	} else {
+		swap.PriceUpdatedAt = time.Time{} // 价格来源未知
 		// 从token中取, 还取不到, 那就尴尬一笑
 		_token, err := token.GetTokenInfo(swap.MainToken, mongodb)
 		if err == nil {
-			swap.PriceInUsd = _token.PriceInUsd
+			swap.PriceInUsdDecimal = _token.PriceInUsdDecimal
+			if swap.PriceInUsdDecimal.IsZero() { // 老数据没有精确值
+				swap.PriceInUsdDecimal = utils.NewDecimalFromFloat(_token.PriceInUsd)
+			}
 		} else {
 			// utils.Errorf("[ updateUsdInfo ] Temp error! get price in usd error in swap! token: %v", swap.MainToken)
-			swap.PriceInUsd = 0 // 应该报错
+			swap.PriceInUsdDecimal = "0" // 应该报错
 		}
 	}
+	swap.PriceInUsd = swap.PriceInUsdDecimal.Float64()
 	swap.VolumeInUsd = swap.AmountOfMainToken * swap.PriceInUsd
 }
 
-func newSwapStruct(block *schema.Block, _log *types.Log, tx *schema.Transaction) *schema.Swap {
+// pairAddr 一般为log的地址, 多币池等情况下为虚拟pair地址
+func newSwapStruct(block *schema.Block, _log *types.Log, tx *schema.Transaction, pairAddr string, svc *chain.Service) *schema.Swap {
 	swap := schema.Swap{
 		BlockNo:  _log.BlockNumber,
 		TxHash:   _log.TxHash.String(),
@@ -438,7 +451,7 @@ func newSwapStruct(block *schema.Block, _log *types.Log, tx *schema.Transaction)
 
 		LogNumInHash: len(tx.Receipt.Logs),
 
-		PairAddr: _log.Address.String(),
+		PairAddr: pairAddr,
 
 		GasPrice: tx.Receipt.EffectiveGasPrice.String(),
 
@@ -460,7 +473,7 @@ func newSwapStruct(block *schema.Block, _log *types.Log, tx *schema.Transaction)
 	}
 
 	// 获取 token0, token1
-	pair, err := pair.GetPairInfoForRead(swap.PairAddr)
+	pair, err := pair.GetPairInfo(swap.PairAddr, svc)
 	if err == nil {
 		swap.Token0 = pair.Token0
 		swap.Token1 = pair.Token1
@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"sfilter/config"
+	"sfilter/schema"
+	"sfilter/services/rpcpool"
+	"sfilter/utils"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// 节点不支持 debug_traceBlockByHash 时置为 true, 之后不再请求
+var traceUnsupported atomic.Bool
+
+type txTraceResult struct {
+	TxHash common.Hash       `json:"txHash"` // 老版本 geth 没有该字段, 此时按顺序对应
+	Result *schema.CallFrame `json:"result"`
+	Error  string            `json:"error"`
+}
+
+// 通过 callTracer 获取区块内所有交易的调用树, 用于解析内部的原生币转账
+// 节点不支持时只标记状态, 不影响区块的其他处理; 其他错误返回, 由上层重试整个区块
+func traceBlock(client *rpcpool.Pool, blk *schema.Block) error {
+	if !config.TraceInternalTransfers {
+		blk.InternalTraceStatus = schema.INTERNAL_TRACE_DISABLED
+		return nil
+	}
+
+	if traceUnsupported.Load() {
+		blk.InternalTraceStatus = schema.INTERNAL_TRACE_UNAVAILABLE
+		return nil
+	}
+
+	traces, err := traceBlockCalls(client, blk.Block)
+	if err != nil {
+		if !isMethodNotFound(err) {
+			return err
+		}
+
+		utils.Warnf("[ traceBlock ] debug_traceBlockByHash not supported, internal transfers will not be recorded. err: %v", err)
+		traceUnsupported.Store(true)
+
+		blk.InternalTraceStatus = schema.INTERNAL_TRACE_UNAVAILABLE
+		return nil
+	}
+
+	blk.Traces = traces
+	blk.InternalTraceStatus = schema.INTERNAL_TRACE_OK
+	return nil
+}
+
+func traceBlockCalls(client *rpcpool.Pool, block *types.Block) (map[common.Hash]*schema.CallFrame, error) {
+	var results []txTraceResult
+	var err error
+
+	args := map[string]interface{}{"tracer": "callTracer"}
+	for i := 0; i < config.ReceiptRetryTimes; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(config.ReceiptCallTimeout)*time.Second)
+		err = client.CallContext(ctx, &results, "debug_traceBlockByHash", block.Hash(), args)
+		cancel()
+
+		if err == nil || isMethodNotFound(err) {
+			break
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	txs := block.Transactions()
+	traces := make(map[common.Hash]*schema.CallFrame, len(results))
+
+	for i, result := range results {
+		if result.Result == nil || result.Error != "" {
+			continue
+		}
+
+		txHash := result.TxHash
+		if txHash == (common.Hash{}) && i < len(txs) {
+			txHash = txs[i].Hash()
+		}
+
+		if txHash != (common.Hash{}) {
+			traces[txHash] = result.Result
+		}
+	}
+
+	return traces, nil
+}
@@ -2,12 +2,15 @@ package handler
 
 import (
 	"fmt"
-	"math"
 	"math/big"
+	"sfilter/config"
 	"sfilter/schema"
+	"sfilter/services/bulk"
 	"sfilter/services/chain"
+	"sfilter/services/pricing"
 	"sfilter/services/token"
 	"sfilter/services/transfer"
+	"sfilter/utils"
 	"strings"
 	"time"
 
@@ -16,14 +19,17 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 )
 
-func HandleTransfer(block *schema.Block, mongodb *mongo.Client) []*schema.Transfer {
+func HandleTransfer(block *schema.Block, mongodb *mongo.Client, svc *chain.Service) []*schema.Transfer {
 	var transferSlices []*schema.Transfer
 
 	for _, tx := range block.Transactions {
+		// 原生币转账没有 Transfer 事件, 单独处理
+		transferSlices = append(transferSlices, parseNativeTransfers(block, tx)...)
+
 		if len(tx.Receipt.Logs) > 0 {
 			for _, _log := range tx.Receipt.Logs {
 				if len(_log.Topics) > 0 {
-					transfer := parseTransferEvent(block, _log)
+					transfer := parseTransferEvent(block, _log, svc)
 					if transfer != nil {
 						// 保存到slice, 方便到时候修改在保存
 						transferSlices = append(transferSlices, transfer)
@@ -37,47 +43,70 @@ func HandleTransfer(block *schema.Block, mongodb *mongo.Client) []*schema.Transf
 	return transferSlices
 }
 
-func UpsertTransferToDB(transfers []*schema.Transfer, swaps []*schema.Swap, mongodb *mongo.Client) {
-	// 本区块内有过交易的 token 的 price 保存
-	mainTokenPriceMap := make(map[string]float64)
-
+func UpsertTransferToDB(transfers []*schema.Transfer, swaps []*schema.Swap, b *bulk.Batch) {
 	// 记录本区块内所有的txHash
 	txhashMap := make(map[string]bool)
-
-	// 先获取本区块交易中的价格
 	for _, _swap := range swaps {
-		mainTokenPriceMap[_swap.MainToken] = _swap.PriceInUsd
 		txhashMap[_swap.TxHash] = true
 	}
 
 	for _, _transfer := range transfers {
-		// 更新 usd value
-		// 如果本区块的swap有交易过, 则直接update
-		price, ok := mainTokenPriceMap[_transfer.Token]
-		if ok && price > 0 {
-			_transfer.TransferValueInUsd = _transfer.Amount * price
-		} else {
-			// 否则调用链上价格数据update
-			_token, err := token.GetTokenInfo(_transfer.Token, mongodb)
-			if err == nil {
-				_transfer.TransferValueInUsd = _transfer.Amount * _token.PriceInUsd
-			}
-		}
-
 		// 更新是否是swap类型的transfer
 		_transfer.TransferType = schema.TRANSFER_EVENT_TRANSFER
 
 		// 如果该hash里面也有swap交易, 直接认为是swap类型
-		_, ok = txhashMap[_transfer.TxHash]
+		_, ok := txhashMap[_transfer.TxHash]
 		if ok {
 			_transfer.TransferType = schema.TRANSFER_EVENT_SWAP
 		}
+	}
+
+	transfer.AddTransfersToBatch(transfers, b)
+}
+
+// 更新 usd value, 原生币在解析时已经按区块eth价格计算
+// ethPrice 为区块的原生币价格, 本区块没有交易过的 token 通过定价图计算
+// 依赖 PriceSwaps 的结果, 在写入协程中执行
+func PriceTransfers(transfers []*schema.Transfer, swaps []*schema.Swap, prices *pricing.Graph, ethPrice float64, mongodb *mongo.Client) {
+	// 本区块内有过交易的 token 的 price 保存
+	mainTokenPriceMap := make(map[string]*schema.Swap)
+	for _, _swap := range swaps {
+		mainTokenPriceMap[_swap.MainToken] = _swap
+	}
+
+	for _, _transfer := range transfers {
+		if _transfer.Category == schema.TRANSFER_CATEGORY_ERC20 {
+			updateTransferUsdValue(_transfer, mainTokenPriceMap, prices, ethPrice, mongodb)
+		}
+	}
+}
+
+func updateTransferUsdValue(_transfer *schema.Transfer, mainTokenPriceMap map[string]*schema.Swap, prices *pricing.Graph, ethPrice float64, mongodb *mongo.Client) {
+	// 如果本区块的swap有交易过, 则直接update
+	_swap, ok := mainTokenPriceMap[_transfer.Token]
+	if ok && _swap.PriceInUsd > 0 {
+		_transfer.TransferValueInUsd = _transfer.Amount * _swap.PriceInUsd
+		_transfer.PriceHops = _swap.PriceHops
+		_transfer.PriceUpdatedAt = _swap.PriceUpdatedAt
+		return
+	}
 
-		transfer.SaveTransferEvent(_transfer, mongodb)
+	// 其次通过定价图换算
+	if quote, ok := prices.PriceInUsd(_transfer.Token, ethPrice, _transfer.Timestamp); ok {
+		_transfer.TransferValueInUsd = _transfer.Amount * quote.PriceInUsd.Float64()
+		_transfer.PriceHops = quote.Hops
+		_transfer.PriceUpdatedAt = quote.UpdatedAt
+		return
+	}
+
+	// 否则调用链上价格数据update
+	_token, err := token.GetTokenInfo(_transfer.Token, mongodb)
+	if err == nil {
+		_transfer.TransferValueInUsd = _transfer.Amount * _token.PriceInUsd
 	}
 }
 
-func parseTransferEvent(block *schema.Block, l *types.Log) *schema.Transfer {
+func parseTransferEvent(block *schema.Block, l *types.Log, svc *chain.Service) *schema.Transfer {
 	var transfer *schema.Transfer
 
 	if len(l.Topics) == 3 && strings.EqualFold(l.Topics[0].String(), "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef") {
@@ -97,21 +126,12 @@ func parseTransferEvent(block *schema.Block, l *types.Log) *schema.Transfer {
 		}
 
 		// 获取token
-		token, err := chain.GetTokenInfoForRead(transfer.Token)
+		token, err := svc.GetTokenInfo(transfer.Token)
 		if err == nil {
 			transfer.TokenSymbol = token.Symbol
 
-			if token.Decimal <= 9 { // 没到丢精度的程度
-				amount, _ := transfer.AmountBigInt.Float64()
-				transfer.Amount = amount / math.Pow10(int(token.Decimal))
-			} else {
-				// 如果某个token少于1e9, 那可以当0看了
-				tmpBig := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(token.Decimal)-9), nil)
-				tmpBig = tmpBig.Div(transfer.AmountBigInt, tmpBig)
-
-				amount, _ := tmpBig.Float64()
-				transfer.Amount = amount / 1e9
-			}
+			transfer.AmountDecimal = utils.TokenAmountToDecimal(transfer.AmountBigInt, token.Decimal)
+			transfer.Amount = transfer.AmountDecimal.Float64()
 		}
 
 		transfer.LogIndexWithTx = fmt.Sprintf("%s_%d", transfer.TxHash, transfer.Position)
@@ -119,3 +139,82 @@ func parseTransferEvent(block *schema.Block, l *types.Log) *schema.Transfer {
 
 	return transfer
 }
+
+// 解析一笔交易中的原生币转账: 交易本身携带的 value, 以及 trace 中内部调用的 value
+// 失败的交易不产生转账
+func parseNativeTransfers(block *schema.Block, tx *schema.Transaction) []*schema.Transfer {
+	var transfers []*schema.Transfer
+
+	if tx.Receipt.Status != types.ReceiptStatusSuccessful {
+		return transfers
+	}
+
+	txHash := tx.OriginTx.Hash()
+	if tx.OriginTx.Value().Sign() > 0 && tx.OriginTx.To() != nil {
+		transfer := newNativeTransfer(block, tx, getTxSender(tx), tx.OriginTx.To().String(), tx.OriginTx.Value())
+		transfer.Category = schema.TRANSFER_CATEGORY_NATIVE
+		transfer.LogIndexWithTx = fmt.Sprintf("%s_native", txHash.String())
+
+		transfers = append(transfers, transfer)
+	}
+
+	// 顶层调用即为交易本身, 已在上面处理, 只解析子调用
+	if trace, ok := block.Traces[txHash]; ok {
+		index := 0
+		for _, call := range trace.Calls {
+			transfers = appendInternalTransfers(transfers, block, tx, call, &index)
+		}
+	}
+
+	return transfers
+}
+
+func appendInternalTransfers(transfers []*schema.Transfer, block *schema.Block, tx *schema.Transaction, call *schema.CallFrame, index *int) []*schema.Transfer {
+	// 回滚的调用, 其子调用也一并无效
+	if call.Error != "" {
+		return transfers
+	}
+
+	// delegatecall 与 staticcall 不会转移原生币
+	if call.Value != nil && call.Value.ToInt().Sign() > 0 && call.Type != "DELEGATECALL" && call.Type != "STATICCALL" {
+		from := common.HexToAddress(call.From).String()
+		to := common.HexToAddress(call.To).String()
+
+		transfer := newNativeTransfer(block, tx, from, to, call.Value.ToInt())
+		transfer.Category = schema.TRANSFER_CATEGORY_INTERNAL
+		transfer.LogIndexWithTx = fmt.Sprintf("%s_internal_%d", tx.OriginTx.Hash().String(), *index)
+		*index++
+
+		transfers = append(transfers, transfer)
+	}
+
+	for _, sub := range call.Calls {
+		transfers = appendInternalTransfers(transfers, block, tx, sub, index)
+	}
+
+	return transfers
+}
+
+func newNativeTransfer(block *schema.Block, tx *schema.Transaction, from, to string, value *big.Int) *schema.Transfer {
+	transfer := &schema.Transfer{
+		Token:       config.NATIVE_TOKEN_ADDRESS,
+		TokenSymbol: config.NATIVE_TOKEN_SYMBOL,
+
+		From: from,
+		To:   to,
+
+		AmountBigInt:  value,
+		AmountDecimal: utils.TokenAmountToDecimal(value, 18),
+
+		BlockNo:  block.BlockNo,
+		TxHash:   tx.OriginTx.Hash().String(),
+		Position: tx.Receipt.TransactionIndex,
+
+		Timestamp: time.Unix(int64(block.Block.Time()), 0),
+	}
+
+	transfer.Amount = transfer.AmountDecimal.Float64()
+	transfer.TransferValueInUsd = transfer.Amount * block.EthPrice
+
+	return transfer
+}
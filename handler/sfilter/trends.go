@@ -19,6 +19,18 @@ func HandleGlobalInfo(block *schema.Block, mongodb *mongo.Client) {
 	updateGlobalInfo(block, mongodb)
 }
 
+// 减去孤块计入1min趋势线的数据, 与 HandleGlobalInfo 一样只处理最近一周的区块
+// 1h/24h 的统计之后会用趋势线重新计算
+func rollbackGlobalTrend(bps *schema.BlockProceeded, pairCreatedNum int, mongodb *mongo.Client) {
+	_time := time.Unix(bps.BlockTime, 0)
+	if time.Since(_time).Seconds() >= config.SecondsForOneWeek {
+		return
+	}
+
+	key := fmt.Sprintf("%v_%v_%v", _time.Day(), _time.Hour(), _time.Minute())
+	global.RollbackTrend(key, bps.TxNums, pairCreatedNum, bps.VolumeByUsd, mongodb)
+}
+
 func updateGlobalInfo1MinTrends(block *schema.Block, mongodb *mongo.Client) {
 	_time := block.BlockTime
 	key := fmt.Sprintf("%v_%v_%v", _time.Day(), _time.Hour(), _time.Minute())
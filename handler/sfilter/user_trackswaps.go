@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"sfilter/config"
 	"sfilter/schema"
+	"sfilter/services/eventbus"
 	userModels "sfilter/user/models"
 	"sfilter/utils"
 
@@ -68,7 +69,7 @@ func CheckAndDeleteUserSwapsUpLimit(mongodb *mongo.Client) {
 
 // 将用户匹配出的swap信息逐一写入db
 // 这里要注意某些用户的容量并及时告警
-func HandleUserTrackSwaps(block *schema.Block, mongodb *mongo.Client, swaps []*schema.Swap) {
+func HandleUserTrackSwaps(mongodb *mongo.Client, swaps []*schema.Swap) {
 	if len(trackAddressMap) <= 0 {
 		utils.Warnf("[ HandleUserTrackSwaps ] len trackAddressMap is zero? no init?")
 		return
@@ -80,6 +81,30 @@ func HandleUserTrackSwaps(block *schema.Block, mongodb *mongo.Client, swaps []*s
 
 }
 
+// 订阅 swap 写入事件, 不再占用区块处理的时间
+func (h *Handler) SubscribeUserTrackSwaps() {
+	h.Bus.Subscribe(eventbus.TopicSwapsPersisted, func(ev *eventbus.Event) {
+		var data eventbus.SwapsPersisted
+		if err := ev.Decode(&data); err != nil {
+			utils.Warnf("[ SubscribeUserTrackSwaps ] decode event failed: %v, block: %v", err, ev.BlockNo)
+			return
+		}
+
+		HandleUserTrackSwaps(h.DB, data.Swaps)
+	})
+
+	// 孤块中的交易已经不存在, 删除对应的跟踪记录
+	h.Bus.Subscribe(eventbus.TopicBlockReorged, func(ev *eventbus.Event) {
+		var data eventbus.BlockReorged
+		if err := ev.Decode(&data); err != nil {
+			utils.Warnf("[ SubscribeUserTrackSwaps ] decode event failed: %v, block: %v", err, ev.BlockNo)
+			return
+		}
+
+		swap.DeleteTrackSwapsByTx(data.TxHashes, h.DB)
+	})
+}
+
 // 处理某一个用户的swaps校验、保存等
 func doHandleOneSwap(_swap *schema.Swap, mongodb *mongo.Client) {
 	_, traderOk := trackAddressMap[_swap.Trader]
@@ -0,0 +1,156 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"sfilter/config"
+	"sfilter/schema"
+	"sfilter/services/backtest"
+	"sfilter/services/chain"
+	"sfilter/utils"
+)
+
+var ErrUnknownStrategy = errors.New("unknown backtest strategy")
+
+// 回测各个热点策略, 策略参数可以通过 json 覆盖, 如 {"stopLoss":0.4,"retrace":0.2}
+func RunBacktest(svc *chain.Service, name, params string, cfg *backtest.Config, outDir string) (*backtest.Report, error) {
+	set := &Setting{
+		DB:     svc.DB().Client(),
+		Chain:  svc,
+		Config: config.DefaultWiserConfig,
+	}
+
+	s, err := newBacktestStrategy(set, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != "" {
+		if err := json.Unmarshal([]byte(params), s); err != nil {
+			return nil, fmt.Errorf("parse params error: %v", err)
+		}
+	}
+
+	report, err := backtest.NewEngine(cfg, set.DB).Run(s)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(outDir, 0755); err != nil {
+		return nil, err
+	}
+
+	if err := report.WriteJSON(filepath.Join(outDir, name+"_report.json")); err != nil {
+		return nil, err
+	}
+
+	if err := report.WriteTradesCSV(filepath.Join(outDir, name+"_trades.csv")); err != nil {
+		return nil, err
+	}
+
+	if err := report.WriteEquityCSV(filepath.Join(outDir, name+"_equity.csv")); err != nil {
+		return nil, err
+	}
+
+	utils.Infof("[ RunBacktest ] %v finished. trades: %v, win rate: %.2f%%, return: %.2f%%, max drawdown: %.2f%%", name, report.TradeNum, report.WinRate*100, report.TotalReturn*100, report.MaxDrawdown*100)
+
+	return report, nil
+}
+
+func newBacktestStrategy(set *Setting, name string) (backtest.Strategy, error) {
+	switch name {
+	case "hb":
+		return NewHBBacktest(set), nil
+	case "hs":
+		return NewHSBacktest(), nil
+	case "hn":
+		return NewHNBacktest(), nil
+	case "hsp":
+		return NewHSPBacktest(), nil
+	}
+
+	return nil, ErrUnknownStrategy
+}
+
+// 通用卖点: 亏 StopLoss 或回调 Retrace, 回调定义为涨破成本 RetraceTrigger 后的下跌
+// TakeProfit 为赚到几倍卖出, 0 表示不使用
+type ExitRule struct {
+	StopLoss       float64 `json:"stopLoss"`
+	RetraceTrigger float64 `json:"retraceTrigger"`
+	Retrace        float64 `json:"retrace"`
+	TakeProfit     float64 `json:"takeProfit"`
+}
+
+func (r *ExitRule) check(pos *backtest.Position, price float64) (string, bool) {
+	return r.exit(pos.BuyPrice, pos.HighPrice, price)
+}
+
+// highPrice 为买入后的最高价
+func (r *ExitRule) exit(buyPrice, highPrice, price float64) (string, bool) {
+	if price <= 0 {
+		return "", false
+	}
+
+	if r.TakeProfit > 0 && price >= buyPrice*r.TakeProfit {
+		return fmt.Sprintf("赚%.0f倍卖", r.TakeProfit), true
+	}
+
+	if r.StopLoss > 0 && price <= buyPrice*(1-r.StopLoss) {
+		return fmt.Sprintf("跌破%.0f%%", r.StopLoss*100), true
+	}
+
+	isRetrace := highPrice >= buyPrice*(1+r.RetraceTrigger)
+	if r.Retrace > 0 && isRetrace && price <= highPrice*(1-r.Retrace) {
+		return fmt.Sprintf("回调%.0f%%", r.Retrace*100), true
+	}
+
+	return "", false
+}
+
+func checkExits(ctx *backtest.Context, rule *ExitRule) {
+	for _, pos := range ctx.Positions() {
+		if reason, ok := rule.check(pos, ctx.Price(pos.Pair)); ok {
+			ctx.Sell(pos.Pair, reason)
+		}
+	}
+}
+
+// 非通缩币、坑人币
+func isNormalPair(ctx *backtest.Context, pair string) bool {
+	info, ok := ctx.PairInfo(pair)
+	return ok && info.MainTokenHackType <= schema.PAIR_MAINTOKEN_HACK_TYPE_NORMAL
+}
+
+// 某个时间点的价格, 取该时间之前最后一根柱子的收盘价
+func priceAt(bars []schema.KLine, t time.Time) float64 {
+	var price float64
+	for _, k := range bars {
+		if k.UnixTime >= t.Unix() {
+			break
+		}
+		price = k.PriceInUsd
+	}
+
+	return price
+}
+
+// [start, end) 内的 tx 数与usd交易量
+func sumBars(bars []schema.KLine, start, end time.Time) (int, float64) {
+	var tx int
+	var volume float64
+	for _, k := range bars {
+		if k.UnixTime < start.Unix() || k.UnixTime >= end.Unix() {
+			continue
+		}
+
+		tx += k.TxNum
+		volume += k.VolumeInUsd
+	}
+
+	return tx, volume
+}
@@ -18,11 +18,12 @@ V. 针对每个用户做计算、梳理、统计
 
 	一次买卖是指买入某个token，然后多久之后完全卖出的一个完整动作；对于某个 token, 他可能重复买卖多次(如做波段)
 	1. 先找出某个token的第一笔买入动作，作为起始点
-	2. 找出买入动作后的第一笔卖出动作或者transfer out 动作
-	3. 在这两个动作之间的买入，都作为一次买入，累加成本
-	4. 第一笔卖出动作时候，记录此时的价格作为卖出价格计算盈利
-	5. 如上1-4步作为一次完整买卖记录下来
-	6. 如果到当前时间，还一次未卖过, 该买卖不参与计算
+	2. 之后的买入与转入都加入持仓, 每一笔作为一个 lot 记录数量与成本, 买入的 gas 计入成本
+	3. 每一笔卖出按 fifo 或平均成本(DealCostMethod)取出对应的 lot, 卖出所得减去成本与卖出 gas 即为已实现盈亏
+	4. transfer 转出不算卖出, 取出的 lot 随 token 转给对方地址, 对方转入时沿用该成本
+	5. 持仓降到接近0时(DealDustRatio)，作为一次完整买卖记录下来
+	6. 到当前时间仍有持仓的, 按当前价格计算未实现盈亏; 亏损或盈利足够多时直接结算, 否则只统计已卖出的部分
+	7. 统计是增量的: 每个地址记录统计到的区块(WiserCursor), 未结束的deal与持仓的lots保存在 DealPosition, 下次只统计新的交易
 */
 
 /*
@@ -32,18 +33,15 @@ frontrun: 基本同区块进出，或者5个block以内的进出，对一次买
 */
 
 /*
-		todo:
-	 	1. 争议点: 是否要把多笔卖出统计, 而不是第一笔就算卖出
-		2. 通缩币是否有问题
+	todo:
+	1. 通缩币是否有问题
 */
 
 import (
 	"errors"
 	"fmt"
 	"math/big"
-	"sfilter/config"
 	"sfilter/schema"
-	"sfilter/services/chain"
 	"sfilter/services/pair"
 	"sfilter/services/token"
 	"sfilter/services/wiser"
@@ -52,28 +50,93 @@ import (
 	"time"
 )
 
-func (w *Wiser) InspectBiDeals(account string) int {
-	utils.Infof("[ InspectBiDeals ] account: %v", account)
+// 某个地址某个token的统计状态, 增量统计时从 DealPosition 恢复, 统计完再保存回去
+type dealState struct {
+	deal   *schema.BiDeal // 当前未结束的一笔买卖, nil 表示还没有买入
+	broken bool           // 当前deal有卖出没有价格, 无法统计盈亏, 结束时丢弃
+	book   *lotBook
+
+	finished int // 本次统计中结束的deal数, 包括没有保存的
+}
+
+// 增量统计某个地址的买卖, 只读取该地址 cursor 之后到 toBlock(含)的交易
+// 没有 cursor 的地址从最近 LatestSwapSeconds 开始统计
+// remark 为 true 时没有新交易的持仓也按当前价格重新结算
+func (w *Wiser) InspectBiDeals(account string, toBlock uint64, remark bool) int {
+	utils.Infof("[ InspectBiDeals ] account: %v, toBlock: %v", account, toBlock)
 
 	dealCount := 0
 
-	trades, err := w.GetAccountTrades(account)
+	cursor, err := wiser.GetWiserCursor(account, w.set.DB)
+	if err != nil {
+		utils.Errorf("[ InspectBiDeals ] GetWiserCursor err: %v", err)
+		return dealCount
+	}
+
+	var fromBlock uint64
+	if cursor != nil {
+		fromBlock = cursor.BlockNo
+	}
+	if fromBlock >= toBlock && !remark {
+		return dealCount
+	}
+
+	positions, err := wiser.GetDealPositions(account, w.set.DB)
 	if err != nil {
-		utils.Errorf("[ InspectBiDeals ] GetAccountTrades err: %v", err)
+		utils.Errorf("[ InspectBiDeals ] GetDealPositions err: %v", err)
 		return dealCount
 	}
 
+	held := make(map[string]*schema.DealPosition)
+	for _, pos := range positions {
+		held[pos.Token] = pos
+	}
+
+	var trades schema.AccountTrades
+	if fromBlock < toBlock {
+		trades, err = w.GetAccountTrades(account, fromBlock, toBlock, held)
+		if err != nil {
+			utils.Errorf("[ InspectBiDeals ] GetAccountTrades err: %v", err)
+			return dealCount
+		}
+
+		// 转入的成本依赖转出方记录的 lots, 转出方还没统计到时先统计到之前的区块, 下一轮再继续
+		// 这样转入成本与地址的统计顺序无关
+		if waitBlock := w.waitMovedLots(account, trades); waitBlock > 0 {
+			utils.Debugf("[ InspectBiDeals ] account %v waits for moved lots at block %v", account, waitBlock)
+			toBlock = waitBlock - 1
+			trades = tradesBefore(trades, waitBlock)
+		}
+	}
+
+	if remark {
+		for token := range held {
+			if _, ok := trades[token]; !ok {
+				if trades == nil {
+					trades = make(schema.AccountTrades)
+				}
+				trades[token] = nil
+			}
+		}
+	}
+
 	for token, atts := range trades {
 		tokenObj, ok := w.set.Tokens[token]
 		if !ok {
-			tokenObj, err = chain.GetTokenInfo(token, w.set.DB)
+			tokenObj, err = w.set.Chain.GetTokenInfo(token)
 			if err != nil {
 				utils.Errorf("[ InspectBiDeals ] failed to get token: %v, err: %v", token, err)
 				continue
 			}
 		}
 
-		deals := w.getDealsFromAtts(atts, account, tokenObj)
+		pos, ok := held[token]
+		if !ok {
+			pos = &schema.DealPosition{Account: account, Token: token}
+		}
+
+		st := w.loadDealState(pos)
+		deals := w.applyAtts(st, atts, account, tokenObj)
 
 		utils.Debugf("[ InspectBiDeals ] token %v has %v deals.", token, len(deals))
 		dealCount += len(deals)
@@ -82,214 +145,425 @@ func (w *Wiser) InspectBiDeals(account string) int {
 			wiser.SaveDeal(deal, w.set.DB)
 		}
 
+		w.saveDealState(st, pos, deals)
+	}
+
+	if fromBlock < toBlock {
+		wiser.SaveWiserCursor(&schema.WiserCursor{Account: account, BlockNo: toBlock}, w.set.DB)
 	}
 
 	return dealCount
 }
 
-func (w *Wiser) getDealsFromAtts(atts []schema.AccountTokenTrade, account string, tokenObj *schema.Token) []*schema.BiDeal {
+func (w *Wiser) loadDealState(pos *schema.DealPosition) *dealState {
+	method := w.set.Config.DealCostMethod
+	if pos.Deal != nil && pos.Deal.CostMethod != "" {
+		method = pos.Deal.CostMethod // 未结束的deal沿用开始时的方式
+	}
+
+	st := &dealState{
+		deal:   pos.Deal,
+		broken: pos.Broken,
+		book:   newLotBook(method),
+	}
+	st.book.load(pos.Lots)
+
+	return st
+}
+
+// 保存统计后的持仓; 未结束的deal按当前价格结算, 符合条件的先作为一笔deal保存, 之后有新交易时更新
+func (w *Wiser) saveDealState(st *dealState, pos *schema.DealPosition, closed []*schema.BiDeal) {
+	// 之前结算保存的deal已经结束, 结束时的key不同则删除旧记录
+	if st.finished > 0 && pos.SavedKey != "" {
+		if len(closed) == 0 || closed[0].SellTxHashWithToken != pos.SavedKey {
+			wiser.DeleteDeal(pos.SavedKey, w.set.DB)
+		}
+		pos.SavedKey = ""
+	}
+
+	var savedKey string
+	if st.deal != nil && !st.broken {
+		snapshot := *st.deal
+		if w.settleOpenDeal(&snapshot, st.book) {
+			wiser.SaveDeal(&snapshot, w.set.DB)
+			savedKey = snapshot.SellTxHashWithToken
+		}
+	}
+
+	if pos.SavedKey != "" && pos.SavedKey != savedKey {
+		wiser.DeleteDeal(pos.SavedKey, w.set.DB)
+	}
+	pos.SavedKey = savedKey
+
+	pos.Deal = st.deal
+	pos.Broken = st.broken
+	pos.Lots = st.book.dealLots()
+
+	if pos.Deal == nil && len(pos.Lots) == 0 {
+		wiser.DeleteDealPosition(pos.Account, pos.Token, w.set.DB)
+		return
+	}
+
+	wiser.SaveDealPosition(pos, w.set.DB)
+}
+
+// 按时间顺序统计新的交易, 返回本次结束的deal
+func (w *Wiser) applyAtts(st *dealState, atts []schema.AccountTokenTrade, account string, tokenObj *schema.Token) []*schema.BiDeal {
 	var deals []*schema.BiDeal
-	var deal *schema.BiDeal
-	var startOver = true // 是否重新计算一笔买卖, 初始化为true
+
+	book := st.book
+
+	// 与swap同一笔交易的transfer是swap本身的转账, 不重复计算
+	swapTxs := getSwapTxs(atts)
+
+	// 持仓清空, 结束当前deal; 没有卖出过的(如全部转走)不算一笔买卖
+	finish := func() {
+		if st.deal != nil && !st.broken && st.deal.SellCount > 0 && w.settleDeal(st.deal) {
+			deals = append(deals, st.deal)
+		}
+
+		st.deal = nil
+		st.broken = false
+		st.finished++
+		book.reset()
+	}
 
 	for _, att := range atts { // atts 按时间从旧到新排列
-		if att.Type == schema.TRADE_TYPE_SWAP && att.Direction == schema.DIRECTION_BUY_OR_ADD && att.Amount > 0 { // 买入且为swap交易
-			if startOver {
-				deal = &schema.BiDeal{
-					Account:   account,
-					Token:     tokenObj.Address,
-					TokenName: tokenObj.Name,
-
-					BuyTxHash:   att.TxHash,
-					BuyBlockNo:  att.BlockNo,
-					BuyPair:     att.Pair,
-					BuyPairType: att.PairType,
-
-					BuyValue:  att.USDValue,
-					BuyAmount: att.Amount,
-					BuyPrice:  att.PriceInUSD,
-				}
+		if att.Amount <= 0 || att.Type == schema.TRADE_TYPE_TRANSFER && swapTxs[att.TxHash] {
+			continue
+		}
 
-				deal.BuyPairAge = 60 * 60 * 24 * 181 // 初始化为1年
-				_pair, ok := w.set.Pairs[deal.BuyPair]
-				if !ok {
-					var err error
-					_pair, err = pair.GetPairInfoForRead(deal.BuyPair)
-					if err == nil {
-						ok = true
-					} else {
-						utils.Errorf("[ getDealsFromAtts ] GetPair %v failed: %v", deal.BuyPair, err)
-					}
-				}
-				if ok {
-					bornAt := _pair.FirstAddPoolTime
-					if !bornAt.IsZero() { // 存在
-						deal.BuyPairAge = int(att.TradeTime.Sub(bornAt).Seconds())
-					}
+		if att.Direction == schema.DIRECTION_BUY_OR_ADD {
+			var cost float64
 
-					deal.BuyPairHackType = _pair.MainTokenHackType
+			if att.Type == schema.TRADE_TYPE_SWAP {
+				if st.deal == nil {
+					st.deal = w.newDeal(att, account, tokenObj, book.method)
 				}
 
-				startOver = false // 说明已经有买入动作了, 是一笔新的deal
+				// 买入的 gas 计入成本
+				cost = att.USDValue + att.GasInUsd
+				book.add(att.Amount, cost)
+
+				st.deal.GasCost += att.GasInUsd
 			} else {
-				// 将成本等累加
-				deal.BuyValue += att.USDValue
-				deal.BuyAmount += att.Amount
+				var ok bool
+				if cost, ok = w.addTransferInLots(att, tokenObj.Address, account, book); !ok {
+					continue
+				}
 
-				if deal.BuyAmount > 0 {
-					deal.BuyPrice = deal.BuyValue / deal.BuyAmount
+				if st.deal == nil {
+					continue // 还没有买入, 转入的作为已有持仓
 				}
+				st.deal.TransferInAmount += att.Amount
 			}
+
+			st.deal.BuyValue += cost
+			st.deal.BuyAmount += att.Amount
+			st.deal.BuyPrice = st.deal.BuyValue / st.deal.BuyAmount
+
+			continue
 		}
 
-		if att.Direction == schema.DIRECTION_SELL_OR_DECREASE { // 只要是sell或者send out
-			if deal == nil { // 说明周期内还没有买, 是卖的交易
-				continue
-			}
-			if startOver { // 说明此时统计周期内第2+笔卖的交易, 累加卖的数据
-				// todo
-				continue
-			}
+		if att.Direction != schema.DIRECTION_SELL_OR_DECREASE {
+			continue
+		}
 
-			if att.Type == schema.TRADE_TYPE_TRANSFER {
-				continue // 先不计算, 当前的transfer的金额不准确, 很多数据是回溯的
+		deal := st.deal
+		if att.Type == schema.TRADE_TYPE_SWAP {
+			_, cost, matched := book.take(att.Amount)
+			if deal == nil || matched <= 0 {
+				continue // 卖出的是统计周期之前买入的, 没有成本, 不统计
 			}
+
 			if att.PriceInUSD <= 0 {
 				utils.Warnf("[ InspectBiDeals ] att.PriceInUSD is 0! token: %v", tokenObj.Address)
-				// 此时本应该算是一笔完整交易, 但是由于没有价格, 因此不统计与保存
-				// 重置状态并开始下一笔deal统计
-				startOver = true
-				continue
+				// 没有价格无法统计盈亏, 该deal结束时不保存
+				st.broken = true
 			}
 
-			// 保存sell信息
+			// 超出持仓的部分没有成本, 按比例只统计有成本的部分
+			value := att.USDValue * matched / att.Amount
+
+			deal.SellCount++
+			deal.SellAmount += matched
+			deal.SellValue += value
+			deal.CostBasis += cost
+			deal.GasCost += att.GasInUsd
+			deal.Earn += value - cost - att.GasInUsd
+
+			// 记录最近一笔sell, 由于可能出现一笔tx里面卖出多笔token, 所以需要 sellTxHash_Token 为key
 			deal.SellTxHashWithToken = fmt.Sprintf("%v_%v", att.TxHash, tokenObj.Address)
 			deal.SellBlockNo = att.BlockNo
 			deal.SellTime = att.TradeTime
 			deal.SellPair = att.Pair
-
-			deal.SellPrice = att.PriceInUSD
-
-			// 如下两个仅记录当前第一笔sell的时候的金额
-			// 但统计的时候会以全部卖出处理
-			deal.SellAmount = att.Amount
-			deal.SellValue = att.USDValue
 			deal.SellType = att.Type
-
-			// 结算deal数据
-			if deal.BuyPrice > 0 {
-				deal.EarnChange = (deal.SellPrice - deal.BuyPrice) / deal.BuyPrice
-			} else {
-				deal.EarnChange = 0 // 0表示异常, 正常情况, 不可能刚好相等
+		} else {
+			// 转出不算卖出, 成本随token转给对方
+			taken, _, matched := book.take(att.Amount)
+			if len(taken) > 0 && att.Counterparty != "" {
+				wiser.SaveMovedLots(&schema.MovedLots{
+					TxHash:  att.TxHash,
+					Token:   tokenObj.Address,
+					From:    account,
+					To:      att.Counterparty,
+					BlockNo: att.BlockNo,
+					Lots:    toDealLots(taken),
+				}, w.set.DB)
 			}
 
-			// 盈亏只算卖出那一笔订单的amount
-			deal.Earn = deal.SellAmount * deal.BuyPrice * deal.EarnChange
-
-			if deal.SellBlockNo < deal.BuyBlockNo {
-				utils.Errorf("[ InspectBiDeals ] wrong block! buyBlock: %v, sellBlock: %v", deal.BuyBlockNo, deal.SellBlockNo)
+			if deal == nil {
 				continue
 			}
+			deal.TransferOutAmount += matched
+		}
 
-			// 定义bideal类型
-			deal.HoldBlocks = deal.SellBlockNo - deal.BuyBlockNo
-			deal.BiDealType = w.getDealType(deal.HoldBlocks)
-			deal.BuyType = w.getBuyType(deal.BuyPairAge)
+		if book.amount() <= deal.BuyAmount*w.set.Config.DealDustRatio {
+			finish()
+		}
+	}
 
-			// 统计截止至今的亏损率(假设未卖)
-			if deal.BuyPrice > 0 {
-				uptoTodayUsdValue, err := w.getDealSellValueWithAllBuyAmount(deal)
-				if err == nil {
-					deal.UptoTodayYield = (uptoTodayUsdValue - deal.BuyValue) / deal.BuyValue
-				}
-			}
+	return deals
+}
 
-			if w.set.Config.DebugMode {
-				wiser.PrintDeal(deal)
-			}
+func (w *Wiser) newDeal(att schema.AccountTokenTrade, account string, tokenObj *schema.Token, costMethod string) *schema.BiDeal {
+	deal := &schema.BiDeal{
+		Account:   account,
+		Token:     tokenObj.Address,
+		TokenName: tokenObj.Name,
+
+		BuyTxHash:   att.TxHash,
+		BuyBlockNo:  att.BlockNo,
+		BuyPair:     att.Pair,
+		BuyPairType: att.PairType,
+
+		CostMethod: costMethod,
+	}
+
+	deal.BuyPairAge = 60 * 60 * 24 * 181 // 初始化为1年
+	_pair, ok := w.set.Pairs[deal.BuyPair]
+	if !ok {
+		var err error
+		_pair, err = pair.GetPairInfo(deal.BuyPair, w.set.Chain)
+		if err == nil {
+			ok = true
+		} else {
+			utils.Errorf("[ getDealsFromAtts ] GetPair %v failed: %v", deal.BuyPair, err)
+		}
+	}
+	if ok {
+		bornAt := _pair.FirstAddPoolTime
+		if !bornAt.IsZero() { // 存在
+			deal.BuyPairAge = int(att.TradeTime.Sub(bornAt).Seconds())
+		}
+
+		deal.BuyPairHackType = _pair.MainTokenHackType
+	}
+
+	return deal
+}
 
-			deals = append(deals, deal) // 是一笔完整买卖, 存入deals数组
+// 转入的token加入持仓, 成本优先沿用转出地址的成本, 否则按转入时的价值
+// 没有价值的转入不计入持仓, 之后卖出超出持仓的部分不统计
+func (w *Wiser) addTransferInLots(att schema.AccountTokenTrade, token, account string, book *lotBook) (float64, bool) {
+	moved, err := wiser.GetMovedLots(att.TxHash, token, account, w.set.DB)
+	if err != nil {
+		utils.Errorf("[ addTransferInLots ] GetMovedLots err: %v, tx: %v", err, att.TxHash)
+	}
 
-			startOver = true // 标记一笔deal完成, 重新开始新的deal统计
+	if moved != nil {
+		var cost float64
+		for _, l := range moved.Lots {
+			book.add(l.Amount, l.Cost)
+			cost += l.Cost
 		}
+
+		return cost, true
+	}
+
+	if att.USDValue <= 0 {
+		return 0, false
 	}
 
-	// 是否要判断下最后一笔的交易状态，如果还持有当前币种未卖出, 则确认是否已跌到接近归零
-	// 如果是, 则计算当前亏钱状态; 否则不记录, 作为无效deal
-	if !startOver {
-		// 说明此时还持有token, 计算当前盈利状态以确认是否作为有效deal记录
-		validDeal := w.updateDealWithLatestData(deal, tokenObj)
-		if validDeal {
-			deals = append(deals, deal)
+	book.add(att.Amount, att.USDValue)
+
+	return att.USDValue, true
+}
+
+func getSwapTxs(atts []schema.AccountTokenTrade) map[string]bool {
+	swapTxs := make(map[string]bool)
+	for _, att := range atts {
+		if att.Type == schema.TRADE_TYPE_SWAP {
+			swapTxs[att.TxHash] = true
 		}
 	}
 
-	return deals
+	return swapTxs
+}
+
+// 找出最早一笔需要等待转出方的转入, 返回其区块, 不需要等待时返回 0
+// 转出方在本轮统计中, 还没有统计到该区块且没有记录 lots 时需要等待
+func (w *Wiser) waitMovedLots(account string, trades schema.AccountTrades) uint64 {
+	var waitBlock uint64
+
+	for token, atts := range trades {
+		swapTxs := getSwapTxs(atts)
+
+		for _, att := range atts {
+			if waitBlock > 0 && att.BlockNo >= waitBlock {
+				break
+			}
+
+			if att.Type != schema.TRADE_TYPE_TRANSFER || att.Direction != schema.DIRECTION_BUY_OR_ADD ||
+				att.Amount <= 0 || swapTxs[att.TxHash] || att.Counterparty == "" {
+				continue
+			}
+
+			if w.senderPending(att, token, account) {
+				waitBlock = att.BlockNo
+				break
+			}
+		}
+	}
+
+	if waitBlock > 0 && !w.deferAccount(account, waitBlock) {
+		utils.Warnf("[ waitMovedLots ] account %v waits too long at block %v, use transfer value as cost", account, waitBlock)
+		return 0
+	}
+
+	return waitBlock
 }
 
-// 确认当前deal持有从未卖出的token, 根据当前价格计算盈利
-func (w *Wiser) updateDealWithLatestData(deal *schema.BiDeal, tokenObj *schema.Token) bool {
-	// deal无有效买入
-	if deal == nil || deal.BuyValue <= 0 || deal.BuyAmount <= 0 || deal.Account == "" {
+func (w *Wiser) senderPending(att schema.AccountTokenTrade, token, account string) bool {
+	if !w.round[att.Counterparty] {
+		return false // 转出方不统计, 不会有记录
+	}
+
+	moved, err := wiser.GetMovedLots(att.TxHash, token, account, w.set.DB)
+	if err != nil || moved != nil {
 		return false
 	}
 
-	if deal.SellTxHashWithToken != "" {
-		// deal 已经收尾, 已经有了卖的交易
+	cursor, err := wiser.GetWiserCursor(att.Counterparty, w.set.DB)
+	if err != nil {
+		utils.Errorf("[ senderPending ] GetWiserCursor err: %v", err)
 		return false
 	}
 
-	// utils.Debugf("[ updateDealWithLatestData ] found one deal to be updated. deal: %v", deal)
+	return cursor == nil || cursor.BlockNo < att.BlockNo
+}
 
-	// 继续往后走, 说明该用户对该token有买入动作但无有效的卖出动作(transfer无效)
-	deal.SellType = schema.TRADE_TYPE_LIQUIDATION
-	// 新建一个唯一键值, 用BuyTx+Token+Account代替
-	deal.SellTxHashWithToken = fmt.Sprintf("%v_%v_%v", deal.BuyTxHash, deal.Token, deal.Account)
+// 只保留 block 之前的交易
+func tradesBefore(trades schema.AccountTrades, block uint64) schema.AccountTrades {
+	result := make(schema.AccountTrades)
+	for token, atts := range trades {
+		var kept []schema.AccountTokenTrade
+		for _, att := range atts {
+			if att.BlockNo < block {
+				kept = append(kept, att)
+			}
+		}
 
-	// 将当前的amount卖到当前的pair里面, 作为earn数据并统计更新
-	// 可能出现实际情况错误, 比如transfer出去或者其他没被统计到的情况, 但先忽略
-	// 这里也不获取余额, 直接模拟将所有buy的卖出去
-	sellUsdValue, err := w.getDealSellValueWithAllBuyAmount(deal)
-	if err != nil || sellUsdValue < 0 {
-		utils.Warnf("[ updateDealWithLatestData ] get deal amout value failed. err: %v, sellUsdValue: %v", err, sellUsdValue)
-		return false
+		if len(kept) > 0 {
+			result[token] = kept
+		}
 	}
 
-	// 此时需要判断其sell value是否已亏损过多或盈利很高, 如果不是, 也不结算
-	if sellUsdValue > deal.BuyValue*w.set.Config.DealDefiniteLoss &&
-		sellUsdValue < deal.BuyValue*w.set.Config.DealDefiniteWin {
-		utils.Debugf("[ updateDealWithLatestData ] sellUsdValue not win or loss too much. current value: %v, BuyValue: %v", sellUsdValue, deal.BuyValue)
+	return result
+}
+
+// 统计一笔已结束的deal
+func (w *Wiser) settleDeal(deal *schema.BiDeal) bool {
+	if deal.SellBlockNo < deal.BuyBlockNo {
+		utils.Errorf("[ InspectBiDeals ] wrong block! buyBlock: %v, sellBlock: %v", deal.BuyBlockNo, deal.SellBlockNo)
 		return false
 	}
 
-	deal.SellAmount = deal.BuyAmount
-	deal.SellValue = sellUsdValue
-	deal.SellPrice = sellUsdValue / deal.SellAmount
+	if deal.SellAmount > 0 {
+		deal.SellPrice = deal.SellValue / deal.SellAmount
+	}
 
-	deal.Earn = deal.SellValue - deal.BuyValue
-	deal.EarnChange = deal.Earn / deal.BuyValue
+	if deal.CostBasis > 0 {
+		deal.EarnChange = deal.Earn / deal.CostBasis
+	} else {
+		deal.EarnChange = 0 // 0表示异常, 正常情况, 不可能刚好相等
+	}
 
 	// 定义bideal类型
-	deal.SellBlockNo, _ = chain.GetCurrentBlockNumber()
-	deal.SellTime = time.Now()
-
 	deal.HoldBlocks = deal.SellBlockNo - deal.BuyBlockNo
 	deal.BiDealType = w.getDealType(deal.HoldBlocks)
-
 	deal.BuyType = w.getBuyType(deal.BuyPairAge)
 
-	deal.UptoTodayYield = (sellUsdValue - deal.BuyValue) / deal.BuyValue
+	// 统计截止至今的亏损率(假设全部未卖)
+	if deal.BuyValue > 0 && deal.BuyAmount > 0 {
+		uptoTodayUsdValue, err := w.getDealSellValue(deal, deal.BuyAmount)
+		if err == nil && uptoTodayUsdValue >= 0 {
+			deal.UptoTodayYield = (uptoTodayUsdValue - deal.BuyValue) / deal.BuyValue
+		}
+	}
+
+	if w.set.Config.DebugMode {
+		wiser.PrintDeal(deal)
+	}
 
 	return true
 }
 
-func (w *Wiser) getDealSellValueWithAllBuyAmount(deal *schema.BiDeal) (float64, error) {
+// 到当前时间仍持有的deal, 按当前价格计算剩余持仓的未实现盈亏
+// 剩余持仓亏损或盈利足够多时直接结算; 否则只有卖出过的才统计已实现的部分
+func (w *Wiser) settleOpenDeal(deal *schema.BiDeal, book *lotBook) bool {
+	if deal.BuyValue <= 0 || deal.BuyAmount <= 0 || deal.Account == "" {
+		return false
+	}
+
+	remaining := book.amount()
+	remainingCost := book.cost()
+	deal.HoldAmount = remaining
+
+	// 将剩余持仓卖到当前的pair里面, 可能出现实际情况错误, 比如没被统计到的transfer, 但先忽略
+	sellUsdValue, err := w.getDealSellValue(deal, remaining)
+	if err != nil || sellUsdValue < 0 {
+		utils.Warnf("[ settleOpenDeal ] get deal amout value failed. err: %v, sellUsdValue: %v", err, sellUsdValue)
+		return deal.SellCount > 0 && w.settleDeal(deal)
+	}
+
+	deal.UnrealizedPnl = sellUsdValue - remainingCost
+
+	if sellUsdValue > remainingCost*w.set.Config.DealDefiniteLoss &&
+		sellUsdValue < remainingCost*w.set.Config.DealDefiniteWin {
+		utils.Debugf("[ settleOpenDeal ] sellUsdValue not win or loss too much. current value: %v, cost: %v", sellUsdValue, remainingCost)
+		return deal.SellCount > 0 && w.settleDeal(deal)
+	}
+
+	// 强制结算剩余持仓
+	deal.SellType = schema.TRADE_TYPE_LIQUIDATION
+	if deal.SellCount == 0 {
+		// 新建一个唯一键值, 用BuyTx+Token+Account代替
+		deal.SellTxHashWithToken = fmt.Sprintf("%v_%v_%v", deal.BuyTxHash, deal.Token, deal.Account)
+	}
+
+	deal.SellAmount += remaining
+	deal.SellValue += sellUsdValue
+	deal.CostBasis += remainingCost
+	deal.Earn += sellUsdValue - remainingCost
+
+	deal.HoldAmount = 0
+	deal.UnrealizedPnl = 0
+
+	deal.SellBlockNo, _ = w.set.Chain.GetCurrentBlockNumber()
+	deal.SellTime = time.Now()
+
+	return w.settleDeal(deal)
+}
+
+// 按当前池子价格卖出 sellAmount 个token得到的usd
+func (w *Wiser) getDealSellValue(deal *schema.BiDeal, sellAmount float64) (float64, error) {
 	pairObj, ok := w.set.Pairs[deal.BuyPair]
 	if !ok {
 		var err error
-		pairObj, err = pair.GetPairInfoForRead(deal.BuyPair)
+		pairObj, err = pair.GetPairInfo(deal.BuyPair, w.set.Chain)
 		if err != nil {
-			utils.Errorf("[ updateDealWithLatestData ] find pair failed. pair: %v, err: %v", deal.BuyPair, err)
+			utils.Errorf("[ getDealSellValue ] find pair failed. pair: %v, err: %v", deal.BuyPair, err)
 			return 0, err
 		}
 	}
@@ -311,24 +585,29 @@ func (w *Wiser) getDealSellValueWithAllBuyAmount(deal *schema.BiDeal) (float64,
 	}
 	tokenExponent = new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimalIn)), nil)
 
-	buyAmountF := big.NewFloat(deal.BuyAmount)
+	buyAmountF := big.NewFloat(sellAmount)
 	buyAmountF = buyAmountF.Mul(buyAmountF, new(big.Float).SetInt(tokenExponent))
 	buyAmountBInt, _ := buyAmountF.Int(nil)
 
 	var amountOut *big.Int
 	var errRet error
 
-	if deal.BuyPairType == schema.SWAP_EVENT_UNISWAPV2_LIKE {
-		amountOut, errRet = chain.GetUniV2SwapAmountOut(deal.BuyPair, pairObj.Token0, deal.Token, buyAmountBInt)
+	pairType := deal.BuyPairType
+	if pairType == 0 {
+		pairType = pairObj.Type // 从db恢复的deal没有 BuyPairType
+	}
+
+	if pairType == schema.SWAP_EVENT_UNISWAPV2_LIKE {
+		amountOut, errRet = w.set.Chain.GetUniV2SwapAmountOut(deal.BuyPair, pairObj.Token0, deal.Token, buyAmountBInt)
 		if errRet != nil {
 			utils.Warnf("[ getDealAmountValueWithAmountIn ] GetUniV2SwapAmountOut failed: %v, pair: %v", errRet, deal.BuyPair)
 		}
-	} else if deal.BuyPairType == schema.SWAP_EVENT_UNISWAPV3_LIKE {
+	} else if pairType == schema.SWAP_EVENT_UNISWAPV3_LIKE {
 		var feeBig *big.Int
 
 		fee := pairObj.PairFee
 		if fee == 0 {
-			feeBig, errRet = chain.GetUniV3PairFee(pairObj.Address)
+			feeBig, errRet = w.set.Chain.GetUniV3PairFee(pairObj.Address)
 			if errRet != nil {
 				utils.Warnf("[ getDealAmountValueWithAmountIn ] GetUniV3PairFee failed. pair: %v, err: %v", pairObj.Address, errRet)
 				goto finish
@@ -337,13 +616,13 @@ func (w *Wiser) getDealSellValueWithAllBuyAmount(deal *schema.BiDeal) (float64,
 			fee = feeBig.Int64()
 		}
 
-		amountOut, errRet = chain.GetUniV3SwapAmountOut(deal.Token, tokenOut, big.NewInt(fee), buyAmountBInt)
+		amountOut, errRet = w.set.Chain.GetUniV3SwapAmountOut(deal.Token, tokenOut, big.NewInt(fee), buyAmountBInt)
 		if errRet != nil {
 			utils.Warnf("[ getDealAmountValueWithAmountIn ] GetUniV3SwapAmountOut failed: %v. pair: %v, in: %v, out: %v, fee: %v, amountIn: %v, amountOut: %v", errRet, deal.BuyPair, deal.Token, tokenOut, fee, buyAmountBInt, amountOut)
 		}
 	} else {
 		errRet = errors.New("wrong pair type")
-		utils.Errorf("[ getDealAmountValueWithAmountIn ] unknown pair type: %v", deal.BuyPairType)
+		utils.Errorf("[ getDealAmountValueWithAmountIn ] unknown pair type: %v", pairType)
 	}
 
 finish:
@@ -352,15 +631,16 @@ finish:
 	if errRet != nil {
 		// 存在错误, 判断下余额, 如果余额比买入时的value还小一定比例, 则作为其买入失败
 		liquidityVolume := pairObj.LiquidityInUsd
-		if liquidityVolume < deal.BuyValue*w.set.Config.DealDefiniteLoss {
-			utils.Infof("[ getDealAmountValueWithAmountIn ] liquidityVolume too less: %v, buyValue: %v", liquidityVolume, deal.BuyValue)
+		buyValue := deal.BuyPrice * sellAmount
+		if liquidityVolume < buyValue*w.set.Config.DealDefiniteLoss {
+			utils.Infof("[ getDealAmountValueWithAmountIn ] liquidityVolume too less: %v, buyValue: %v", liquidityVolume, buyValue)
 			sellUsdValue = liquidityVolume
 			errRet = nil
 		}
 	} else {
 		// 还需要将 tokenOut 的amountOut转成法币
 		var ethPrice float64
-		ethPrice, errRet = chain.GetBasicCoinPrice(nil, nil, config.BlockChain)
+		ethPrice, errRet = w.set.Chain.GetBasicCoinPrice(nil)
 		if errRet == nil {
 			sellUsdValue = utils.CalculateVolumeInUsd(tokenOut, new(big.Float).SetInt(amountOut), decimalOut, ethPrice)
 		}
@@ -383,55 +663,59 @@ finish:
 	return sellUsdValue, errRet
 }
 
-// 获取该用户最近一段时间的swap记录与transfer记录并按要求组装构造格式
-func (w *Wiser) GetAccountTrades(account string) (schema.AccountTrades, error) {
-	swapAtts, err1 := wiser.GetAccountSwaps(w.set.Config.LatestSwapSeconds, w.set.Config.DbBlockReadSize, account, w.set.DB)
+// 获取该用户 (fromBlock, toBlock] 的swap记录与transfer记录并按要求组装构造格式
+// fromBlock 为0时取最近 LatestSwapSeconds 的记录
+func (w *Wiser) GetAccountTrades(account string, fromBlock, toBlock uint64, held map[string]*schema.DealPosition) (schema.AccountTrades, error) {
+	swapAtts, err1 := wiser.GetAccountSwaps(w.set.Config.LatestSwapSeconds, fromBlock, toBlock, w.set.Config.DbBlockReadSize, account, w.set.DB)
 
-	transferAtts, err2 := wiser.GetAccountTransfers(w.set.Config.LatestSwapSeconds, w.set.Config.DbBlockReadSize, account, w.set.DB)
+	transferAtts, err2 := wiser.GetAccountTransfers(w.set.Config.LatestSwapSeconds, fromBlock, toBlock, w.set.Config.DbBlockReadSize, account, w.set.DB)
 
 	if err1 != nil || err2 != nil {
 		utils.Errorf("[  GetAccountTrades] get account by token error, err1: %v, err2: %v", err1, err2)
+		if err1 == nil {
+			err1 = err2
+		}
 		return nil, err1
 	}
 
 	// 组装对应的swaps和transfer, 按照时间排序
 	// 如果时间一样, 以swap优先排序(因为同一个区块中, swaps比transfer优先级高)
-	// 合并swap数组, transfer有swap没有的不管
+	// 合并swap数组, transfer有swap没有的不管, 除非之前已经有持仓
 	atts := make(schema.AccountTrades)
 	for token, trades := range swapAtts {
 		atts[token] = append(atts[token], trades...)
+	}
+	for token, transfers := range transferAtts {
+		if _, ok := atts[token]; !ok && held[token] == nil {
+			continue
+		}
+		atts[token] = append(atts[token], transfers...)
+	}
 
-		// 如果有transfer也合并
-		transfers, ok := transferAtts[token]
-		if ok {
-			atts[token] = append(atts[token], transfers...)
-
-			// 排序
-			sort.Slice(atts[token], func(i, j int) bool {
-				if atts[token][i].BlockNo == atts[token][j].BlockNo {
-					// 有些交易是同一个区块里面有买有卖(eg: frontrun), 因此需要处理
-					// 如果hash不一样, 按Position排序, 如果hash都一样, 则swap优先
-					// 如果一笔交易多笔swap, 会出现transfer排在多笔swap后边的情况, 但对我们统计应该无影响
-					if atts[token][i].BlockNo == atts[token][j].BlockNo {
-						// 同一笔交易, 防止有些合约不讲武德, transfer与swap事件触发顺序不一致, 因此以swap优先
-						if atts[token][i].TxHash == atts[token][j].TxHash {
-							// 如果类型也一样, 按position来, 如果类型不一样, 按swap优先来
-							if atts[token][i].Type == atts[token][j].Type {
-								return atts[token][i].Position < atts[token][j].Position
-							} else {
-								return atts[token][i].Type == schema.TRADE_TYPE_SWAP
-							}
-						} else {
-							// 如果不是同一个区块, 则按position排序即可
-							return atts[token][i].Position < atts[token][j].Position
-						}
+	for token := range atts {
+		// 排序
+		sort.SliceStable(atts[token], func(i, j int) bool {
+			if atts[token][i].BlockNo == atts[token][j].BlockNo {
+				// 有些交易是同一个区块里面有买有卖(eg: frontrun), 因此需要处理
+				// 如果hash不一样, 按Position排序, 如果hash都一样, 则swap优先
+				// 如果一笔交易多笔swap, 会出现transfer排在多笔swap后边的情况, 但对我们统计应该无影响
+				// 同一笔交易, 防止有些合约不讲武德, transfer与swap事件触发顺序不一致, 因此以swap优先
+				if atts[token][i].TxHash == atts[token][j].TxHash {
+					// 如果类型也一样, 按position来, 如果类型不一样, 按swap优先来
+					if atts[token][i].Type == atts[token][j].Type {
+						return atts[token][i].Position < atts[token][j].Position
+					} else {
+						return atts[token][i].Type == schema.TRADE_TYPE_SWAP
 					}
+				} else {
+					// 如果不是同一笔交易, 则按position排序即可
+					return atts[token][i].Position < atts[token][j].Position
 				}
+			}
 
-				// 默认按区块高度排序
-				return atts[token][i].BlockNo < atts[token][j].BlockNo
-			})
-		}
+			// 默认按区块高度排序
+			return atts[token][i].BlockNo < atts[token][j].BlockNo
+		})
 	}
 
 	// if w.set.Config.DebugMode {
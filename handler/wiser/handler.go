@@ -1,16 +1,23 @@
 package handler
 
+import (
+	"strings"
+
+	"sfilter/services/chain"
+	"sfilter/services/eventbus"
+	"sfilter/utils"
+)
+
 type Handler struct {
 	Wiser   *Wiser
-	Hbpair  *HBPair
-	Hspair  *HSPair
-	Hnpair  *HNPair
-	HspPair *HSPPair
+	Runtime *Runtime
 }
 
 // deal or wiser 表示分析deal和wiser, 任意一个开启均表示打开 wiser 服务
-func NewHandler(account, db string, debug bool, deal, wiser bool, hx string) *Handler {
-	set := NewSetting(account, db, debug)
+// hx 为逗号分隔的策略名, 如 "hb,hn", all 表示运行所有策略
+func NewHandler(svc *chain.Service, bus *eventbus.Bus, account string, debug bool, deal, wiser bool, hx string) *Handler {
+	set := NewSetting(svc, account, debug)
+	set.Bus = bus
 
 	hndl := &Handler{}
 
@@ -22,52 +29,41 @@ func NewHandler(account, db string, debug bool, deal, wiser bool, hx string) *Ha
 		}
 	}
 
-	if hx == "hb" {
-		hndl.Hbpair = &HBPair{
-			Set: set,
+	if names := parseStrategyNames(set, hx); len(names) > 0 {
+		rt, err := NewRuntime(set, names)
+		if err != nil {
+			utils.Fatalf("[ NewHandler ] NewRuntime failed: %v", err)
 		}
+		hndl.Runtime = rt
 	}
 
-	if hx == "hs" {
-		hndl.Hspair = &HSPair{
-			Set: set,
-		}
-	}
+	return hndl
+}
 
-	if hx == "hn" {
-		hndl.Hnpair = &HNPair{
-			Set: set,
-		}
+func parseStrategyNames(set *Setting, hx string) []string {
+	if hx == "all" {
+		return StrategyNames(set)
 	}
 
-	if hx == "hsp" {
-		hndl.HspPair = &HSPPair{
-			Set: set,
+	var names []string
+	for _, name := range strings.Split(hx, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
 		}
 	}
 
-	return hndl
+	return names
 }
 
 func (h *Handler) Run() {
+	if h.Runtime != nil {
+		h.Runtime.Start()
+	}
+
 	if h.Wiser != nil {
 		h.Wiser.set.doWiserPreparation()
 		h.Wiser.Run()
 	}
 
-	if h.Hbpair != nil {
-		h.Hbpair.Run()
-	}
-
-	if h.Hspair != nil {
-		h.Hspair.Run()
-	}
-
-	if h.Hnpair != nil {
-		h.Hnpair.Run()
-	}
-
-	if h.HspPair != nil {
-		h.HspPair.Run()
-	}
+	select {}
 }
@@ -35,6 +35,7 @@ import (
 	"math"
 	"sfilter/config"
 	"sfilter/schema"
+	"sfilter/services/backtest"
 	"sfilter/services/kline"
 	"sfilter/services/pair"
 	"sfilter/utils"
@@ -42,8 +43,6 @@ import (
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo/options"
-
-	"github.com/robfig/cron"
 )
 
 // hot pair
@@ -51,125 +50,96 @@ type HBPair struct {
 	Set       *Setting
 	traceTime time.Time
 
+	ExitRule
+
 	hpairs1d []*schema.Pair
 	hpairs4h []*schema.Pair
 	hpairs1h []*schema.Pair
 }
 
+func NewHBPair(set *Setting) *HBPair {
+	p := &HBPair{
+		Set:      set,
+		ExitRule: ExitRule{StopLoss: 0.5, RetraceTrigger: 0.5, Retrace: 0.3},
+	}
+	p.init()
+
+	return p
+}
+
 func (p *HBPair) init() {
 	loc, err := time.LoadLocation("Asia/Shanghai")
 	if err != nil {
 		panic(err)
 	}
 	time.Local = loc
-
-	// 将时间设置为 2024.02.23 0:0:00
-	p.traceTime = time.Date(2024, 2, 22, 0, 0, 0, 1, loc)
-	// p.traceTime = time.Now()
 }
 
-func (p *HBPair) Run() {
-	p.init()
-
-	{ // test
-		p.PolicyLogic()
-		return
-	}
-
-	c := cron.New()
-	spec := "0 0 16 * * *" // 定时每晚执行, 注意机器是utc+0时区
-	c.AddFunc(spec, func() {
-		p.PolicyLogic()
-	})
-	c.Start()
-
-	select {}
+func (p *HBPair) Name() string {
+	return "hb"
 }
 
-func (p *HBPair) PolicyLogic() {
-	// 找出符合当前买点的pair
-	p.HotPairSearch()
-
-	//针对符合的pair找出卖点, 并回测数据
-	// p.HotPairSell(p.hpairs1h)
-	// p.HotPairSell(p.hpairs4h)
-	p.HotPairSell(p.hpairs1d)
+// 每天东八区0点找买点, 每10min检查卖点
+func (p *HBPair) Schedule() Schedule {
+	return Schedule{Buy: 24 * time.Hour, Sell: 10 * time.Minute, Offset: 16 * time.Hour}
 }
 
-func (p *HBPair) HotPairSell(_pairs []*schema.Pair) {
-	// p.printPairs(_pairs)
+// 找出符合当前买点的pair
+func (p *HBPair) BuySignals() []*schema.BiTrade {
+	p.traceTime = time.Now()
+	p.hpairs1h, p.hpairs4h, p.hpairs1d = nil, nil, nil
+
+	p.HotPairSearch()
+	p.printPairs(p.hpairs1d)
 
-	utils.Infof("[ HotPairSell ] buy time: %v", p.traceTime.Format("2006-01-02 15:04:05"))
+	var trades []*schema.BiTrade
+	for _, _pair := range p.hpairs1d {
+		trades = append(trades, &schema.BiTrade{
+			MainToken:     utils.GetMainToken(_pair.Token0, _pair.Token1),
+			PairAddress:   _pair.Address,
+			PairName:      _pair.PairName,
+			PairLiquidity: _pair.LiquidityInUsd,
+			PairAge:       time.Since(_pair.FirstAddPoolTime),
 
-	for _, _pair := range _pairs {
-		p.TraceBackOnePair(_pair)
+			BuyPrice:  _pair.ReservedFloat, // 检查日线时的最新价格
+			BuyReason: "日线放量",
+			TxNumIn1h: _pair.TxNumIn1h,
+		})
 	}
+
+	return trades
 }
 
 // 卖点: 亏50%或回调30%
 // 回调定义: 涨破成本50%后的下跌
-func (p *HBPair) TraceBackOnePair(_pair *schema.Pair) {
-	curPrice := _pair.ReservedFloat
-
-	// 取出从起始时间点到现在的分钟线, 取出价格
-	// 由于高开低收取的是 pair 相对价格, 不是usd, 因此直接取收盘价usd
+func (p *HBPair) SellSignals(trades []*schema.BiTrade) []*schema.BiTrade {
 	now := time.Now()
-	durationHours := now.Sub(p.traceTime).Hours()
-	klines1Min := kline.Get1MinKlineWithFullGenerated(_pair.Address, now, int(durationHours), p.Set.DB.Database(config.DatabaseName))
-	// utils.Debugf("[ TraceBackOnePair ] len for klines1Min: %v, curPrice: %v", len(klines1Min), curPrice)
-
-	// 时间从过去到现在排序
-	var isRetrace bool  // 是否属于回调阶段
-	var highest float64 // 回溯期间最高价格
-	for _, k1m := range klines1Min {
-		if k1m.PriceInUsd > highest {
-			highest = k1m.PriceInUsd
-		}
 
-		if !isRetrace {
-			// 判断价格是否超过成本的50%, 如果是, 则定义为回调阶段
-			if highest >= curPrice*1.5 {
-				isRetrace = true
+	var sold []*schema.BiTrade
+	for _, trade := range trades {
+		// 由于高开低收取的是 pair 相对价格, 不是usd, 因此直接取收盘价usd
+		klines1Min := kline.Get1MinKlineWithFullGenerated(trade.PairAddress, now, 1, p.Set.DB.Database(config.DatabaseName))
+
+		var price float64
+		for _, k1m := range klines1Min {
+			if k1m.UnixTime <= trade.BuyTime.Unix() || k1m.PriceInUsd <= 0 {
+				continue
 			}
-		}
 
-		// 亏50%或回调30% 定义为卖出
-		if (k1m.PriceInUsd <= curPrice*0.5) || (isRetrace && k1m.PriceInUsd <= highest*0.7) {
-			p.handleSellInfo(_pair, &k1m)
-			return
+			price = k1m.PriceInUsd
+			if price > trade.HighestPrice {
+				trade.HighestPrice = price
+			}
 		}
-	}
-
-	// 说明未卖掉, 表示持有
-	p.handleSellInfo(_pair, nil)
-}
 
-// 卖出时间点, 如果kline为nil, 表示一直持有未卖出
-func (p *HBPair) handleSellInfo(_pair *schema.Pair, kline *schema.KLine) {
-	buyPrice := _pair.ReservedFloat // 取出回溯时的价格
-	if buyPrice <= 0 {
-		utils.Warnf("[  handleSellInfo] error buyPrice is: %v", buyPrice)
-		return
-	}
-
-	var sellPrice float64
-	var sellTime time.Time
-
-	if kline != nil {
-		sellPrice = kline.PriceInUsd
-		sellTime = time.Unix(kline.UnixTime, 0)
-	} else {
-		sellPrice = _pair.PriceInUsd
-		sellTime = time.Now()
+		if reason, ok := p.exit(trade.BuyPrice, trade.HighestPrice, price); ok {
+			trade.SellPrice = price
+			trade.SellReason = reason
+			sold = append(sold, trade)
+		}
 	}
 
-	earn := (sellPrice - buyPrice) / buyPrice * 100
-
-	if kline != nil {
-		fmt.Printf("%v, %.1f%%, %v, %v\n", _pair.PairName, earn, _pair.Address, sellTime.Format("2006-01-02 15:04:05"))
-	} else {
-		fmt.Printf("%v, %.1f%%, %v, %v\n", _pair.PairName, earn, _pair.Address, "hold")
-	}
+	return sold
 }
 
 // 同时允许api调用, 略搓...
@@ -494,7 +464,7 @@ func (p *HBPair) checkPairHot1Day(_pair *schema.Pair) bool {
 
 func (p *HBPair) GetBasicPairs() []*schema.Pair {
 	// test
-	// pairT, _ := pair.GetPairInfoForRead("0x769f539486b31eF310125C44d7F405C6d470cD1f")
+	// pairT, _ := pair.GetPairInfo("0x769f539486b31eF310125C44d7F405C6d470cD1f", p.Set.Chain)
 	// var pairs []*schema.Pair
 	// pairs = append(pairs, pairT)
 	// return pairs
@@ -507,8 +477,11 @@ func (p *HBPair) GetBasicPairs() []*schema.Pair {
 	}
 
 	// 价格最小涨幅要求, 减少数据量
-	filter["priceChangeIn24h"] = bson.M{
-		"$gte": 0,
+	// 涨幅为 pair 表中的当前数据, 回测历史时间点时不使用
+	if time.Since(p.traceTime) < time.Hour {
+		filter["priceChangeIn24h"] = bson.M{
+			"$gte": 0,
+		}
 	}
 	// filter["$and"] = []bson.M{
 	// 	{"priceChangeIn24h": bson.M{"$gte": 0}},
@@ -516,7 +489,7 @@ func (p *HBPair) GetBasicPairs() []*schema.Pair {
 	// }
 
 	// pair创建时长要求
-	date := time.Now().Add(-time.Duration(p.Set.Config.MinPairCreatAge) * time.Second)
+	date := p.traceTime.Add(-time.Duration(p.Set.Config.MinPairCreatAge) * time.Second)
 	filter["firstAddPoolTime"] = bson.M{
 		"$lte": date,
 	}
@@ -527,7 +500,7 @@ func (p *HBPair) GetBasicPairs() []*schema.Pair {
 	}
 
 	// 最近1小时内必须更新过, 也就是必须有交易
-	date = time.Now().Add(-1 * time.Hour)
+	date = p.traceTime.Add(-1 * time.Hour)
 	filter["updatedAt"] = bson.M{
 		"$gte": date,
 	}
@@ -564,3 +537,46 @@ func checkExistPair(target *schema.Pair, pairs []*schema.Pair) bool {
 
 	return false
 }
+
+// 回测: 在起始时间按日线条件选出 pair 并买入, 按 ExitRule 卖出
+type HBBacktest struct {
+	set *Setting
+
+	ExitRule
+
+	pairs  []string
+	bought map[string]bool
+}
+
+func NewHBBacktest(set *Setting) *HBBacktest {
+	return &HBBacktest{
+		set:      set,
+		ExitRule: ExitRule{StopLoss: 0.5, RetraceTrigger: 0.5, Retrace: 0.3},
+		bought:   make(map[string]bool),
+	}
+}
+
+func (b *HBBacktest) Name() string {
+	return "hb"
+}
+
+func (b *HBBacktest) Pairs(start time.Time, _ []*backtest.Snapshot) []string {
+	p := &HBPair{
+		Set:       b.set,
+		traceTime: start,
+	}
+
+	_, _, b.pairs = p.HotPairSearch()
+	return b.pairs
+}
+
+func (b *HBBacktest) OnTick(ctx *backtest.Context) {
+	checkExits(ctx, &b.ExitRule)
+
+	// 每个 pair 只在起始时买入一次, 还没有成交的等到第一笔成交
+	for _, pair := range b.pairs {
+		if !b.bought[pair] && ctx.Buy(pair, "日线放量") {
+			b.bought[pair] = true
+		}
+	}
+}
@@ -4,14 +4,13 @@ import (
 	"fmt"
 	"sfilter/config"
 	"sfilter/schema"
-	"sfilter/services/chain"
+	"sfilter/services/backtest"
 	"sfilter/services/kline"
 	"sfilter/services/pair"
 	"sfilter/services/wiser"
 	"sfilter/utils"
 	"time"
 
-	"github.com/robfig/cron"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
@@ -39,36 +38,20 @@ type HNPair struct {
 	Set *Setting
 }
 
-func (p *HNPair) Run() {
-	// { // test
-	// 	p.PolicyLogic()
-	// 	return
-	// }
-
-	c := cron.New()
-
-	spec_min := "20 0/10 * * * *"
-	c.AddFunc(spec_min, func() {
-		p.GetHotNewPairSellSignal()
-	})
-
-	spec_hour := "30 0 * * * *"
-	c.AddFunc(spec_hour, func() {
-		p.GetHotNewPairBuySignal()
-	})
-
-	c.Start()
+func NewHNPair(set *Setting) *HNPair {
+	return &HNPair{Set: set}
+}
 
-	select {}
+func (p *HNPair) Name() string {
+	return "hn"
 }
 
-// for test
-func (p *HNPair) PolicyLogic() {
-	p.GetHotNewPairSellSignal() // 先检查卖的, 避免本次新加入的也被检查
-	p.GetHotNewPairBuySignal()
+// 每小时的整点检查买点, 每10min检查卖点
+func (p *HNPair) Schedule() Schedule {
+	return Schedule{Buy: time.Hour, Sell: 10 * time.Minute}
 }
 
-func (p *HNPair) GetHotNewPairBuySignal() {
+func (p *HNPair) BuySignals() []*schema.BiTrade {
 	pairs := p.FindHotNewPairs(5)
 
 	var buyPairs []*schema.Pair
@@ -82,29 +65,28 @@ func (p *HNPair) GetHotNewPairBuySignal() {
 		}
 	}
 
-	// 针对选择出来的程序, 执行买入动作
+	var trades []*schema.BiTrade
 	for _, _pair := range buyPairs {
-		trade := schema.BiTrade{
+		trades = append(trades, &schema.BiTrade{
+			MainToken:     utils.GetMainToken(_pair.Token0, _pair.Token1),
 			PairAddress:   _pair.Address,
 			PairName:      _pair.PairName,
 			PairLiquidity: _pair.LiquidityInUsd,
 			PairAge:       time.Since(_pair.FirstAddPoolTime),
 
 			BuyPrice:  _pair.PriceInUsd,
-			BuyTime:   time.Now(),
 			SortRank:  _pair.ReservedInt,
 			TxNumIn1h: _pair.TxNumIn1h,
-		}
-
-		p.buyTrade(&trade)
-
+		})
 	}
+
+	return trades
 }
 
 func (p *HNPair) checkPairValidity(_pair *schema.Pair) bool {
 	// 先检测是否为坑人币
 	if _pair.Type == schema.SWAP_EVENT_UNISWAPV2_LIKE {
-		hackType := chain.GetUniV2PoolTokenHackType(_pair)
+		hackType := p.Set.Chain.GetUniV2PoolTokenHackType(_pair)
 		if hackType > 3 {
 			// 说明是坑人币
 			utils.Errorf("[ checkPairValidity ] hack pair: %v. Pair: %v, address: %v", hackType, _pair.PairName, _pair.Address)
@@ -139,16 +121,17 @@ func (p *HNPair) checkPairValidity(_pair *schema.Pair) bool {
 	return true
 }
 
-func (p *HNPair) GetHotNewPairSellSignal() {
-	trades := p.getUnSoldTrades()
+func (p *HNPair) SellSignals(trades []*schema.BiTrade) []*schema.BiTrade {
 	topN := p.FindHotNewPairs(5)
 
+	var sold []*schema.BiTrade
 	for _, trade := range trades {
-		isSell := p.checkSellSignal(&trade, topN)
-		if isSell {
-			p.sellTrade(&trade)
+		if p.checkSellSignal(trade, topN) {
+			sold = append(sold, trade)
 		}
 	}
+
+	return sold
 }
 
 func (p *HNPair) checkSellSignal(trade *schema.BiTrade, topN []*schema.Pair) bool {
@@ -204,51 +187,6 @@ func (p *HNPair) checkSellSignal(trade *schema.BiTrade, topN []*schema.Pair) boo
 	return true
 }
 
-func (p *HNPair) sellTrade(trade *schema.BiTrade) {
-	trade.EarnRatio = (trade.SellPrice - trade.BuyPrice) / trade.BuyPrice
-	trade.HoldTime = trade.SellTime.Sub(trade.BuyTime)
-	trade.Status = 1
-
-	// 保存到db
-	wiser.UpdateBiTrade(trade, p.Set.DB)
-
-	msg := fmt.Sprintf("<font color=\"warning\">[ **Sell** ]</font>\nPair: [%v](https://www.dextools.io/app/cn/ether/pair-explorer/%v)\nEarn: <font color=\"comment\">%.2f%%</font>\nReason: <font color=\"comment\">**%v**</font>\nSellPrice: %v\nBuyTime: %v\nBuyRank: %v\nHoldTime: %v\nLiquidity: %v", trade.PairName, trade.PairAddress, trade.EarnRatio*100, trade.SellReason, trade.SellPrice, trade.BuyTime.In(time.FixedZone("UTC+8", 8*60*60)).Format("01-02 15:04"), trade.SortRank, utils.ReadibleDuration(trade.HoldTime), utils.HumanizeNumber(trade.PairLiquidity))
-
-	utils.SendWecommBot(p.Set.Config.HotPairHookUrl, msg)
-}
-
-func (p *HNPair) getUnSoldTrades() []schema.BiTrade {
-	db := p.Set.DB.Database(config.DatabaseName)
-
-	filter := bson.M{}
-	filter["status"] = 0
-
-	options := &options.FindOptions{}
-
-	trades, _, err := wiser.GetBiTrades(options, &filter, db)
-	if err != nil {
-		utils.Errorf("[ getUnSoldTrades ] GetBiTrades failed: %v", err)
-	}
-
-	utils.Debugf("[ getUnSoldTrades ] GetBiTrades len: %v", len(trades))
-	return trades
-}
-
-func (p *HNPair) buyTrade(trade *schema.BiTrade) {
-	utils.Infof("[ buyTrade ] buy now. PairName: %v", trade.PairName)
-
-	// 保存到db
-	wiser.SaveBiTrade(trade, p.Set.DB)
-
-	// 获取该pair符合要求的次数
-	// buyCounts := p.GetPairBuyCounts(trade.PairAddress)
-
-	// 发送消息到wx
-	msg := fmt.Sprintf("<font color=\"info\">[ **Buy** ]</font>\nPair: [%v](https://www.dextools.io/app/cn/ether/pair-explorer/%v)\nLiquidity: $%v\nTxNumIn1h: %v\nAge: %v\nPrice: %v\nRank: %v\n", trade.PairName, trade.PairAddress, utils.HumanizeNumber(trade.PairLiquidity), trade.TxNumIn1h, utils.ReadibleDuration(trade.PairAge), trade.BuyPrice, trade.SortRank)
-
-	utils.SendWecommBot(p.Set.Config.HotPairHookUrl, msg)
-}
-
 func (p *HNPair) GetPairBuyCounts(pair string) int64 {
 	filter := bson.M{}
 	filter["pairAddress"] = pair
@@ -306,3 +244,81 @@ func (p *HNPair) FindHotNewPairs(topN int64) []*schema.Pair {
 
 	return info
 }
+
+// 回测: 以 hrank 快照中的排名代替实时的1小时tx排名
+// 每小时整点检查买点, 每10分钟检查是否跌出前 TopN, 涨到 TakeProfit 倍随时卖出
+type HNBacktest struct {
+	TopN         int     `json:"topN"`
+	MaxAge       int     `json:"maxAge"`       // pair 创建时长上限, 单位秒
+	MinLiquidity float64 `json:"minLiquidity"` // 池子最小金额
+	MaxHighRatio float64 `json:"maxHighRatio"` // 24小时最高价/最新价 上限
+
+	ExitRule
+}
+
+func NewHNBacktest() *HNBacktest {
+	return &HNBacktest{
+		TopN:         5,
+		MaxAge:       60 * 60,
+		MinLiquidity: 10000,
+		MaxHighRatio: 2,
+		ExitRule:     ExitRule{TakeProfit: 5},
+	}
+}
+
+func (b *HNBacktest) Name() string {
+	return "hn"
+}
+
+func (b *HNBacktest) Pairs(_ time.Time, snapshots []*backtest.Snapshot) []string {
+	return backtest.SnapshotPairs(snapshots, b.TopN)
+}
+
+func (b *HNBacktest) OnTick(ctx *backtest.Context) {
+	checkExits(ctx, &b.ExitRule)
+
+	snapshot := ctx.Snapshot()
+	if snapshot == nil {
+		return
+	}
+
+	now := ctx.Now()
+
+	// 先检查卖的, 避免本次新加入的也被检查
+	if now.Minute()%10 == 0 {
+		for _, pos := range ctx.Positions() {
+			if rank := snapshot.Rank(pos.Pair); rank == 0 || rank > b.TopN {
+				ctx.Sell(pos.Pair, fmt.Sprintf("TX 跌出前%v", b.TopN))
+			}
+		}
+	}
+
+	if now.Minute() != 0 {
+		return
+	}
+
+	for _, rank := range snapshot.Ranks {
+		if rank.SortRank > b.TopN || rank.PairLiquidity < b.MinLiquidity || !isNormalPair(ctx, rank.PairAddress) {
+			continue
+		}
+
+		info, _ := ctx.PairInfo(rank.PairAddress)
+		if now.Sub(info.FirstAddPoolTime) > time.Duration(b.MaxAge)*time.Second {
+			continue
+		}
+
+		var highest float64
+		for _, k := range ctx.BarsSince(rank.PairAddress, 24*time.Hour) {
+			if k.PriceInUsd > highest {
+				highest = k.PriceInUsd
+			}
+		}
+
+		price := ctx.Price(rank.PairAddress)
+		if price <= 0 || highest/price >= b.MaxHighRatio {
+			continue
+		}
+
+		ctx.Buy(rank.PairAddress, fmt.Sprintf("rank: %v", rank.SortRank))
+	}
+}
@@ -2,13 +2,8 @@ package handler
 
 import (
 	"fmt"
-	"sfilter/schema"
-	"sfilter/services/wiser"
-	"sfilter/utils"
+	"sfilter/services/backtest"
 	"time"
-
-	"go.mongodb.org/mongo-driver/bson"
-	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 /**
@@ -23,103 +18,92 @@ import (
 1. 五倍卖出
 2. 买入后每10分钟取一次tx，tx跌出前20卖出
 
+只用于回测, 重放 hrank 快照
 */
 
 // hot second pump pair - 第二次重新爆拉热点币
 type HSPPair struct {
-	Set *Setting
+	FirstRank    int     `json:"firstRank"`    // 第一个小时的排名要求
+	BuyRank      int     `json:"buyRank"`      // 再次爆拉时的排名要求
+	SellRank     int     `json:"sellRank"`     // 跌出该排名卖出
+	MinAge       int     `json:"minAge"`       // 再次爆拉时 pair 创建时长下限, 单位秒
+	MaxAge       int     `json:"maxAge"`       // 再次爆拉时 pair 创建时长上限, 单位秒
+	MinLiquidity float64 `json:"minLiquidity"` // 池子最小金额
+
+	ExitRule
+
+	last      *backtest.Snapshot
+	firstSeen map[string]bool // pair -> 第一次出现在排名中时是否符合要求
+	bought    map[string]bool
 }
 
-func (p *HSPPair) Run() {
-	{ // test
-		p.TraceBack()
-		return
+func NewHSPBacktest() *HSPPair {
+	return &HSPPair{
+		FirstRank:    20,
+		BuyRank:      15,
+		SellRank:     20,
+		MinAge:       6 * 60 * 60,
+		MaxAge:       7 * 24 * 60 * 60,
+		MinLiquidity: 1000,
+		ExitRule:     ExitRule{TakeProfit: 5},
+		firstSeen:    make(map[string]bool),
+		bought:       make(map[string]bool),
 	}
-
 }
-func (p *HSPPair) printOneHRank(pairAddr string, hranks []schema.HotPairRank) {
-	utils.Infof("\n*** Pair: %v", pairAddr)
-	for _, rank := range hranks {
-		fmt.Printf("rank: %v, pairAge: %v, createdAt: %v\n", rank.SortRank, rank.PairAge, rank.CreatedAt)
-	}
 
-	fmt.Printf("*** End ****\n\n")
+func (p *HSPPair) Name() string {
+	return "hsp"
 }
 
-// 回溯
-func (p *HSPPair) TraceBack() {
-	hmap := p.GetHotRankMap()
-
-	for pairAddr, hranks := range hmap {
-		// p.printOneHRank(pairAddr, hranks)
-
-		p.DoTraceBackOne(pairAddr, hranks)
-	}
-
+func (p *HSPPair) Pairs(_ time.Time, snapshots []*backtest.Snapshot) []string {
+	return backtest.SnapshotPairs(snapshots, p.FirstRank)
 }
 
-func (p *HSPPair) DoTraceBackOne(pairAddr string, hranks []schema.HotPairRank) {
-	if len(hranks) < 2 {
-		return // 排名太少不符合需求
-	}
+func (p *HSPPair) OnTick(ctx *backtest.Context) {
+	checkExits(ctx, &p.ExitRule)
 
-	// 第一个小时tx进入前20, 也就是需要判断第一根柱子是否符合需求
-	if hranks[0].PairAge > time.Hour && hranks[0].SortRank <= 20 {
-		// utils.Warnf("[ DoTraceBackOne ] the first time age too old or rank not good: Age: %v, Rank: %v", hranks[0].PairAge, hranks[0].SortRank)
+	snapshot := ctx.Snapshot()
+	if snapshot == nil {
 		return
 	}
 
-	// 针对5个小时后面的柱子, 判断是否存在:
-	// pair创建时间小于1周 && 最近1小时tx在前15
-	var ind int
-	var hrank schema.HotPairRank
-	hranks = hranks[1:]
-	for ind, hrank = range hranks {
-		if hrank.PairAge > 6*time.Hour && hrank.PairAge < 7*24*time.Hour {
-			// pair时间符合需求, 再判断tx是否符合排名
-			if hrank.SortRank < 15 {
-				// 找到买点
-				// utils.Infof("[ DoTraceBackOne ] pass  buy point.. pair: %v", pairAddr)
-				break
+	// 买入后每10分钟检查一次排名
+	if ctx.Now().Minute()%10 == 0 {
+		for _, pos := range ctx.Positions() {
+			if rank := snapshot.Rank(pos.Pair); rank == 0 || rank > p.SellRank {
+				ctx.Sell(pos.Pair, fmt.Sprintf("TX 跌出前%v", p.SellRank))
 			}
 		}
 	}
 
-	if ind != len(hranks)-1 {
-		// 说明找到买点, 开始找卖点
-		hranks = hranks[ind+1:]
-
-		// 1.五倍卖出; 2.买入后每小时取一次tx，tx跌出前20卖出
-		fmt.Printf("%v, %v, %v\n", hranks[0].PairName, hranks[0].PairAddress, hranks[0].CreatedAt)
-
+	// 每个快照只处理一次
+	if snapshot == p.last {
+		return
 	}
+	p.last = snapshot
 
-}
-
-// 将所有hrank小时排名从db中取出, 以pair为key, createdAt倒排
-// 以pair为key, createdAt倒排保存到map数组
-func (p *HSPPair) GetHotRankMap() schema.HRankMap {
-	filter := bson.M{}
-
-	// 排名前20的即可
-	filter["sortRank"] = bson.M{
-		"$lte": 20,
-	}
-	filter["pairLiquidity"] = bson.M{
-		"$gte": 1000,
-	}
+	for _, rank := range snapshot.Ranks {
+		if rank.PairLiquidity < p.MinLiquidity {
+			continue
+		}
 
-	// 设个大值, 实际达不到
-	limit := int64(24 * 100 * 30)
-	options := &options.FindOptions{Limit: &limit}
+		// 第一个小时tx进入前20
+		first, ok := p.firstSeen[rank.PairAddress]
+		if !ok {
+			p.firstSeen[rank.PairAddress] = rank.PairAge <= time.Hour && rank.SortRank <= p.FirstRank
+			continue
+		}
 
-	sort := bson.D{bson.E{Key: "createdAt", Value: -1}}
-	options = options.SetSort(sort)
+		if !first || p.bought[rank.PairAddress] {
+			continue
+		}
 
-	hmap, err := wiser.GetHotRankToMap(options, &filter, p.Set.DB)
-	if err != nil {
-		utils.Fatalf("[ GetHotRankMap ] get error: %v", err)
+		// 创建一段时间后, 最近1小时tx重新进入前15
+		age := rank.PairAge
+		if age > time.Duration(p.MinAge)*time.Second && age < time.Duration(p.MaxAge)*time.Second && rank.SortRank < p.BuyRank {
+			if ctx.Buy(rank.PairAddress, fmt.Sprintf("rank: %v, age: %v", rank.SortRank, age)) {
+				p.bought[rank.PairAddress] = true
+			}
+		}
 	}
-
-	return hmap
 }
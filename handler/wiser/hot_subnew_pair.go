@@ -4,13 +4,12 @@ import (
 	"fmt"
 	"sfilter/config"
 	"sfilter/schema"
+	"sfilter/services/backtest"
 	"sfilter/services/kline"
 	"sfilter/services/pair"
-	"sfilter/services/wiser"
 	"sfilter/utils"
 	"time"
 
-	"github.com/robfig/cron"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
@@ -36,29 +35,20 @@ type HSPair struct {
 	Set *Setting
 }
 
-func (p *HSPair) Run() {
-	// { // test
-	// 	p.PolicyLogic()
-	// 	return
-	// }
-
-	c := cron.New()
-	spec := "20 0/5 * * * *" // 第20s开始执行, 每5min执行一次
-	c.AddFunc(spec, func() {
-		p.PolicyLogic()
-	})
-	c.Start()
-
-	select {}
+func NewHSPair(set *Setting) *HSPair {
+	return &HSPair{Set: set}
 }
 
-func (p *HSPair) PolicyLogic() {
-	p.HotSubnewSellSignal() // 先检查卖的, 避免本次新加入的也被检查
+func (p *HSPair) Name() string {
+	return "hs"
+}
 
-	p.HotSubnewBuySignal()
+// 每5min执行一次
+func (p *HSPair) Schedule() Schedule {
+	return Schedule{Buy: 5 * time.Minute, Sell: 5 * time.Minute}
 }
 
-func (p *HSPair) HotSubnewBuySignal() {
+func (p *HSPair) BuySignals() []*schema.BiTrade {
 	pairs := p.FindHotSubnewPairs()
 
 	var buyPairs []*schema.Pair
@@ -72,33 +62,23 @@ func (p *HSPair) HotSubnewBuySignal() {
 		}
 	}
 
-	// 针对选择出来的程序, 执行买入动作
+	var trades []*schema.BiTrade
 	for _, _pair := range buyPairs {
-		// 检查是否已经买入
-		mainToken := utils.GetMainToken(_pair.Token0, _pair.Token1)
-		_, err := wiser.GetToBeSoldBiTrade(mainToken, p.Set.DB)
-		if err != nil {
-			// 没找到, 或者说没买, 执行买入动作
-			trade := schema.BiTrade{
-				MainToken:     mainToken,
-				PairAddress:   _pair.Address,
-				PairName:      _pair.PairName,
-				PairLiquidity: _pair.LiquidityInUsd,
-				PairAge:       time.Since(_pair.FirstAddPoolTime),
-
-				BuyPrice:  _pair.PriceInUsd,
-				BuyTime:   time.Now(),
-				BuyReason: _pair.ReservedString,
-				SortRank:  _pair.ReservedInt,
-
-				HighestPrice: _pair.PriceInUsd,
-			}
-
-			p.buyTrade(&trade)
-		} else {
-			utils.Warnf("[ HotSubnewBuySignal ] mainToken has been bought. pairName: %v, mainToken: %v", _pair.PairName, mainToken)
-		}
+		trades = append(trades, &schema.BiTrade{
+			MainToken:     utils.GetMainToken(_pair.Token0, _pair.Token1),
+			PairAddress:   _pair.Address,
+			PairName:      _pair.PairName,
+			PairLiquidity: _pair.LiquidityInUsd,
+			PairAge:       time.Since(_pair.FirstAddPoolTime),
+
+			BuyPrice:  _pair.PriceInUsd,
+			BuyReason: _pair.ReservedString,
+			SortRank:  _pair.ReservedInt,
+			TxNumIn1h: _pair.TxNumIn1h,
+		})
 	}
+
+	return trades
 }
 
 func (p *HSPair) checkPairValidity(_pair *schema.Pair) bool {
@@ -145,16 +125,17 @@ func (p *HSPair) checkPairValidity(_pair *schema.Pair) bool {
 	return true
 }
 
-func (p *HSPair) HotSubnewSellSignal() {
-	// 取出所有未卖出的币, 逐个检查是否达到了卖出条件
-	trades := p.getUnSoldTrades()
+// 逐个检查是否达到了卖出条件
+func (p *HSPair) SellSignals(trades []*schema.BiTrade) []*schema.BiTrade {
+	var sold []*schema.BiTrade
 
 	for _, trade := range trades {
-		isSell := p.checkSellSignal(&trade)
-		if isSell {
-			p.sellTrade(&trade)
+		if p.checkSellSignal(trade) {
+			sold = append(sold, trade)
 		}
 	}
+
+	return sold
 }
 
 func (p *HSPair) checkSellSignal(trade *schema.BiTrade) bool {
@@ -211,9 +192,6 @@ func (p *HSPair) checkSellSignal(trade *schema.BiTrade) bool {
 
 		if k1m.PriceInUsd > trade.HighestPrice {
 			trade.HighestPrice = k1m.PriceInUsd
-
-			// 更新到db, 下次使用
-			wiser.UpdateBiTrade(trade, p.Set.DB)
 		}
 
 		if !isRetrace {
@@ -246,90 +224,137 @@ func (p *HSPair) checkSellSignal(trade *schema.BiTrade) bool {
 	return false
 }
 
-func (p *HSPair) buyTrade(trade *schema.BiTrade) {
-	utils.Infof("[ buyTrade ] buy now. PairName: %v", trade.PairName)
-
-	// 保存到db
-	wiser.SaveBiTrade(trade, p.Set.DB)
+func (p *HSPair) FindHotSubnewPairs() []*schema.Pair {
+	db := p.Set.DB.Database(config.DatabaseName)
+	filter := bson.M{}
 
-	// 发送消息到wx
-	msg := fmt.Sprintf("<font color=\"info\">[ **Buy** ]</font>\nPair: [%v](https://www.dextools.io/app/cn/ether/pair-explorer/%v)\nLiquidity: $%.1f K\nAge: %v\nPrice: %v\nRank: %v\nBuyReason: %v", trade.PairName, trade.PairAddress, trade.PairLiquidity/1000, utils.ReadibleDuration(trade.PairAge), trade.BuyPrice, trade.SortRank, trade.BuyReason)
-	utils.SendWecommBot(p.Set.Config.HotPairHookUrl, msg)
-}
+	// 非通缩币
+	filter["mainTokenHackType"] = bson.M{
+		"$lte": 2,
+	}
 
-func (p *HSPair) sellTrade(trade *schema.BiTrade) {
-	// utils.Infof("[ sellTrade ] sell now. PairName: %v", trade.PairName)
-	trade.SellTime = time.Now()
+	// 最近1小时内必须更新过, 也就是必须有交易
+	date := time.Now().Add(-1 * time.Hour)
+	filter["updatedAt"] = bson.M{
+		"$gte": date,
+	}
 
-	trade.EarnRatio = (trade.SellPrice - trade.BuyPrice) / trade.BuyPrice
-	trade.HoldTime = time.Since(trade.BuyTime)
-	trade.Status = 1 // 表示该币可以重新买入
+	limit := int64(20)
+	options := &options.FindOptions{Limit: &limit}
 
-	// 保存到db
-	wiser.UpdateBiTrade(trade, p.Set.DB)
+	sort := bson.D{}
 
-	mainTokenCount := p.getSoldTradesByMainToken(trade.MainToken)
+	// 根据 txNumIn1h 倒排
+	sort = append(sort, bson.E{Key: "txNumIn1h", Value: -1}, bson.E{Key: "UpdateAt", Value: -1})
+	options = options.SetSort(sort)
 
-	// 发送消息到wx
-	msg := fmt.Sprintf("<font color=\"warning\">[ **Sell** ]</font>\nPair: [%v](https://www.dextools.io/app/cn/ether/pair-explorer/%v)\nEarn: <font color=\"comment\">%.2f%%</font>\nReason: <font color=\"comment\">**%v**</font>\nSellPrice: %v\nBuyTime: %v\nBuyRank: %v\nHoldTime: %v\nBuyCounts: %v\nLiquidity: $%.1f K\nAge: %v", trade.PairName, trade.PairAddress, trade.EarnRatio*100, trade.SellReason, trade.SellPrice, trade.BuyTime.In(time.FixedZone("UTC+8", 8*60*60)).Format("01-02 15:04"), trade.SortRank, trade.HoldTime.String(), mainTokenCount, trade.PairLiquidity/1000, utils.ReadibleDuration(trade.PairAge))
+	info, count, err := pair.GetHotPairs(options, &filter, db)
+	utils.Debugf("[ FindHotSubnewPairs ] len: %v, err: %v", count, err)
 
-	utils.SendWecommBot(p.Set.Config.HotPairHookUrl, msg)
+	return info
 }
 
-func (p *HSPair) getSoldTradesByMainToken(mainToken string) int64 {
-	filter := bson.M{}
-	filter["status"] = 1
-	filter["mainToken"] = mainToken
+// 回测: 以 hrank 快照中 tx 排名前 TopN 的 pair 代替实时的1小时tx排名, 每5分钟检查一次买卖点
+type HSBacktest struct {
+	TopN    int     `json:"topN"`
+	MinRise float64 `json:"minRise"` // 15分钟最小涨幅
+	MaxRise float64 `json:"maxRise"` // 15分钟最大涨幅
 
-	options := &options.FindOptions{}
-	_, count, _ := wiser.GetBiTrades(options, &filter, p.Set.DB.Database(config.DatabaseName))
+	ExitRule
+}
 
-	return count
+func NewHSBacktest() *HSBacktest {
+	return &HSBacktest{
+		TopN:     20,
+		MinRise:  0.05,
+		MaxRise:  0.5,
+		ExitRule: ExitRule{StopLoss: 0.5, RetraceTrigger: 0.5, Retrace: 0.3},
+	}
 }
 
-func (p *HSPair) getUnSoldTrades() []schema.BiTrade {
-	db := p.Set.DB.Database(config.DatabaseName)
+func (b *HSBacktest) Name() string {
+	return "hs"
+}
 
-	filter := bson.M{}
-	filter["status"] = 0
+func (b *HSBacktest) Pairs(_ time.Time, snapshots []*backtest.Snapshot) []string {
+	return backtest.SnapshotPairs(snapshots, b.TopN)
+}
 
-	options := &options.FindOptions{}
+func (b *HSBacktest) OnTick(ctx *backtest.Context) {
+	now := ctx.Now()
+	if now.Minute()%5 != 0 {
+		return
+	}
 
-	trades, _, err := wiser.GetBiTrades(options, &filter, db)
-	if err != nil {
-		utils.Errorf("[ getUnSoldTrades ] GetBiTrades failed: %v", err)
+	// 先检查卖的, 避免本次新加入的也被检查
+	for _, pos := range ctx.Positions() {
+		if reason, ok := b.checkSell(ctx, pos); ok {
+			ctx.Sell(pos.Pair, reason)
+		}
 	}
 
-	utils.Debugf("[ getUnSoldTrades ] GetBiTrades len: %v", len(trades))
-	return trades
+	snapshot := ctx.Snapshot()
+	if snapshot == nil {
+		return
+	}
+
+	for _, rank := range snapshot.Ranks {
+		if rank.SortRank > b.TopN || !isNormalPair(ctx, rank.PairAddress) {
+			continue
+		}
+
+		if reason, ok := b.checkBuy(ctx, rank.PairAddress); ok {
+			ctx.Buy(rank.PairAddress, reason)
+		}
+	}
 }
 
-func (p *HSPair) FindHotSubnewPairs() []*schema.Pair {
-	db := p.Set.DB.Database(config.DatabaseName)
-	filter := bson.M{}
+// 连续15分钟每5分钟的 tx 与价格都上涨, 且15分钟涨幅在 [MinRise, MaxRise] 内
+func (b *HSBacktest) checkBuy(ctx *backtest.Context, pair string) (string, bool) {
+	now := ctx.Now()
+	bars := ctx.BarsSince(pair, time.Hour)
 
-	// 非通缩币
-	filter["mainTokenHackType"] = bson.M{
-		"$lte": 2,
+	tx1, _ := sumBars(bars, now.Add(-15*time.Minute), now.Add(-10*time.Minute))
+	tx2, _ := sumBars(bars, now.Add(-10*time.Minute), now.Add(-5*time.Minute))
+	tx3, _ := sumBars(bars, now.Add(-5*time.Minute), now)
+	if !(tx1 > 0 && tx1 < tx2 && tx2 < tx3) {
+		return "", false
 	}
 
-	// 最近1小时内必须更新过, 也就是必须有交易
-	date := time.Now().Add(-1 * time.Hour)
-	filter["updatedAt"] = bson.M{
-		"$gte": date,
+	p0 := priceAt(bars, now.Add(-15*time.Minute))
+	p1 := priceAt(bars, now.Add(-10*time.Minute))
+	p2 := priceAt(bars, now.Add(-5*time.Minute))
+	p3 := priceAt(bars, now)
+	if !(p0 > 0 && p0 < p1 && p1 < p2 && p2 < p3) {
+		return "", false
 	}
 
-	limit := int64(20)
-	options := &options.FindOptions{Limit: &limit}
+	if p3 < p0*(1+b.MinRise) || p3 > p0*(1+b.MaxRise) {
+		return "", false
+	}
 
-	sort := bson.D{}
+	return fmt.Sprintf("tx: %v->%v->%v", tx1, tx2, tx3), true
+}
 
-	// 根据 txNumIn1h 倒排
-	sort = append(sort, bson.E{Key: "txNumIn1h", Value: -1}, bson.E{Key: "UpdateAt", Value: -1})
-	options = options.SetSort(sort)
+// 缩量涨、放量跌, 或触发 ExitRule
+func (b *HSBacktest) checkSell(ctx *backtest.Context, pos *backtest.Position) (string, bool) {
+	now := ctx.Now()
+	bars := ctx.BarsSince(pos.Pair, time.Hour)
 
-	info, count, err := pair.GetHotPairs(options, &filter, db)
-	utils.Debugf("[ FindHotSubnewPairs ] len: %v, err: %v", count, err)
+	_, vl1 := sumBars(bars, now.Add(-15*time.Minute), now.Add(-10*time.Minute))
+	_, vl2 := sumBars(bars, now.Add(-10*time.Minute), now.Add(-5*time.Minute))
+	_, vl3 := sumBars(bars, now.Add(-5*time.Minute), now)
 
-	return info
+	p0 := priceAt(bars, now.Add(-15*time.Minute))
+	p3 := priceAt(bars, now)
+
+	if p0 > 0 && vl1 > vl2 && vl2 > vl3 && p0 < p3 {
+		return fmt.Sprintf("缩量涨: %.1f->%.1f->%.1f", vl1, vl2, vl3), true
+	}
+
+	if p0 > 0 && vl1 < vl2 && vl2 < vl3 && p3 < p0 {
+		return fmt.Sprintf("放量跌: %.1f->%.1f->%.1f", vl1, vl2, vl3), true
+	}
+
+	return b.check(pos, ctx.Price(pos.Pair))
 }
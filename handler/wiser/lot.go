@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"sfilter/schema"
+)
+
+// 一批买入(或转入)的 token, cost 为剩余数量对应的 usd 成本
+type lot struct {
+	amount float64
+	cost   float64
+}
+
+// 某个地址持有的某个 token 的成本记录
+// fifo 模式下按买入顺序卖出; avg 模式下只保留一个合并后的 lot, 按平均成本卖出
+type lotBook struct {
+	method string
+	lots   []*lot
+}
+
+func newLotBook(method string) *lotBook {
+	if method != schema.DEAL_COST_METHOD_AVG {
+		method = schema.DEAL_COST_METHOD_FIFO
+	}
+
+	return &lotBook{method: method}
+}
+
+func (b *lotBook) add(amount, cost float64) {
+	if amount <= 0 {
+		return
+	}
+
+	if b.method == schema.DEAL_COST_METHOD_AVG && len(b.lots) > 0 {
+		b.lots[0].amount += amount
+		b.lots[0].cost += cost
+		return
+	}
+
+	b.lots = append(b.lots, &lot{amount: amount, cost: cost})
+}
+
+// 取出 amount 数量的 token, 返回取出的 lots 及其成本
+// 持有数量不足时只取出已有的, matched 为实际取出的数量
+func (b *lotBook) take(amount float64) (taken []*lot, cost, matched float64) {
+	for amount > 0 && len(b.lots) > 0 {
+		head := b.lots[0]
+
+		if head.amount <= amount {
+			taken = append(taken, head)
+			cost += head.cost
+			matched += head.amount
+			amount -= head.amount
+
+			b.lots = b.lots[1:]
+			continue
+		}
+
+		// 部分取出, 成本按比例拆分
+		part := &lot{amount: amount, cost: head.cost * amount / head.amount}
+		head.amount -= part.amount
+		head.cost -= part.cost
+
+		taken = append(taken, part)
+		cost += part.cost
+		matched += part.amount
+		amount = 0
+	}
+
+	return taken, cost, matched
+}
+
+func (b *lotBook) amount() float64 {
+	var total float64
+	for _, l := range b.lots {
+		total += l.amount
+	}
+
+	return total
+}
+
+func (b *lotBook) cost() float64 {
+	var total float64
+	for _, l := range b.lots {
+		total += l.cost
+	}
+
+	return total
+}
+
+func (b *lotBook) reset() {
+	b.lots = nil
+}
+
+// 从保存的持仓恢复
+func (b *lotBook) load(lots []schema.DealLot) {
+	b.lots = nil
+	for _, l := range lots {
+		b.lots = append(b.lots, &lot{amount: l.Amount, cost: l.Cost})
+	}
+}
+
+// 转成保存到db的格式
+func (b *lotBook) dealLots() []schema.DealLot {
+	return toDealLots(b.lots)
+}
+
+func toDealLots(lots []*lot) []schema.DealLot {
+	var result []schema.DealLot
+	for _, l := range lots {
+		result = append(result, schema.DealLot{Amount: l.amount, Cost: l.cost})
+	}
+
+	return result
+}
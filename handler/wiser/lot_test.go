@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"math"
+	"testing"
+
+	"sfilter/schema"
+)
+
+func almostEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+// 两次买入: 100 个成本 100, 100 个成本 300
+func TestLotBookPartialTake(t *testing.T) {
+	cases := []struct {
+		method string
+		take   float64
+
+		wantCost    float64
+		wantMatched float64
+		wantTaken   []schema.DealLot
+		wantLeft    []schema.DealLot
+	}{
+		{
+			// 先卖出先买入的, 部分取出第二个 lot 时成本按比例拆分
+			method:      schema.DEAL_COST_METHOD_FIFO,
+			take:        150,
+			wantCost:    250,
+			wantMatched: 150,
+			wantTaken:   []schema.DealLot{{Amount: 100, Cost: 100}, {Amount: 50, Cost: 150}},
+			wantLeft:    []schema.DealLot{{Amount: 50, Cost: 150}},
+		},
+		{
+			// 只保留一个合并后的 lot, 按平均成本 2 取出
+			method:      schema.DEAL_COST_METHOD_AVG,
+			take:        150,
+			wantCost:    300,
+			wantMatched: 150,
+			wantTaken:   []schema.DealLot{{Amount: 150, Cost: 300}},
+			wantLeft:    []schema.DealLot{{Amount: 50, Cost: 100}},
+		},
+		{
+			// 超出持仓的部分没有成本
+			method:      schema.DEAL_COST_METHOD_FIFO,
+			take:        250,
+			wantCost:    400,
+			wantMatched: 200,
+			wantTaken:   []schema.DealLot{{Amount: 100, Cost: 100}, {Amount: 100, Cost: 300}},
+		},
+		{
+			method:      schema.DEAL_COST_METHOD_AVG,
+			take:        250,
+			wantCost:    400,
+			wantMatched: 200,
+			wantTaken:   []schema.DealLot{{Amount: 200, Cost: 400}},
+		},
+		{
+			// 未知的方式按 fifo
+			method:      "",
+			take:        50,
+			wantCost:    50,
+			wantMatched: 50,
+			wantTaken:   []schema.DealLot{{Amount: 50, Cost: 50}},
+			wantLeft:    []schema.DealLot{{Amount: 50, Cost: 50}, {Amount: 100, Cost: 300}},
+		},
+	}
+
+	for _, c := range cases {
+		book := newLotBook(c.method)
+		book.add(100, 100)
+		book.add(100, 300)
+		book.add(0, 10) // 数量为0的忽略
+
+		taken, cost, matched := book.take(c.take)
+
+		if !almostEqual(cost, c.wantCost) || !almostEqual(matched, c.wantMatched) {
+			t.Errorf("%q take %v: cost = %v, matched = %v, want %v, %v", c.method, c.take, cost, matched, c.wantCost, c.wantMatched)
+		}
+
+		if !equalLots(toDealLots(taken), c.wantTaken) {
+			t.Errorf("%q take %v: taken = %v, want %v", c.method, c.take, toDealLots(taken), c.wantTaken)
+		}
+
+		if !equalLots(book.dealLots(), c.wantLeft) {
+			t.Errorf("%q take %v: left = %v, want %v", c.method, c.take, book.dealLots(), c.wantLeft)
+		}
+
+		var leftAmount, leftCost float64
+		for _, l := range c.wantLeft {
+			leftAmount += l.Amount
+			leftCost += l.Cost
+		}
+		if !almostEqual(book.amount(), leftAmount) || !almostEqual(book.cost(), leftCost) {
+			t.Errorf("%q take %v: amount = %v, cost = %v, want %v, %v", c.method, c.take, book.amount(), book.cost(), leftAmount, leftCost)
+		}
+	}
+}
+
+// 保存后恢复的持仓与原来一致, 之后的买入继续按原来的方式记录
+func TestLotBookLoad(t *testing.T) {
+	book := newLotBook(schema.DEAL_COST_METHOD_AVG)
+	book.load([]schema.DealLot{{Amount: 10, Cost: 20}})
+	book.add(10, 40)
+
+	want := []schema.DealLot{{Amount: 20, Cost: 60}}
+	if !equalLots(book.dealLots(), want) {
+		t.Fatalf("lots = %v, want %v", book.dealLots(), want)
+	}
+
+	book.reset()
+	if book.dealLots() != nil || book.amount() != 0 {
+		t.Fatalf("lots = %v after reset, want empty", book.dealLots())
+	}
+}
+
+func equalLots(a, b []schema.DealLot) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if !almostEqual(a[i].Amount, b[i].Amount) || !almostEqual(a[i].Cost, b[i].Cost) {
+			return false
+		}
+	}
+
+	return true
+}
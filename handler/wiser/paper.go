@@ -0,0 +1,385 @@
+package handler
+
+import (
+	"errors"
+	"math/big"
+	"time"
+
+	"sfilter/config"
+	"sfilter/schema"
+	"sfilter/services/kline"
+	"sfilter/services/pair"
+	"sfilter/services/token"
+	"sfilter/services/wiser"
+	"sfilter/utils"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+var ErrUnsupportedPool = errors.New("unsupported pool type")
+
+// 策略的模拟盘, 按信号用虚拟资金买卖
+// 成交按池子当前储备(v2)或 quoter(v3)询价, 滑点与池子手续费都已包含在内; 询价失败时按信号价格扣除手续费成交
+// 持仓按k线收盘价估值, 不计卖出滑点
+// 与策略在同一个协程中执行, 不需要加锁
+type Ledger struct {
+	set      *Setting
+	strategy string
+	conf     *config.StrategyConfig
+}
+
+func NewLedger(set *Setting, strategy string, conf *config.StrategyConfig) *Ledger {
+	return &Ledger{
+		set:      set,
+		strategy: strategy,
+		conf:     conf,
+	}
+}
+
+// 没有账户时按配置的初始资金创建
+func (l *Ledger) account() (*schema.PaperAccount, error) {
+	acc, err := wiser.GetPaperAccount(l.strategy, l.set.DB)
+	if err == nil {
+		return acc, nil
+	}
+
+	if err != mongo.ErrNoDocuments {
+		return nil, err
+	}
+
+	acc = &schema.PaperAccount{
+		Strategy:       l.strategy,
+		InitialCapital: l.conf.Capital,
+		Cash:           l.conf.Capital,
+		Equity:         l.conf.Capital,
+	}
+
+	if err := wiser.SavePaperAccount(acc, l.set.DB); err != nil {
+		return nil, err
+	}
+
+	utils.Infof("[ Ledger.account ] %v paper account created. capital: %v", l.strategy, l.conf.Capital)
+
+	return acc, nil
+}
+
+func (l *Ledger) Open(trade *schema.BiTrade) {
+	acc, err := l.account()
+	if err != nil {
+		utils.Errorf("[ Ledger.Open ] %v get account failed: %v", l.strategy, err)
+		return
+	}
+
+	if _, err := wiser.GetOpenPaperPosition(l.strategy, trade.MainToken, l.set.DB); err == nil {
+		utils.Warnf("[ Ledger.Open ] %v position exists. pair: %v", l.strategy, trade.PairName)
+		return
+	}
+
+	size := l.conf.PositionSize
+	if size > acc.Cash-config.PaperGasUsd {
+		size = acc.Cash - config.PaperGasUsd
+	}
+	if size <= 0 {
+		utils.Warnf("[ Ledger.Open ] %v cash not enough: %v, pair: %v", l.strategy, acc.Cash, trade.PairName)
+		return
+	}
+
+	_pair, err := l.pairInfo(trade.PairAddress)
+	if err != nil {
+		utils.Errorf("[ Ledger.Open ] %v find pair failed: %v, pair: %v", l.strategy, err, trade.PairAddress)
+		return
+	}
+
+	fill := schema.PAPER_FILL_POOL
+	amount, err := l.buyAmount(_pair, trade.MainToken, size)
+	if err != nil || amount <= 0 {
+		utils.Warnf("[ Ledger.Open ] %v quote failed: %v, fill with signal price. pair: %v", l.strategy, err, trade.PairName)
+
+		fill = schema.PAPER_FILL_SIGNAL
+		amount = size * (1 - feeRate(_pair)) / trade.BuyPrice
+	}
+
+	cost := size + config.PaperGasUsd
+	pos := &schema.PaperPosition{
+		Strategy:    l.strategy,
+		MainToken:   trade.MainToken,
+		PairAddress: trade.PairAddress,
+		PairName:    trade.PairName,
+
+		Amount: amount,
+		Cost:   cost,
+
+		EntryPrice:  size / amount,
+		SignalPrice: trade.BuyPrice,
+		EntryFill:   fill,
+		EntryTime:   trade.BuyTime,
+
+		MarkPrice:     trade.BuyPrice,
+		MarkValue:     amount * trade.BuyPrice,
+		UnrealizedPnl: amount*trade.BuyPrice - cost,
+		MarkTime:      trade.BuyTime,
+
+		Status: schema.PAPER_POSITION_OPEN,
+	}
+
+	if err := wiser.SavePaperPosition(pos, l.set.DB); err != nil {
+		return
+	}
+
+	acc.Cash -= cost
+	acc.GasCost += config.PaperGasUsd
+	wiser.SavePaperAccount(acc, l.set.DB)
+
+	utils.Infof("[ Ledger.Open ] %v bought %v. size: %.2f, price: %v, signal price: %v, fill: %v", l.strategy, trade.PairName, size, pos.EntryPrice, trade.BuyPrice, fill)
+}
+
+func (l *Ledger) Close(trade *schema.BiTrade) {
+	pos, err := wiser.GetOpenPaperPosition(l.strategy, trade.MainToken, l.set.DB)
+	if err != nil {
+		// 模拟盘启用之前买入的, 或者买入时资金不足
+		utils.Debugf("[ Ledger.Close ] %v no position. pair: %v, err: %v", l.strategy, trade.PairName, err)
+		return
+	}
+
+	acc, err := l.account()
+	if err != nil {
+		utils.Errorf("[ Ledger.Close ] %v get account failed: %v", l.strategy, err)
+		return
+	}
+
+	_pair, err := l.pairInfo(trade.PairAddress)
+	if err != nil {
+		utils.Errorf("[ Ledger.Close ] %v find pair failed: %v, pair: %v", l.strategy, err, trade.PairAddress)
+		return
+	}
+
+	fill := schema.PAPER_FILL_POOL
+	value, err := l.sellValue(_pair, pos.MainToken, pos.Amount)
+	if err != nil || value <= 0 {
+		utils.Warnf("[ Ledger.Close ] %v quote failed: %v, fill with signal price. pair: %v", l.strategy, err, trade.PairName)
+
+		fill = schema.PAPER_FILL_SIGNAL
+		value = pos.Amount * trade.SellPrice * (1 - feeRate(_pair))
+	}
+
+	pos.ExitPrice = value / pos.Amount
+	pos.ExitValue = value - config.PaperGasUsd
+	pos.ExitFill = fill
+	pos.ExitReason = trade.SellReason
+	pos.ExitTime = trade.SellTime
+
+	pos.RealizedPnl = pos.ExitValue - pos.Cost
+	pos.EarnRatio = pos.RealizedPnl / pos.Cost
+	pos.MarkPrice = trade.SellPrice
+	pos.MarkValue = 0
+	pos.UnrealizedPnl = 0
+	pos.MarkTime = trade.SellTime
+	pos.Status = schema.PAPER_POSITION_CLOSED
+
+	if err := wiser.UpdatePaperPosition(pos, l.set.DB); err != nil {
+		return
+	}
+
+	acc.Cash += pos.ExitValue
+	acc.RealizedPnl += pos.RealizedPnl
+	acc.GasCost += config.PaperGasUsd
+	wiser.SavePaperAccount(acc, l.set.DB)
+
+	utils.Infof("[ Ledger.Close ] %v sold %v. pnl: %.2f(%.2f%%), price: %v, signal price: %v, fill: %v", l.strategy, trade.PairName, pos.RealizedPnl, pos.EarnRatio*100, pos.ExitPrice, trade.SellPrice, fill)
+}
+
+// 按k线收盘价给持仓估值, 并记录资金曲线
+func (l *Ledger) Mark(now time.Time) {
+	acc, err := l.account()
+	if err != nil {
+		utils.Errorf("[ Ledger.Mark ] %v get account failed: %v", l.strategy, err)
+		return
+	}
+
+	positions, err := wiser.GetOpenPaperPositions(l.strategy, l.set.DB)
+	if err != nil {
+		utils.Errorf("[ Ledger.Mark ] %v GetOpenPaperPositions failed: %v", l.strategy, err)
+		return
+	}
+
+	var positionValue, unrealized float64
+	for _, pos := range positions {
+		// 取不到价格时沿用上次的估值
+		if price := l.markPrice(pos.PairAddress, now); price > 0 {
+			pos.MarkPrice = price
+			pos.MarkValue = pos.Amount * price
+			pos.UnrealizedPnl = pos.MarkValue - pos.Cost
+			pos.MarkTime = now
+
+			wiser.UpdatePaperPosition(pos, l.set.DB)
+		}
+
+		positionValue += pos.MarkValue
+		unrealized += pos.UnrealizedPnl
+	}
+
+	acc.PositionValue = positionValue
+	acc.Equity = acc.Cash + positionValue
+	wiser.SavePaperAccount(acc, l.set.DB)
+
+	wiser.SavePaperEquity(&schema.PaperEquity{
+		Strategy: l.strategy,
+		Time:     now.Truncate(time.Minute),
+
+		Cash:          acc.Cash,
+		PositionValue: positionValue,
+		Equity:        acc.Equity,
+
+		RealizedPnl:   acc.RealizedPnl,
+		UnrealizedPnl: unrealized,
+
+		OpenPositions: len(positions),
+	}, l.set.DB)
+}
+
+// 最近一根有价格的k线收盘价, 先看1小时内的分钟线, 再看1天内的小时线
+func (l *Ledger) markPrice(pairAddr string, now time.Time) float64 {
+	db := l.set.DB.Database(config.DatabaseName)
+
+	for _, tf := range []struct {
+		name string
+		n    int
+	}{{"1m", 60}, {"1h", 24}} {
+		bars, err := kline.GetRecentKlines(pairAddr, tf.name, tf.n, now, db)
+		if err != nil {
+			utils.Warnf("[ Ledger.markPrice ] GetRecentKlines failed: %v, pair: %v", err, pairAddr)
+			return 0
+		}
+
+		if len(bars) > 0 {
+			return bars[len(bars)-1].PriceInUsd
+		}
+	}
+
+	return 0
+}
+
+func (l *Ledger) pairInfo(address string) (*schema.Pair, error) {
+	if _pair, ok := l.set.Pairs[address]; ok {
+		return _pair, nil
+	}
+
+	return pair.GetPairInfo(address, l.set.Chain)
+}
+
+// 用 usd 数量为 size 的 quote token 能买到的 main token 数量
+func (l *Ledger) buyAmount(_pair *schema.Pair, mainToken string, size float64) (float64, error) {
+	quoteToken := otherToken(_pair, mainToken)
+
+	price, err := l.tokenPrice(quoteToken)
+	if err != nil {
+		return 0, err
+	}
+
+	return l.quote(_pair, quoteToken, size/price)
+}
+
+// 卖出 amount 个 main token 得到的 usd
+func (l *Ledger) sellValue(_pair *schema.Pair, mainToken string, amount float64) (float64, error) {
+	quoteToken := otherToken(_pair, mainToken)
+
+	price, err := l.tokenPrice(quoteToken)
+	if err != nil {
+		return 0, err
+	}
+
+	amountOut, err := l.quote(_pair, mainToken, amount)
+	if err != nil {
+		return 0, err
+	}
+
+	return amountOut * price, nil
+}
+
+// 向池子询价, 数量都不含精度
+func (l *Ledger) quote(_pair *schema.Pair, tokenIn string, amountIn float64) (float64, error) {
+	tokenOut := otherToken(_pair, tokenIn)
+
+	decimalIn, decimalOut := _pair.Decimal0, _pair.Decimal1
+	if tokenIn != _pair.Token0 {
+		decimalIn, decimalOut = _pair.Decimal1, _pair.Decimal0
+	}
+
+	amountInF := new(big.Float).Mul(big.NewFloat(amountIn), new(big.Float).SetInt(decimalExponent(decimalIn)))
+	amountInBInt, _ := amountInF.Int(nil)
+
+	var amountOut *big.Int
+	var err error
+
+	switch _pair.Type {
+	case schema.SWAP_EVENT_UNISWAPV2_LIKE:
+		amountOut, err = l.set.Chain.GetUniV2SwapAmountOut(_pair.Address, _pair.Token0, tokenIn, amountInBInt)
+
+	case schema.SWAP_EVENT_UNISWAPV3_LIKE:
+		fee := _pair.PairFee
+		if fee == 0 {
+			feeBig, err := l.set.Chain.GetUniV3PairFee(_pair.Address)
+			if err != nil {
+				return 0, err
+			}
+			fee = feeBig.Int64()
+		}
+
+		amountOut, err = l.set.Chain.GetUniV3SwapAmountOut(tokenIn, tokenOut, big.NewInt(fee), amountInBInt)
+
+	default:
+		return 0, ErrUnsupportedPool
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	amountOutF := new(big.Float).Quo(new(big.Float).SetInt(amountOut), new(big.Float).SetInt(decimalExponent(decimalOut)))
+	amount, _ := amountOutF.Float64()
+
+	return amount, nil
+}
+
+// 价值币直接取价格, 其他币取 token 表中的 usd 价格
+func (l *Ledger) tokenPrice(address string) (float64, error) {
+	if utils.CheckExistString(address, config.QuoteUsdCoinList) {
+		return 1, nil
+	}
+
+	if utils.CheckExistString(address, config.QuoteEthCoinList) {
+		return l.set.Chain.GetBasicCoinPrice(nil)
+	}
+
+	_token, err := token.GetTokenInfo(address, l.set.DB)
+	if err != nil {
+		return 0, err
+	}
+
+	if _token.PriceInUsd <= 0 {
+		return 0, errors.New("token has no usd price")
+	}
+
+	return _token.PriceInUsd, nil
+}
+
+func otherToken(_pair *schema.Pair, address string) string {
+	if address == _pair.Token0 {
+		return _pair.Token1
+	}
+
+	return _pair.Token0
+}
+
+func decimalExponent(decimal uint8) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimal)), nil)
+}
+
+// pair 的手续费率, 没有记录时用默认费率
+func feeRate(_pair *schema.Pair) float64 {
+	if _pair.PairFee > 0 {
+		return float64(_pair.PairFee) / 1e6
+	}
+
+	return config.BacktestFeeRate
+}
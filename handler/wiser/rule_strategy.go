@@ -0,0 +1,276 @@
+package handler
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"sfilter/config"
+	"sfilter/schema"
+	"sfilter/services/kline"
+	"sfilter/services/pair"
+	"sfilter/services/rules"
+	"sfilter/utils"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// 由规则文件定义的策略, 规则文件为 RuleDir 下的 <name>.yaml
+// 每次执行前检查文件是否修改, 修改后重新加载, 不需要重新编译或重启; schedule 的修改需要重启才生效
+type RuleStrategy struct {
+	set  *Setting
+	name string
+	path string
+
+	mu      sync.Mutex
+	def     *rules.Definition
+	modTime time.Time
+}
+
+func ruleStrategyPath(set *Setting, name string) string {
+	return filepath.Join(set.Config.RuleDir, name+".yaml")
+}
+
+// RuleDir 下所有规则文件对应的策略名
+func ruleStrategyNames(set *Setting) []string {
+	files, _ := filepath.Glob(filepath.Join(set.Config.RuleDir, "*.yaml"))
+
+	var names []string
+	for _, file := range files {
+		names = append(names, strings.TrimSuffix(filepath.Base(file), ".yaml"))
+	}
+
+	return names
+}
+
+func NewRuleStrategy(set *Setting, name string) (*RuleStrategy, error) {
+	s := &RuleStrategy{
+		set:  set,
+		name: name,
+		path: ruleStrategyPath(set, name),
+	}
+
+	if err := s.reload(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *RuleStrategy) Name() string {
+	return s.name
+}
+
+func (s *RuleStrategy) Schedule() Schedule {
+	def := s.definition()
+	return Schedule{Buy: def.Schedule.Buy, Sell: def.Schedule.Sell, Offset: def.Schedule.Offset}
+}
+
+// 文件有修改时重新加载, 加载失败继续使用旧的规则
+func (s *RuleStrategy) reload() error {
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.def != nil && !info.ModTime().After(s.modTime) {
+		return nil
+	}
+
+	def, err := rules.Load(s.path)
+	if err != nil {
+		return fmt.Errorf("load %v error: %v", s.path, err)
+	}
+
+	if s.def != nil {
+		utils.Infof("[ RuleStrategy.reload ] %v reloaded", s.name)
+	}
+
+	s.def = def
+	s.modTime = info.ModTime()
+
+	return nil
+}
+
+func (s *RuleStrategy) definition() *rules.Definition {
+	if err := s.reload(); err != nil {
+		utils.Warnf("[ RuleStrategy.definition ] %v", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.def
+}
+
+func (s *RuleStrategy) BuySignals() []*schema.BiTrade {
+	def := s.definition()
+	now := time.Now()
+
+	var buyPairs []*schema.Pair
+	for ind, _pair := range s.universe(def, now) {
+		env := newPairEnv(_pair, ind+1, now, s.set)
+
+		ok, expr, err := def.MatchBuy(env)
+		if !ok {
+			utils.Debugf("[ RuleStrategy.BuySignals ] %v not pass. pair: %v, rule: %v, err: %v", s.name, _pair.PairName, expr, err)
+			continue
+		}
+
+		if !checkExistPair(_pair, buyPairs) {
+			_pair.ReservedInt = ind + 1
+			buyPairs = append(buyPairs, _pair)
+		}
+	}
+
+	var trades []*schema.BiTrade
+	for _, _pair := range buyPairs {
+		trades = append(trades, &schema.BiTrade{
+			MainToken:     utils.GetMainToken(_pair.Token0, _pair.Token1),
+			PairAddress:   _pair.Address,
+			PairName:      _pair.PairName,
+			PairLiquidity: _pair.LiquidityInUsd,
+			PairAge:       now.Sub(_pair.FirstAddPoolTime),
+
+			BuyPrice:  _pair.PriceInUsd,
+			BuyReason: s.name,
+			SortRank:  _pair.ReservedInt,
+			TxNumIn1h: _pair.TxNumIn1h,
+		})
+	}
+
+	return trades
+}
+
+func (s *RuleStrategy) SellSignals(trades []*schema.BiTrade) []*schema.BiTrade {
+	def := s.definition()
+	now := time.Now()
+
+	ranks := make(map[string]int)
+	for ind, _pair := range s.universe(def, now) {
+		ranks[_pair.Address] = ind + 1
+	}
+
+	var sold []*schema.BiTrade
+	for _, trade := range trades {
+		_pair, err := pair.GetPairInfoForApi(trade.PairAddress, s.set.DB.Database(config.DatabaseName))
+		if err != nil {
+			utils.Warnf("[ RuleStrategy.SellSignals ] GetPairInfoForApi failed: %v, pair: %v", err, trade.PairAddress)
+			continue
+		}
+
+		price := _pair.PriceInUsd
+		if price > trade.HighestPrice {
+			trade.HighestPrice = price
+		}
+
+		env := newPairEnv(_pair, ranks[_pair.Address], now, s.set)
+		env.vars["buyPrice"] = trade.BuyPrice
+		env.vars["highestPrice"] = trade.HighestPrice
+		env.vars["holdTime"] = now.Sub(trade.BuyTime).Seconds()
+		if trade.BuyPrice > 0 {
+			env.vars["earn"] = (price - trade.BuyPrice) / trade.BuyPrice
+		}
+
+		if expr := def.MatchSell(env); expr != nil {
+			trade.SellPrice = price
+			trade.SellReason = expr.String()
+			sold = append(sold, trade)
+		}
+	}
+
+	return sold
+}
+
+// 最近有交易的 pair, 按 universe.sort 倒序, 序号即 rank
+func (s *RuleStrategy) universe(def *rules.Definition, now time.Time) []*schema.Pair {
+	filter := bson.M{}
+	filter["tradeInfoUpdatedAt"] = bson.M{
+		"$gte": now.Add(-def.Universe.Active),
+	}
+
+	limit := def.Universe.Limit
+	options := &options.FindOptions{Limit: &limit}
+	options = options.SetSort(bson.D{bson.E{Key: def.Universe.Sort, Value: -1}})
+
+	pairs, _, err := pair.GetHotPairs(options, &filter, s.set.DB.Database(config.DatabaseName))
+	if err != nil {
+		utils.Errorf("[ RuleStrategy.universe ] %v GetHotPairs err: %v", s.name, err)
+	}
+
+	return pairs
+}
+
+// 规则求值环境: pair 表的数值字段, 派生变量 age(秒)、rank, 以及 pair 的k线
+type pairEnv struct {
+	set  *Setting
+	pair string
+	now  time.Time
+
+	vars   map[string]float64
+	klines map[string][]schema.KLine // 周期名 -> 已取出的柱子
+}
+
+func newPairEnv(_pair *schema.Pair, rank int, now time.Time, set *Setting) *pairEnv {
+	env := &pairEnv{
+		set:    set,
+		pair:   _pair.Address,
+		now:    now,
+		vars:   make(map[string]float64),
+		klines: make(map[string][]schema.KLine),
+	}
+
+	rules.StructVars(_pair, env.vars)
+	env.vars["age"] = now.Sub(_pair.FirstAddPoolTime).Seconds()
+	env.vars["rank"] = float64(rank)
+
+	return env
+}
+
+func (e *pairEnv) Var(name string) (float64, bool) {
+	v, ok := e.vars[name]
+	return v, ok
+}
+
+func (e *pairEnv) Series(field string, tf time.Duration, n int) ([]float64, error) {
+	name, ok := kline.TimeframeByInterval(tf)
+	if !ok {
+		return nil, fmt.Errorf("%w: %v", kline.ErrUnknownTimeframe, tf)
+	}
+
+	// 同一周期取过更多柱子时直接复用
+	bars, ok := e.klines[name]
+	if !ok || len(bars) < n {
+		var err error
+		bars, err = kline.GetRecentKlines(e.pair, name, n, e.now, e.set.DB.Database(config.DatabaseName))
+		if err != nil {
+			return nil, err
+		}
+		e.klines[name] = bars
+	}
+
+	if len(bars) > n {
+		bars = bars[len(bars)-n:]
+	}
+
+	values := make([]float64, 0, len(bars))
+	for _, k := range bars {
+		switch field {
+		case "close":
+			values = append(values, k.PriceInUsd)
+		case "volume":
+			values = append(values, k.VolumeInUsd)
+		case "tx":
+			values = append(values, float64(k.TxNum))
+		}
+	}
+
+	return values, nil
+}
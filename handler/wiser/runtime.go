@@ -0,0 +1,223 @@
+package handler
+
+import (
+	"fmt"
+	"time"
+
+	"sfilter/config"
+	"sfilter/schema"
+	"sfilter/services/eventbus"
+	"sfilter/services/wiser"
+	"sfilter/utils"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	JOB_SELL = iota
+	JOB_BUY
+	JOB_MARK // 模拟盘估值
+)
+
+// 同时运行多个策略, 每个策略一个协程, 策略之间互不阻塞
+// 同一个策略的买卖依次执行, 上一次还没跑完时新的任务排队, 队列满了则跳过
+type Runtime struct {
+	set     *Setting
+	runners []*runner
+}
+
+type runner struct {
+	set      *Setting
+	strategy Strategy
+	conf     *config.StrategyConfig
+	ledger   *Ledger
+	jobs     chan int
+}
+
+func NewRuntime(set *Setting, names []string) (*Runtime, error) {
+	rt := &Runtime{set: set}
+
+	for _, name := range names {
+		s, err := newStrategy(set, name)
+		if err != nil {
+			return nil, err
+		}
+
+		conf := set.Config.Strategy(name)
+		rt.runners = append(rt.runners, &runner{
+			set:      set,
+			strategy: s,
+			conf:     conf,
+			ledger:   NewLedger(set, name, conf),
+			jobs:     make(chan int, config.StrategyJobBufferSize),
+		})
+	}
+
+	return rt, nil
+}
+
+func (rt *Runtime) Start() {
+	for _, r := range rt.runners {
+		r := r
+		go r.loop()
+
+		sch := r.strategy.Schedule()
+
+		// 先订阅卖的, 同一区块触发时先检查卖点, 避免本次新买入的也被检查
+		if sch.Sell > 0 {
+			rt.set.OnNewPeriod(sch.Sell, sch.Offset, func() {
+				r.submit(JOB_SELL)
+			})
+		}
+
+		rt.set.OnNewPeriod(sch.Buy, sch.Offset, func() {
+			r.submit(JOB_BUY)
+		})
+
+		// 启动时先估值一次, 同时创建模拟盘账户
+		r.submit(JOB_MARK)
+		rt.set.OnNewPeriod(config.PaperMarkInterval, 0, func() {
+			r.submit(JOB_MARK)
+		})
+
+		utils.Infof("[ Runtime.Start ] strategy %v started. buy: %v, sell: %v, offset: %v", r.strategy.Name(), sch.Buy, sch.Sell, sch.Offset)
+	}
+}
+
+func (r *runner) submit(job int) {
+	select {
+	case r.jobs <- job:
+	default:
+		utils.Warnf("[ runner.submit ] strategy %v is busy, skip job: %v", r.strategy.Name(), job)
+	}
+}
+
+func (r *runner) loop() {
+	for job := range r.jobs {
+		r.run(job)
+	}
+}
+
+func (r *runner) run(job int) {
+	defer func() {
+		if e := recover(); e != nil {
+			utils.Errorf("[ runner.run ] strategy %v panic: %v, job: %v", r.strategy.Name(), e, job)
+		}
+	}()
+
+	switch job {
+	case JOB_SELL:
+		r.sell()
+	case JOB_BUY:
+		r.buy()
+	case JOB_MARK:
+		r.ledger.Mark(time.Now())
+	}
+}
+
+func (r *runner) buy() {
+	name := r.strategy.Name()
+
+	for _, trade := range r.strategy.BuySignals() {
+		if trade.BuyPrice <= 0 {
+			utils.Warnf("[ runner.buy ] %v invalid buy price: %v, pair: %v", name, trade.BuyPrice, trade.PairName)
+			continue
+		}
+
+		// 同一个策略, 同一个 main token 未卖出前不重复买入
+		if _, err := wiser.GetToBeSoldBiTrade(name, trade.MainToken, r.set.DB); err == nil {
+			utils.Warnf("[ runner.buy ] %v mainToken has been bought. pairName: %v, mainToken: %v", name, trade.PairName, trade.MainToken)
+			continue
+		}
+
+		trade.Strategy = name
+		if trade.BuyTime.IsZero() {
+			trade.BuyTime = time.Now()
+		}
+		if trade.HighestPrice < trade.BuyPrice {
+			trade.HighestPrice = trade.BuyPrice
+		}
+
+		r.deliver(eventbus.SIGNAL_SIDE_BUY, trade)
+	}
+}
+
+func (r *runner) sell() {
+	name := r.strategy.Name()
+
+	trades, err := wiser.GetUnSoldBiTrades(name, r.set.DB)
+	if err != nil {
+		utils.Errorf("[ runner.sell ] %v GetUnSoldBiTrades failed: %v", name, err)
+		return
+	}
+
+	if len(trades) == 0 {
+		return
+	}
+
+	highest := make(map[*schema.BiTrade]float64)
+	for _, trade := range trades {
+		highest[trade] = trade.HighestPrice
+	}
+
+	sold := make(map[*schema.BiTrade]bool)
+	for _, trade := range r.strategy.SellSignals(trades) {
+		if trade.SellTime.IsZero() {
+			trade.SellTime = time.Now()
+		}
+		if trade.BuyPrice > 0 {
+			trade.EarnRatio = (trade.SellPrice - trade.BuyPrice) / trade.BuyPrice
+		}
+		trade.HoldTime = trade.SellTime.Sub(trade.BuyTime)
+		trade.Status = 1 // 表示该币可以重新买入
+
+		sold[trade] = true
+		r.deliver(eventbus.SIGNAL_SIDE_SELL, trade)
+	}
+
+	// 未卖出的保存最高价, 下次使用
+	for _, trade := range trades {
+		if !sold[trade] && trade.HighestPrice != highest[trade] {
+			wiser.UpdateBiTrade(trade, r.set.DB)
+		}
+	}
+}
+
+// 所有策略共用的信号处理: 保存买卖记录, 模拟盘成交, 发布到事件总线, 推送消息
+func (r *runner) deliver(side string, trade *schema.BiTrade) {
+	var msg string
+
+	if side == eventbus.SIGNAL_SIDE_BUY {
+		utils.Infof("[ runner.deliver ] %v buy now. PairName: %v", trade.Strategy, trade.PairName)
+		wiser.SaveBiTrade(trade, r.set.DB)
+		r.ledger.Open(trade)
+
+		msg = fmt.Sprintf("<font color=\"info\">[ **Buy** ]</font>\nStrategy: %v\nPair: [%v](https://www.dextools.io/app/cn/ether/pair-explorer/%v)\nLiquidity: $%v\nTxNumIn1h: %v\nAge: %v\nPrice: %v\nRank: %v\nBuyReason: %v", trade.Strategy, trade.PairName, trade.PairAddress, utils.HumanizeNumber(trade.PairLiquidity), trade.TxNumIn1h, utils.ReadibleDuration(trade.PairAge), trade.BuyPrice, trade.SortRank, trade.BuyReason)
+	} else {
+		utils.Infof("[ runner.deliver ] %v sell now. PairName: %v, reason: %v", trade.Strategy, trade.PairName, trade.SellReason)
+		wiser.UpdateBiTrade(trade, r.set.DB)
+		r.ledger.Close(trade)
+
+		msg = fmt.Sprintf("<font color=\"warning\">[ **Sell** ]</font>\nStrategy: %v\nPair: [%v](https://www.dextools.io/app/cn/ether/pair-explorer/%v)\nEarn: <font color=\"comment\">%.2f%%</font>\nReason: <font color=\"comment\">**%v**</font>\nSellPrice: %v\nBuyTime: %v\nBuyRank: %v\nHoldTime: %v\nBuyCounts: %v\nLiquidity: $%v", trade.Strategy, trade.PairName, trade.PairAddress, trade.EarnRatio*100, trade.SellReason, trade.SellPrice, trade.BuyTime.In(time.FixedZone("UTC+8", 8*60*60)).Format("01-02 15:04"), trade.SortRank, utils.ReadibleDuration(trade.HoldTime), r.getSoldCounts(trade), utils.HumanizeNumber(trade.PairLiquidity))
+	}
+
+	r.set.Bus.Publish(eventbus.TopicTradeSignal, 0, &eventbus.TradeSignal{Side: side, Trade: trade})
+
+	if r.conf.HookUrl != "" {
+		utils.SendWecommBot(r.conf.HookUrl, msg)
+	}
+}
+
+// 本策略对该 main token 已完成的交易次数
+func (r *runner) getSoldCounts(trade *schema.BiTrade) int64 {
+	filter := bson.M{}
+	filter["strategy"] = trade.Strategy
+	filter["status"] = 1
+	filter["mainToken"] = trade.MainToken
+
+	options := &options.FindOptions{}
+	_, count, _ := wiser.GetBiTrades(options, &filter, r.set.DB.Database(config.DatabaseName))
+
+	return count
+}
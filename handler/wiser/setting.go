@@ -1,34 +1,29 @@
 package handler
 
 import (
-	"context"
 	"sfilter/config"
 	"sfilter/schema"
 	"sfilter/services/chain"
+	"sfilter/services/eventbus"
 	"sfilter/services/pair"
 	"sfilter/services/token"
 	"sfilter/utils"
 	"time"
 
 	"go.mongodb.org/mongo-driver/mongo"
-	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 type Setting struct {
 	DB     *mongo.Client
+	Chain  *chain.Service
+	Bus    *eventbus.Bus // 订阅 sfilter 发布的事件
 	Config *config.WiserConfig
 
 	Tokens schema.TokenMap
 	Pairs  schema.PairMap
 }
 
-func NewSetting(account, db string, debug bool) *Setting {
-	clientOptions := options.Client().ApplyURI(config.MONGO_ADDR)
-	mongodb, err := mongo.Connect(context.Background(), clientOptions)
-	if err != nil {
-		utils.Fatalf("connect mongo error: %v", err)
-	}
-
+func NewSetting(svc *chain.Service, account string, debug bool) *Setting {
 	wiserConfig := config.DefaultWiserConfig
 	if account != "" {
 		wiserConfig.DebugAccount = account
@@ -38,13 +33,11 @@ func NewSetting(account, db string, debug bool) *Setting {
 		wiserConfig.DebugMode = true
 	}
 
-	if db != "" {
-		config.DatabaseName = db
-	}
 	// other config change..
 
 	set := &Setting{
-		DB:     mongodb,
+		DB:     svc.DB().Client(),
+		Chain:  svc,
 		Config: wiserConfig,
 	}
 
@@ -86,7 +79,7 @@ func (s *Setting) checkPairValidation() {
 			s.Config.ForceUpdatePairHackStatus {
 			// 先判断是否有 pairType
 			if _pair.Type == 0 {
-				_type, err := chain.GetUniPoolType(_pair.Address)
+				_type, err := s.Chain.GetUniPoolType(_pair.Address)
 				if err != nil || _type == 0 {
 					// utils.Warnf("[ checkPairValidation ] GetUniPoolType failed. pair: %v, _type: %v, err: %v", _pair.Address, _type, err)
 
@@ -98,7 +91,7 @@ func (s *Setting) checkPairValidation() {
 			}
 
 			if _pair.Type == schema.SWAP_EVENT_UNISWAPV2_LIKE {
-				_pair.MainTokenHackType = chain.GetUniV2PoolTokenHackType(_pair)
+				_pair.MainTokenHackType = s.Chain.GetUniV2PoolTokenHackType(_pair)
 			} else {
 				_pair.MainTokenHackType = schema.PAIR_MAINTOKEN_HACK_TYPE_UNKNOWN
 			}
@@ -113,3 +106,37 @@ func (s *Setting) checkPairValidation() {
 
 	utils.Infof("[ checkPairValidation ] finished checkPairValidation.")
 }
+
+// 区块时间进入新的周期时执行 fn, 代替原来的 cron 轮询
+// BlockProcessed 发布时该区块数据已全部写入, 不再需要延迟几十秒执行
+// 太旧的区块(如启动时追赶的历史区块)不触发
+// offset 为周期起点相对 utc 0点的偏移
+func (s *Setting) OnNewPeriod(period, offset time.Duration, fn func()) {
+	var last int64
+
+	s.Bus.Subscribe(eventbus.TopicBlockProcessed, func(ev *eventbus.Event) {
+		var data eventbus.BlockProcessed
+		if err := ev.Decode(&data); err != nil || data.Block == nil {
+			utils.Warnf("[ OnNewPeriod ] decode event failed: %v, block: %v", err, ev.BlockNo)
+			return
+		}
+
+		if time.Since(time.Unix(data.Block.BlockTime, 0)) > period {
+			return
+		}
+
+		current := (data.Block.BlockTime - int64(offset.Seconds())) / int64(period.Seconds())
+		if current <= last {
+			return
+		}
+
+		// 启动后的第一个区块只记录周期, 和 cron 一样等到下一个周期开始才执行
+		first := last == 0
+		last = current
+		if first {
+			return
+		}
+
+		fn()
+	})
+}
@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"sfilter/schema"
+)
+
+// 热点币策略, 由 Runtime 按 Schedule 调用
+// 策略只负责找出买卖点, 买卖记录的保存、同一 main token 的去重与信号推送由 Runtime 统一处理
+type Strategy interface {
+	Name() string
+
+	Schedule() Schedule
+
+	// 买点, 返回待买入的 trade, 至少填好 pair 信息与 BuyPrice
+	BuySignals() []*schema.BiTrade
+
+	// 检查本策略未卖出的 trade, 返回需要卖出的, 需填好 SellPrice 与 SellReason
+	// 可以更新 trade 的 HighestPrice, 由 Runtime 保存
+	SellSignals(trades []*schema.BiTrade) []*schema.BiTrade
+}
+
+// 策略的执行周期, 按区块时间触发
+type Schedule struct {
+	Buy    time.Duration // 检查买点的周期
+	Sell   time.Duration // 检查卖点的周期, 0 表示不检查
+	Offset time.Duration // 周期起点相对 utc 0点的偏移, 如 16h 表示每天 utc 16点(东八区0点)
+}
+
+// 可在线运行的策略, hsp 只用于回测
+var strategyBuilders = map[string]func(set *Setting) Strategy{
+	"hb": func(set *Setting) Strategy { return NewHBPair(set) },
+	"hs": func(set *Setting) Strategy { return NewHSPair(set) },
+	"hn": func(set *Setting) Strategy { return NewHNPair(set) },
+}
+
+// 内置策略与 RuleDir 下的规则策略, 同名时使用内置策略
+func StrategyNames(set *Setting) []string {
+	var names []string
+	for name := range strategyBuilders {
+		names = append(names, name)
+	}
+
+	for _, name := range ruleStrategyNames(set) {
+		if _, ok := strategyBuilders[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// 按名称创建策略, 并用配置中的参数覆盖默认参数
+// 不是内置策略时查找同名的规则文件
+func newStrategy(set *Setting, name string) (Strategy, error) {
+	build, ok := strategyBuilders[name]
+	if !ok {
+		if _, err := os.Stat(ruleStrategyPath(set, name)); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrUnknownStrategy, name)
+		}
+
+		return NewRuleStrategy(set, name)
+	}
+
+	s := build(set)
+
+	if params := set.Config.Strategy(name).Params; params != "" {
+		if err := json.Unmarshal([]byte(params), s); err != nil {
+			return nil, fmt.Errorf("parse %v params error: %v", name, err)
+		}
+	}
+
+	return s, nil
+}
@@ -3,13 +3,15 @@ package handler
 import (
 	"encoding/json"
 	"fmt"
-	"math"
 	"sfilter/config"
 	"sfilter/schema"
 	"sfilter/services/chain"
+	"sfilter/services/eventbus"
 	"sfilter/services/pair"
 	"sfilter/services/wiser"
 	"sfilter/utils"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/robfig/cron"
@@ -24,6 +26,18 @@ type Wiser struct {
 	wiserInspect bool
 
 	epoch string
+
+	round map[string]bool // 本轮统计的地址, 统计期间只读
+
+	mu           sync.Mutex
+	deferred     map[string]*movedWait // 本轮等待转出方的地址, 下一轮继续统计
+	lastDeferred map[string]*movedWait // 上一轮等待的地址
+
+	safeBlock uint64   // sfilter 连续写入到的区块(IndexedThrough), 之前的交易已全部入库. atomic
+	lastBlock uint64   // 上一轮统计到的区块
+	reorgFrom uint64   // 待处理的重组的最低孤块, 在统计协程中处理. atomic
+	reorged   []string // 重组后需要从头统计的地址, 在下一轮统计
+	jobs      chan struct{}
 }
 
 func (w *Wiser) Run() {
@@ -32,6 +46,54 @@ func (w *Wiser) Run() {
 		// return
 	}
 
+	// 统计耗时较长, 放到单独的协程, 上一轮还没跑完时跳过
+	w.jobs = make(chan struct{}, 1)
+	go w.loop()
+
+	w.set.Bus.Subscribe(eventbus.TopicBlockProcessed, func(ev *eventbus.Event) {
+		var data eventbus.BlockProcessed
+		if err := ev.Decode(&data); err != nil || data.Block == nil {
+			utils.Warnf("[ Wiser.Run ] decode event failed: %v, block: %v", err, ev.BlockNo)
+			return
+		}
+
+		// 回填、调试的区块不连续, 不能作为统计的截止区块
+		if data.IndexedThrough <= 0 {
+			return
+		}
+
+		// 启动后收到第一个区块时先统计一次
+		if atomic.SwapUint64(&w.safeBlock, uint64(data.IndexedThrough)) == 0 {
+			w.submit()
+		}
+	})
+
+	w.set.Bus.Subscribe(eventbus.TopicBlockReorged, func(ev *eventbus.Event) {
+		from := uint64(ev.BlockNo)
+		if from == 0 {
+			return
+		}
+
+		// 截止区块退回到孤块之前, 等主链上的区块重新写入
+		for {
+			safe := atomic.LoadUint64(&w.safeBlock)
+			if safe < from || atomic.CompareAndSwapUint64(&w.safeBlock, safe, from-1) {
+				break
+			}
+		}
+
+		for {
+			pending := atomic.LoadUint64(&w.reorgFrom)
+			if (pending != 0 && pending <= from) || atomic.CompareAndSwapUint64(&w.reorgFrom, pending, from) {
+				break
+			}
+		}
+
+		w.submit()
+	})
+
+	w.set.OnNewPeriod(time.Duration(w.set.Config.WiserSearchInterval)*time.Second, 0, w.submit)
+
 	c := cron.New()
 
 	spec_hour := "20 0/5 * * * *"
@@ -87,7 +149,7 @@ func (w *Wiser) Save1HourTopRank() {
 func (w *Wiser) Test(accounts []string) {
 	var contract, notContract int
 	for _, account := range accounts {
-		isContract := chain.IsContract(account)
+		isContract := w.set.Chain.IsContract(account)
 		utils.Infof("[ Test ] account: %v is contract: %v", account, isContract)
 
 		if isContract > 0 {
@@ -100,48 +162,208 @@ func (w *Wiser) Test(accounts []string) {
 	utils.Fatalf("[ Test ] contract: %v, notContract: %v", contract, notContract)
 }
 
+func (w *Wiser) submit() {
+	select {
+	case w.jobs <- struct{}{}:
+	default:
+		utils.Warnf("[ Wiser.submit ] wiser searcher is busy, skip.")
+	}
+}
+
+func (w *Wiser) loop() {
+	for range w.jobs {
+		w.WiserSearcher()
+	}
+}
+
+// 增量统计: 只统计上一轮之后有过交易的地址, 每个地址只读取其 cursor 之后的交易
+// 进入新的 epoch 时, 所有统计过的地址都重新统计一遍, 持仓按当前价格结算, 超出统计周期的 deal 不再计入
 func (w *Wiser) WiserSearcher() {
-	var accounts []string
-	if w.set.Config.DebugAccount != "" {
-		accounts = append(accounts, w.set.Config.DebugAccount)
-	} else {
-		var err error
-		accounts, err = wiser.GetActiveAccounts(w.set.Config.AccountActiveSeconds, w.set.DB)
-		if err != nil {
-			utils.Fatalf("[ WiserSearcher ] GetActiveAccounts error:  %v", err)
-			return
+	defer func() {
+		if e := recover(); e != nil {
+			utils.Errorf("[ WiserSearcher ] panic: %v", e)
 		}
+	}()
+
+	w.rollbackReorg()
+
+	toBlock := atomic.LoadUint64(&w.safeBlock)
+	if toBlock == 0 || toBlock <= w.lastBlock {
+		return
 	}
-	utils.Infof("[ WiserSearcher ] accounts len: %v, they are: %v", len(accounts), accounts)
 
-	if w.dealInspect {
-		if !w.set.Config.DebugMode && w.set.Config.DebugAccount == "" {
-			wiser.ResetDealCollection(w.set.DB)
-		}
-		for _, account := range accounts {
-			w.InspectBiDeals(account)
+	epoch := time.Now().Format("20060102")
+	refresh := epoch != w.epoch
+
+	accounts, err := w.getSearchAccounts(toBlock, refresh)
+	if err != nil {
+		utils.Errorf("[ WiserSearcher ] get accounts error: %v", err)
+		return
+	}
+	utils.Infof("[ WiserSearcher ] accounts len: %v, blocks: (%v, %v], refresh: %v", len(accounts), w.lastBlock, toBlock, refresh)
+
+	if refresh {
+		w.epoch = epoch
+	}
+
+	// 上一轮等待转出方的地址本轮继续统计, 重启后丢失的等到该地址再次活跃或新的 epoch 时统计
+	w.lastDeferred, w.deferred = w.deferred, nil
+	w.round = make(map[string]bool)
+	for _, account := range accounts {
+		w.round[account] = true
+	}
+	for account := range w.lastDeferred {
+		if !w.round[account] {
+			w.round[account] = true
+			accounts = append(accounts, account)
 		}
 	}
-	if w.wiserInspect {
-		w.epoch = time.Now().Format("20060102")
-		if !w.set.Config.DebugMode && w.set.Config.DebugAccount == "" {
-			wiser.ResetWiserEpochData(w.set.DB, w.epoch)
+	for _, account := range w.reorged {
+		if !w.round[account] {
+			w.round[account] = true
+			accounts = append(accounts, account)
 		}
+	}
+	w.reorged = nil
 
-		for _, account := range accounts {
+	w.inspectAccounts(accounts, func(account string) {
+		if w.dealInspect {
+			w.InspectBiDeals(account, toBlock, refresh)
+		}
+		if w.wiserInspect {
 			w.InspectAccount(account)
 		}
+	})
+	w.lastBlock = toBlock
 
-		if !w.set.Config.DebugMode && w.set.Config.DebugAccount == "" {
-			jsonString, _ := json.Marshal(w.set.Config)
-			config := &schema.WiserDBConfig{
-				Epoch:  w.epoch,
-				Config: string(jsonString),
-			}
-			wiser.UpdateWiserConfig(config, w.set.DB) // 更新本次epoch
+	if w.wiserInspect && !w.set.Config.DebugMode && w.set.Config.DebugAccount == "" {
+		jsonString, _ := json.Marshal(w.set.Config)
+		config := &schema.WiserDBConfig{
+			Epoch:  w.epoch,
+			Config: string(jsonString),
+		}
+		wiser.UpdateWiserConfig(config, w.set.DB) // 更新本次epoch
+	}
+}
+
+// 统计到孤块的地址删除孤块之后的deal, 持仓没有历史无法退回, 删除持仓与 cursor 后从头统计
+func (w *Wiser) rollbackReorg() {
+	from := atomic.SwapUint64(&w.reorgFrom, 0)
+	if from == 0 {
+		return
+	}
+
+	if w.lastBlock >= from {
+		w.lastBlock = from - 1
+	}
+
+	accounts, err := wiser.GetWiserCursorAccountsFrom(from, w.set.DB)
+	if err != nil {
+		utils.Errorf("[ rollbackReorg ] GetWiserCursorAccountsFrom(%v) err: %v", from, err)
+		return
+	}
+
+	for _, account := range accounts {
+		wiser.DeleteDealsFrom(account, from, w.set.DB)
+		wiser.ResetWiserAccount(account, w.set.DB)
+	}
+
+	w.reorged = append(w.reorged, accounts...)
+	utils.Warnf("[ rollbackReorg ] reorg from block: %v, reset accounts: %v", from, len(accounts))
+}
+
+// 转入方等待转出方记录 lots 的区块与轮数
+type movedWait struct {
+	block  uint64
+	rounds int
+}
+
+// 记录等待转出方的地址, 同一区块等待超过 WiserMovedLotsWaitRounds 轮时返回 false, 不再等待
+func (w *Wiser) deferAccount(account string, block uint64) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	wait := &movedWait{block: block, rounds: 1}
+	if last, ok := w.lastDeferred[account]; ok && last.block == block {
+		wait.rounds = last.rounds + 1
+	}
+	if wait.rounds > config.WiserMovedLotsWaitRounds {
+		return false
+	}
+
+	if w.deferred == nil {
+		w.deferred = make(map[string]*movedWait)
+	}
+	w.deferred[account] = wait
+
+	return true
+}
+
+// 本轮需要统计的地址. refresh 时为最近活跃的与所有统计过的地址, 否则为上一轮之后有过交易的地址
+func (w *Wiser) getSearchAccounts(toBlock uint64, refresh bool) ([]string, error) {
+	if w.set.Config.DebugAccount != "" {
+		return []string{w.set.Config.DebugAccount}, nil
+	}
+
+	if !refresh {
+		return wiser.GetActiveAccountsInBlocks(w.lastBlock, toBlock, w.set.DB)
+	}
+
+	accounts, err := wiser.GetActiveAccounts(w.set.Config.AccountActiveSeconds, w.set.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	known, err := wiser.GetWiserCursorAccounts(w.set.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	for _, account := range accounts {
+		seen[account] = true
+	}
+	for _, account := range known {
+		if !seen[account] {
+			seen[account] = true
+			accounts = append(accounts, account)
 		}
 	}
 
+	return accounts, nil
+}
+
+// WiserWorkerNum 个协程同时统计, 全部完成后返回
+func (w *Wiser) inspectAccounts(accounts []string, fn func(account string)) {
+	ch := make(chan string)
+
+	var wg sync.WaitGroup
+	for i := 0; i < config.WiserWorkerNum; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for account := range ch {
+				w.inspectSafely(account, fn)
+			}
+		}()
+	}
+
+	for _, account := range accounts {
+		ch <- account
+	}
+	close(ch)
+
+	wg.Wait()
+}
+
+func (w *Wiser) inspectSafely(account string, fn func(account string)) {
+	defer func() {
+		if e := recover(); e != nil {
+			utils.Errorf("[ inspectAccounts ] account %v panic: %v", account, e)
+		}
+	}()
+
+	fn(account)
 }
 
 func (w *Wiser) FindTopXPairs(topN int64, sortKey string) []*schema.Pair {
@@ -182,15 +404,20 @@ func (w *Wiser) FindTopXPairs(topN int64, sortKey string) []*schema.Pair {
 func (w *Wiser) InspectAccount(account string) {
 	utils.Infof("[ InspectAccount ] account: %v", account)
 
-	// 先取出该地址所有deals
-	deals, err := wiser.GetAccountAllDeals(account, w.set.DB)
+	// 先取出该地址统计周期内所有deals
+	since := time.Now().Add(-time.Duration(w.set.Config.LatestSwapSeconds) * time.Second)
+	deals, err := wiser.GetAccountAllDeals(account, since, w.set.DB)
 	if err != nil {
 		utils.Errorf("[ InspectAccount ] failed to GetAccountAllDeals: %v", err)
 		return
 	}
 
+	// 本epoch之前符合条件的, 现在不符合了需要删除
+	addressWithEpoch := fmt.Sprintf("%v_%v", account, w.epoch)
+
 	if w.set.Config.DebugAccount == "" && len(deals) < int(w.set.Config.DealThresholdPerMon) {
 		utils.Debugf("[ InspectAccount ] GetAccountAllDeals failed or too less deals.  account: %v, len: %v", account, len(deals))
+		wiser.DeleteWiser(addressWithEpoch, w.set.DB)
 		return
 	}
 
@@ -198,8 +425,8 @@ func (w *Wiser) InspectAccount(account string) {
 	_wiser := w.inspectAccountByDeals(account, deals)
 
 	// 获取余额
-	ethBalance, err1 := chain.GetAccountEthBalance(account)
-	wethBalance, err2 := chain.GetAccountWEthBalance(account)
+	ethBalance, err1 := w.set.Chain.GetAccountEthBalance(account)
+	wethBalance, err2 := w.set.Chain.GetAccountWEthBalance(account)
 	if err1 != nil || err2 != nil {
 		utils.Errorf("[ InspectAccount ] GetAccountEthBalance failed. err1: %v, err2: %v", err1, err2)
 		return
@@ -207,11 +434,13 @@ func (w *Wiser) InspectAccount(account string) {
 	_wiser.EthBalance = ethBalance + wethBalance
 
 	// 判断是否为合约
-	_wiser.IsContract = chain.IsContract(_wiser.Address)
+	_wiser.IsContract = w.set.Chain.IsContract(_wiser.Address)
 
 	isValid := w.isWiserNeedBePicked(&_wiser)
 	if isValid {
 		wiser.SaveWiser(&_wiser, w.set.DB)
+	} else {
+		wiser.DeleteWiser(addressWithEpoch, w.set.DB)
 	}
 
 	// debug
@@ -318,7 +547,7 @@ func (w *Wiser) inspectAccountByDeals(account string, deals []schema.BiDeal) sch
 		wiser.ValidTradeCount++
 		wiser.TotalWinValue += deal.Earn
 
-		totalCost += math.Abs(deal.Earn / deal.EarnChange)
+		totalCost += deal.CostBasis
 
 		if deal.EarnChange >= w.set.Config.DealProfitTarget {
 			winTimes++ // 盈利率合格
@@ -428,13 +657,13 @@ func (w *Wiser) updateOtherProfile(wiser *schema.Wiser, deflatPairTimes, buyZero
 	}
 }
 
-func TEST_WISER() {
+func TEST_WISER(svc *chain.Service) {
 	options := &options.FindOptions{}
 	options = options.SetSort(bson.D{{Key: "weight", Value: -1}})
 	filter := bson.M{}
 	filter["epoch"] = "20240127"
-	address_oldS, _, _ := wiser.GetWisers(options, &filter, chain.GetMongo().Database("sfilter"))
-	address_newS, _, _ := wiser.GetWisers(options, &filter, chain.GetMongo().Database("creat"))
+	address_oldS, _, _ := wiser.GetWisers(options, &filter, svc.DB().Client().Database("sfilter"))
+	address_newS, _, _ := wiser.GetWisers(options, &filter, svc.DB().Client().Database("creat"))
 
 	var old, new []string
 	for _, addr := range address_oldS {
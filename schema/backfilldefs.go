@@ -0,0 +1,33 @@
+package schema
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// 历史区块回填任务的进度, 用于崩溃后继续
+type BackfillCheckpoint struct {
+	TaskId    string `json:"taskId" bson:"taskId"` // 命令行的 from_to, 省略 to 时为 from_0
+	FromBlock int64  `json:"fromBlock" bson:"fromBlock"`
+	ToBlock   int64  `json:"toBlock" bson:"toBlock"` // 省略 to 时为第一次运行时的链头
+
+	NextBlock int64 `json:"nextBlock" bson:"nextBlock"` // 该区块之前(不含)的都已处理完毕, 重启后从这里继续
+	Proceeded int64 `json:"proceeded" bson:"proceeded"` // 已处理的区块数
+
+	FailedBlocks []int64 `json:"failedBlocks" bson:"failedBlocks"` // 本次多次重试仍失败的区块, NextBlock 不会越过它们, 重新运行时重试
+
+	Finished bool `json:"finished" bson:"finished"`
+
+	UpdatedAt time.Time `json:"updatedAt" bson:"updatedAt"`
+	CreatedAt time.Time `json:"createdAt" bson:"createdAt"`
+}
+
+var BackfillIndexModel = []mongo.IndexModel{
+	{
+		Keys:    bson.D{{Key: "taskId", Value: 1}},
+		Options: options.Index().SetName("taskId_index").SetUnique(true),
+	},
+}
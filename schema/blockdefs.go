@@ -3,6 +3,8 @@ package schema
 import (
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -28,20 +30,45 @@ type Block struct {
 	EthPrice       float64
 
 	Transactions []*Transaction
+
+	// debug_traceBlockByHash callTracer 的结果, key 为 tx hash
+	Traces              map[common.Hash]*CallFrame
+	InternalTraceStatus int
+}
+
+// callTracer 返回的调用帧
+type CallFrame struct {
+	Type  string       `json:"type"` // CALL, DELEGATECALL, STATICCALL, CREATE 等
+	From  string       `json:"from"`
+	To    string       `json:"to"`
+	Value *hexutil.Big `json:"value,omitempty"`
+	Error string       `json:"error,omitempty"` // 非空表示该调用(及其子调用)已回滚
+
+	Calls []*CallFrame `json:"calls,omitempty"`
 }
 
 // 该表的目的是确认是否已经被处理, 防止重复
+// 同时记录 hash 与 parentHash, 用于检测链重组
+
+// 之前的数据没有 status 字段, 读出为0, 视为已确认
+const (
+	BLOCK_STATUS_CONFIRMED   int = iota // 已达到确认深度, 视为最终数据
+	BLOCK_STATUS_UNCONFIRMED            // 未达到确认深度, 可能被回滚
+)
 
 type BlockProceeded struct {
-	BlockNo   int64   `json:"blockNo" bson:"blockNo"`     // 区块号
-	Hash      string  `json:"hash" bson:"hash"`           // 哈希
-	BlockTime int64   `json:"blockTime" bson:"blockTime"` // 区块打包时间
-	EthPrice  float64 `json:"ethPrice" bson:"ethPrice"`   // eth价格 by usd. 每个区块从usdc/eth v3 pool中获取
+	BlockNo    int64   `json:"blockNo" bson:"blockNo"`       // 区块号
+	Hash       string  `json:"hash" bson:"hash"`             // 哈希
+	ParentHash string  `json:"parentHash" bson:"parentHash"` // 父区块哈希
+	BlockTime  int64   `json:"blockTime" bson:"blockTime"`   // 区块打包时间
+	EthPrice   float64 `json:"ethPrice" bson:"ethPrice"`     // eth价格 by usd. 从原生币价格序列中获取
+
+	TxNums int `json:"txNums" bson:"txNums"`
 
-	TxNums      int     `json:"txNums" bson:"txNums"`
-	VolumeByUsd float64 `json:"volumeByUsd" bson:"volumeByUsd"`
+	InternalTraceStatus int     `json:"internalTraceStatus" bson:"internalTraceStatus"` // 内部转账是否已获取
+	VolumeByUsd         float64 `json:"volumeByUsd" bson:"volumeByUsd"`
 
-	Status    int       `json:"status" bson:"status"` // 状态，目前未使用
+	Status    int       `json:"status" bson:"status"` // 确认状态, 见 BLOCK_STATUS_*
 	CreatedAt time.Time `json:"-" bson:"createdAt"`   // 创建时间
 }
 
@@ -58,4 +85,8 @@ var BlockProceededIndexModel = []mongo.IndexModel{
 		Keys:    bson.D{{Key: "blockNo", Value: 1}},
 		Options: options.Index().SetName("blockNo_index"),
 	},
+	{
+		Keys:    bson.D{{Key: "status", Value: 1}},
+		Options: options.Index().SetName("status_index"),
+	},
 }
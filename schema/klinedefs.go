@@ -2,6 +2,7 @@ package schema
 
 import (
 	"sfilter/config"
+	"sfilter/utils"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -54,6 +55,13 @@ type KLine struct {
 
 	Volume float64 `bson:"volume" json:"volume"`
 
+	// 精确值, 上面的 float 字段由其转换而来
+	OpenPriceDecimal  utils.Decimal `bson:"openPriceDecimal" json:"openPriceDecimal"`
+	ClosePriceDecimal utils.Decimal `bson:"closePriceDecimal" json:"closePriceDecimal"`
+	HighPriceDecimal  utils.Decimal `bson:"highPriceDecimal" json:"highPriceDecimal"`
+	LowPriceDecimal   utils.Decimal `bson:"lowPriceDecimal" json:"lowPriceDecimal"`
+	VolumeDecimal     utils.Decimal `bson:"volumeDecimal" json:"volumeDecimal"`
+
 	// 作用: 由于为了节省表行数, 因此一个字段有多个k线数据
 	// 因此增加一个 时间 表示当前KLine表述时间
 	// 由于每次udpate的时候, 发现柱子已过期, 会清空当前小时内的所有柱子
@@ -113,11 +121,11 @@ type KLines1Hour struct {
 	KLineCreatTime `bson:",inline"`
 }
 
-// 日线, 每年一行记录; 暂时不考虑自动删除
+// 日线, 每月一行记录
 type KLines1Day struct {
 	KLinePairInfo `bson:",inline"` // inline表示内连展开存储
 
-	// 年份; 通过 pairl与year 查找到具体的行
+	// Pair_Year_Month 组合; 通过 pair 与年月查找到具体的行
 	PairYearMonth string `json:"pairYearMonth" bson:"pairYearMonth"`
 
 	Kline KLinesForMonth `json:"klines" bson:"klines"`
@@ -164,3 +172,22 @@ var Kline1HourIndexModel = []mongo.IndexModel{
 		Options: options.Index().SetName("baseToken_index"),
 	},
 }
+
+var Kline1DayIndexModel = []mongo.IndexModel{
+	{
+		Keys:    bson.D{{Key: "timestamp", Value: -1}},
+		Options: options.Index().SetName("timestamp_index").SetExpireAfterSeconds(config.Kline1DayTableSaveTime),
+	},
+	{
+		Keys:    bson.D{{Key: "pair", Value: 1}},
+		Options: options.Index().SetName("pair_index"),
+	},
+	{
+		Keys:    bson.D{{Key: "pairYearMonth", Value: 1}},
+		Options: options.Index().SetName("pairYearMonth_index").SetUnique(true),
+	},
+	{
+		Keys:    bson.D{{Key: "baseToken", Value: 1}},
+		Options: options.Index().SetName("baseToken_index"),
+	},
+}
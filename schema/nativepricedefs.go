@@ -0,0 +1,28 @@
+package schema
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// 原生币(eth/bnb等)的usd价格序列, 由参考池子中的swap生成
+// 只在有参考池子成交的区块写入一条
+type NativePrice struct {
+	BlockNo   int64     `json:"blockNo" bson:"blockNo"`
+	BlockTime time.Time `json:"blockTime" bson:"blockTime"`
+
+	Price float64 `json:"price" bson:"price"` // 各参考池子最近成交价格的中位数
+	Pools int     `json:"pools" bson:"pools"` // 参与计算的池子数
+
+	CreatedAt time.Time `json:"-" bson:"createdAt"`
+}
+
+var NativePriceIndexModel = []mongo.IndexModel{
+	{
+		Keys:    bson.D{{Key: "blockNo", Value: -1}},
+		Options: options.Index().SetName("blockNo_index").SetUnique(true),
+	},
+}
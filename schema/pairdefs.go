@@ -15,6 +15,7 @@ type Pair struct {
 	InfoOnChain       `bson:",inline"`
 	InfoOnPairCreated `bson:",inline"`
 	InfoOnPools       `bson:",inline"`
+	InfoOnPoolState   `bson:",inline"`
 
 	TradeInfoForPair `bson:",inline"`
 
@@ -38,6 +39,11 @@ type InfoOnPairCreated struct {
 	PairCreatedHash    string `json:"pairCreatedHash" bson:"pairCreatedHash"`
 
 	PairFee int64 `json:"pairFee" bson:"pairFee"`
+
+	// 多币池(curve, balancer)或单例合约(uniswap v4)中的币对, address 为生成的虚拟地址
+	// PoolAddress 为实际的池子地址, PoolId 为 balancer/v4 的 poolId
+	PoolAddress string `json:"poolAddress,omitempty" bson:"poolAddress,omitempty"`
+	PoolId      string `json:"poolId,omitempty" bson:"poolId,omitempty"`
 }
 
 // add liquidity etc..
@@ -49,6 +55,15 @@ type InfoOnPools struct {
 	FirstAddGasPrice    string    `json:"firstAddGasPrice" bson:"firstAddGasPrice"`
 }
 
+// v3 等集中流动性池子的当前状态, 取最近一笔swap后的值
+type InfoOnPoolState struct {
+	SqrtPriceX96    string `json:"sqrtPriceX96,omitempty" bson:"sqrtPriceX96,omitempty"`
+	ActiveLiquidity string `json:"activeLiquidity,omitempty" bson:"activeLiquidity,omitempty"` // 当前tick区间内的流动性
+	CurrentTick     int64  `json:"currentTick" bson:"currentTick"`
+
+	PoolStateBlockNo uint64 `json:"poolStateBlockNo,omitempty" bson:"poolStateBlockNo,omitempty"` // 状态对应的区块, 防止回溯时覆盖新数据
+}
+
 type InfoOnChain struct {
 	Address string `json:"address" bson:"address"` // 地址
 
@@ -0,0 +1,124 @@
+package schema
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// 模拟盘成交价的来源
+const (
+	PAPER_FILL_POOL   = "pool"   // 按池子当前储备/quoter 询价成交
+	PAPER_FILL_SIGNAL = "signal" // 询价失败, 按信号价格扣除手续费成交
+)
+
+// 模拟盘持仓状态
+const (
+	PAPER_POSITION_OPEN int = iota
+	PAPER_POSITION_CLOSED
+)
+
+// 策略的模拟盘账户, 每个策略一个
+type PaperAccount struct {
+	Strategy string `json:"strategy" bson:"strategy"` // unique key
+
+	InitialCapital float64 `json:"initialCapital" bson:"initialCapital"`
+	Cash           float64 `json:"cash" bson:"cash"`
+
+	RealizedPnl float64 `json:"realizedPnl" bson:"realizedPnl"` // 已平仓的累计盈亏, 已扣除手续费与 gas
+	GasCost     float64 `json:"gasCost" bson:"gasCost"`         // 累计 gas 成本
+
+	// 最近一次估值
+	PositionValue float64 `json:"positionValue" bson:"positionValue"`
+	Equity        float64 `json:"equity" bson:"equity"`
+
+	UpdatedAt time.Time `json:"updatedAt" bson:"updatedAt"`
+	CreatedAt time.Time `json:"createdAt" bson:"createdAt"`
+}
+
+// 模拟盘的一笔持仓, 平仓后即为一笔已完成交易
+type PaperPosition struct {
+	Id primitive.ObjectID `json:"id" bson:"_id,omitempty"`
+
+	Strategy    string `json:"strategy" bson:"strategy"`
+	MainToken   string `json:"mainToken" bson:"mainToken"`
+	PairAddress string `json:"pairAddress" bson:"pairAddress"`
+	PairName    string `json:"pairName" bson:"pairName"`
+
+	Amount float64 `json:"amount" bson:"amount"` // 买到的 main token 数量
+	Cost   float64 `json:"cost" bson:"cost"`     // 买入花费的 usd, 含 gas
+
+	// entry
+	EntryPrice  float64   `json:"entryPrice" bson:"entryPrice"`   // 实际成交均价
+	SignalPrice float64   `json:"signalPrice" bson:"signalPrice"` // 信号价格, 与成交价的差即滑点
+	EntryFill   string    `json:"entryFill" bson:"entryFill"`
+	EntryTime   time.Time `json:"entryTime" bson:"entryTime"`
+
+	// mark to market
+	MarkPrice     float64   `json:"markPrice" bson:"markPrice"`
+	MarkValue     float64   `json:"markValue" bson:"markValue"`
+	UnrealizedPnl float64   `json:"unrealizedPnl" bson:"unrealizedPnl"`
+	MarkTime      time.Time `json:"markTime" bson:"markTime"`
+
+	// exit
+	ExitPrice  float64   `json:"exitPrice" bson:"exitPrice"`
+	ExitValue  float64   `json:"exitValue" bson:"exitValue"` // 卖出所得 usd, 已扣除 gas
+	ExitFill   string    `json:"exitFill" bson:"exitFill"`
+	ExitReason string    `json:"exitReason" bson:"exitReason"`
+	ExitTime   time.Time `json:"exitTime" bson:"exitTime"`
+
+	RealizedPnl float64 `json:"realizedPnl" bson:"realizedPnl"`
+	EarnRatio   float64 `json:"earnRatio" bson:"earnRatio"`
+
+	Status int `json:"status" bson:"status"`
+
+	UpdatedAt time.Time `json:"updatedAt" bson:"updatedAt"`
+	CreatedAt time.Time `json:"createdAt" bson:"createdAt"`
+}
+
+// 模拟盘资金曲线上的一个点
+type PaperEquity struct {
+	Strategy string    `json:"strategy" bson:"strategy"`
+	Time     time.Time `json:"time" bson:"time"`
+
+	Cash          float64 `json:"cash" bson:"cash"`
+	PositionValue float64 `json:"positionValue" bson:"positionValue"`
+	Equity        float64 `json:"equity" bson:"equity"`
+
+	RealizedPnl   float64 `json:"realizedPnl" bson:"realizedPnl"`
+	UnrealizedPnl float64 `json:"unrealizedPnl" bson:"unrealizedPnl"`
+
+	OpenPositions int `json:"openPositions" bson:"openPositions"`
+}
+
+var PaperAccountIndexModel = []mongo.IndexModel{
+	{
+		Keys:    bson.D{{Key: "strategy", Value: 1}},
+		Options: options.Index().SetName("strategy_index").SetUnique(true),
+	},
+}
+
+var PaperPositionIndexModel = []mongo.IndexModel{
+	{
+		Keys:    bson.D{{Key: "strategy", Value: 1}, {Key: "status", Value: 1}},
+		Options: options.Index().SetName("strategy_status_index"),
+	},
+	{
+		Keys:    bson.D{{Key: "mainToken", Value: -1}},
+		Options: options.Index().SetName("mainToken_index"),
+	},
+	{
+		Keys:    bson.D{{Key: "exitTime", Value: -1}},
+		Options: options.Index().SetName("exitTime_index"),
+	},
+}
+
+var PaperEquityIndexModel = []mongo.IndexModel{
+	{
+		Keys:    bson.D{{Key: "strategy", Value: 1}, {Key: "time", Value: 1}},
+		Options: options.Index().SetName("strategy_time_index").SetUnique(true),
+	},
+}
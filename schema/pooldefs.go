@@ -2,6 +2,7 @@ package schema
 
 import (
 	"sfilter/config"
+	"sfilter/utils"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -18,6 +19,10 @@ type LiquidityEvent struct {
 	Amount0 string `json:"amount0" bson:"amount0"`
 	Amount1 string `json:"amount1" bson:"amount1"`
 
+	// 已除以decimal的精确数量
+	Amount0Decimal utils.Decimal `json:"amount0Decimal" bson:"amount0Decimal"`
+	Amount1Decimal utils.Decimal `json:"amount1Decimal" bson:"amount1Decimal"`
+
 	AmountInUsd float64 `json:"amountInUsd" bson:"amountInUsd"`
 	PairName    string  `json:"pairName" bson:"pairName"`
 
@@ -2,6 +2,7 @@ package schema
 
 import (
 	"sfilter/config"
+	"sfilter/utils"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -14,8 +15,12 @@ const (
 
 	SWAP_EVENT_RESERVED_1
 	SWAP_EVENT_UNISWAPV2_LIKE // uniswapv2 like
-	SWAP_EVENT_UNISWAPV3_LIKE // uniswapv3 like
+	SWAP_EVENT_UNISWAPV3_LIKE // uniswapv3 like, 包含 pancakeswap v3
 
+	SWAP_EVENT_CURVE_LIKE      // curve stable/crypto pool
+	SWAP_EVENT_BALANCERV2_LIKE // balancer v2 vault
+	SWAP_EVENT_UNISWAPV4_LIKE  // uniswap v4 poolmanager
+	SWAP_EVENT_MAVERICK_LIKE   // maverick v1
 )
 
 const (
@@ -63,19 +68,41 @@ type Swap struct {
 	AmountOfMainBig  string `json:"-" bson:"amountOfMainBig"`
 	AmountOfQuoteBig string `json:"-" bson:"amountOfQuoteBig"`
 
+	// 精确值, 上面的 float 字段均由其转换而来
+	PriceDecimal         utils.Decimal `json:"priceDecimal" bson:"priceDecimal"`
+	PriceInUsdDecimal    utils.Decimal `json:"priceInUsdDecimal" bson:"priceInUsdDecimal"`
+	AmountOfMainDecimal  utils.Decimal `json:"amountOfMainDecimal" bson:"amountOfMainDecimal"`   // 已除以decimal的主代币数量
+	AmountOfQuoteDecimal utils.Decimal `json:"amountOfQuoteDecimal" bson:"amountOfQuoteDecimal"` // 已除以decimal的计价代币数量
+
 	VolumeInUsd float64 ` json:"volumeInUsd" bson:"volumeInUsd"` // 本次交易以Usd计价金额
 
+	// usd 价格的来源: 经过定价图的跳数(0表示直接以weth/稳定币计价), 及价格对应的成交时间
+	PriceHops      int       `json:"priceHops" bson:"priceHops"`
+	PriceUpdatedAt time.Time `json:"priceUpdatedAt,omitempty" bson:"priceUpdatedAt,omitempty"`
+
 	LogIndexWithTx string `json:"-" bson:"logIndexWithTx"` // tx hash 以及 log 在本区块中的序号，以作为唯一标识
 
 	LogNumInHash int `json:"logNumInHash" bson:"logNumInHash"`
 
 	SwapTime time.Time `json:"swapTime" bson:"swapTime"`
 
+	SwapPoolState `bson:",inline"`
+
 	SwapOmitFields `bson:",inline"`
 
 	CreatedAt time.Time `json:"-" bson:"createdAt"` // 创建时间
 }
 
+// 集中流动性池子(uniswap v3/v4 等) swap 后的池子状态, 其他类型为空
+// 此时 Price 为 sqrtPriceX96 计算出的池子价格, ExecutionPrice 为本次成交均价, 两者之差即为滑点
+type SwapPoolState struct {
+	SqrtPriceX96 string `json:"sqrtPriceX96,omitempty" bson:"sqrtPriceX96,omitempty"`
+	Liquidity    string `json:"liquidity,omitempty" bson:"liquidity,omitempty"`
+	Tick         int64  `json:"tick,omitempty" bson:"tick,omitempty"`
+
+	ExecutionPriceDecimal utils.Decimal `json:"executionPriceDecimal,omitempty" bson:"executionPriceDecimal,omitempty"`
+}
+
 // 临时变量, 不存入db
 type SwapOmitFields struct {
 	Decimal0 uint8 `json:"-" bson:"-"`
@@ -2,6 +2,7 @@ package schema
 
 import (
 	"sfilter/config"
+	"sfilter/utils"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -12,15 +13,43 @@ import (
 // 定义Token map, 避免反复查db
 type TokenMap map[string]*Token
 
+// token 元数据的获取状态, 老数据为 UNKNOWN
+const (
+	TOKEN_METADATA_UNKNOWN int = iota
+	TOKEN_METADATA_OK
+	TOKEN_METADATA_PARTIAL // name、symbol 或 totalSupply 取不到, 已使用替代值
+	TOKEN_METADATA_INVALID // decimals 或 symbol 取不到, 数量与名称不可信, 不参与计算
+)
+
+// token 标准
+const (
+	TOKEN_STANDARD_ERC20    = "erc20"
+	TOKEN_STANDARD_ERC777   = "erc777"   // 在 ERC1820 注册了 ERC777Token 接口
+	TOKEN_STANDARD_REBASING = "rebasing" // 余额会自动变化, 如 stETH、aToken 等
+)
+
+// 代理合约类型
+const (
+	TOKEN_PROXY_EIP1967        = "eip1967"
+	TOKEN_PROXY_EIP1967_BEACON = "eip1967-beacon"
+	TOKEN_PROXY_EIP1822        = "eip1822"
+)
+
 type TokenInfoOnChain struct {
 	Address string `json:"address" bson:"address"` // 地址
 	Name    string `json:"name" bson:"name"`       // 名称
 	Symbol  string `json:"symbol" bson:"symbol"`   // 符号
 
-	PriceInUsd float64 `json:"priceInUsd" bson:"priceInUsd"`
+	PriceInUsd        float64       `json:"priceInUsd" bson:"priceInUsd"` // 由 PriceInUsdDecimal 转换而来
+	PriceInUsdDecimal utils.Decimal `json:"priceInUsdDecimal" bson:"priceInUsdDecimal"`
 
 	TotalSupply string `json:"totalSupply" bson:"totalSupply"` // 总供应量
 	Decimal     uint8  `json:"decimal" bson:"decimal"`         // 小数位数
+
+	Standard       string `json:"standard" bson:"standard"`             // 见 TOKEN_STANDARD_*
+	ProxyType      string `json:"proxyType" bson:"proxyType"`           // 见 TOKEN_PROXY_*, 非代理合约为空
+	Implementation string `json:"implementation" bson:"implementation"` // 代理合约当前的实现合约
+	MetadataStatus int    `json:"metadataStatus" bson:"metadataStatus"` // 见 TOKEN_METADATA_*
 }
 
 // 第三方数据获
@@ -3,6 +3,7 @@ package schema
 import (
 	"math/big"
 	"sfilter/config"
+	"sfilter/utils"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -18,6 +19,19 @@ const (
 
 )
 
+const (
+	TRANSFER_CATEGORY_ERC20    int = iota // erc20 Transfer 事件
+	TRANSFER_CATEGORY_NATIVE              // 交易本身携带的原生币转账
+	TRANSFER_CATEGORY_INTERNAL            // 合约内部调用产生的原生币转账
+)
+
+// 区块内部调用(internal tx)的获取状态
+const (
+	INTERNAL_TRACE_DISABLED    int = iota // 未开启
+	INTERNAL_TRACE_OK                     // 已获取
+	INTERNAL_TRACE_UNAVAILABLE            // 节点不支持, 该区块缺少内部转账数据
+)
+
 type Transfer struct {
 	Token string `json:"token" bson:"token"` // 地址
 
@@ -26,11 +40,16 @@ type Transfer struct {
 	From string `json:"from" bson:"from"`
 	To   string `json:"to" bson:"to"`
 
-	Amount       float64  `json:"amount" bson:"amount"`
-	AmountBigInt *big.Int `json:"-" bson:"-"` // 暂时保留
+	Amount        float64       `json:"amount" bson:"amount"`               // 由 AmountDecimal 转换而来
+	AmountDecimal utils.Decimal `json:"amountDecimal" bson:"amountDecimal"` // 已除以decimal的精确数量
+	AmountBigInt  *big.Int      `json:"-" bson:"-"`                         // 暂时保留
 
 	TransferValueInUsd float64 `json:"transferValueInUsd" bson:"transferValueInUsd"`
 
+	// usd 价格的来源, 同 Swap
+	PriceHops      int       `json:"priceHops" bson:"priceHops"`
+	PriceUpdatedAt time.Time `json:"priceUpdatedAt,omitempty" bson:"priceUpdatedAt,omitempty"`
+
 	BlockNo  uint64 `json:"blockNo" bson:"blockNo"`   // 区块号
 	TxHash   string `json:"txHash" bson:"txHash"`     // 交易哈希
 	Position uint   `json:"position" bson:"position"` // 交易在本区块中的序号
@@ -38,6 +57,7 @@ type Transfer struct {
 	LogIndexWithTx string `json:"-" bson:"logIndexWithTx"` // tx hash 以及 log 在本区块中的序号，以作为唯一标识
 
 	TransferType int `json:"transferType" bson:"transferType"`
+	Category     int `json:"category" bson:"category"` // erc20、原生币或内部转账
 
 	Timestamp time.Time `json:"timestamp" bson:"timestamp"` // transfer时间
 
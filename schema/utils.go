@@ -10,6 +10,8 @@ import (
 func InitTables(mongodb *mongo.Client) {
 	utils.DoInitTable(config.DatabaseName, config.SwapTableName, SwapIndexModel, mongodb)
 	utils.DoInitTable(config.DatabaseName, config.BlockProceededTableName, BlockProceededIndexModel, mongodb)
+	utils.DoInitTable(config.DatabaseName, config.BackfillTableName, BackfillIndexModel, mongodb)
+	utils.DoInitTable(config.DatabaseName, config.NativePriceTableName, NativePriceIndexModel, mongodb)
 
 	utils.DoInitTable(config.DatabaseName, config.TokenTableName, TokenIndexModel, mongodb)
 	utils.DoInitTable(config.DatabaseName, config.PairTableName, PairIndexModel, mongodb)
@@ -17,6 +19,7 @@ func InitTables(mongodb *mongo.Client) {
 
 	utils.DoInitTable(config.DatabaseName, config.Kline1MinTableName, Kline1MinIndexModel, mongodb)
 	utils.DoInitTable(config.DatabaseName, config.Kline1HourTableName, Kline1HourIndexModel, mongodb)
+	utils.DoInitTable(config.DatabaseName, config.Kline1DayTableName, Kline1DayIndexModel, mongodb)
 
 	utils.DoInitTable(config.DatabaseName, config.TransferTableName, TransferIndexModel, mongodb)
 
@@ -34,6 +37,14 @@ func InitTables(mongodb *mongo.Client) {
 	utils.DoInitTable(config.DatabaseName, config.BiDealTableName, BiDealIndexModel, mongodb)
 	utils.DoInitTable(config.DatabaseName, config.BiTradeTableName, BiTradeIndexModel, mongodb)
 	utils.DoInitTable(config.DatabaseName, config.HotPairRankTableName, HotPairRankIndexModel, mongodb)
+	utils.DoInitTable(config.DatabaseName, config.WiserCursorTableName, WiserCursorIndexModel, mongodb)
+	utils.DoInitTable(config.DatabaseName, config.DealPositionTableName, DealPositionIndexModel, mongodb)
+	utils.DoInitTable(config.DatabaseName, config.MovedLotsTableName, MovedLotsIndexModel, mongodb)
+
+	// 策略模拟盘
+	utils.DoInitTable(config.DatabaseName, config.PaperAccountTableName, PaperAccountIndexModel, mongodb)
+	utils.DoInitTable(config.DatabaseName, config.PaperPositionTableName, PaperPositionIndexModel, mongodb)
+	utils.DoInitTable(config.DatabaseName, config.PaperEquityTableName, PaperEquityIndexModel, mongodb)
 
 	// router etc
 	utils.DoInitTable(config.DatabaseName, config.RouterTableName, RouterIndexModel, mongodb)
@@ -1,6 +1,7 @@
 package schema
 
 import (
+	"sfilter/config"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -38,6 +39,12 @@ const (
 	BI_DEAL_TYPE_TREND //  正常趋势交易
 )
 
+// deal 成本计算方式
+const (
+	DEAL_COST_METHOD_FIFO = "fifo" // 先买入的先卖出
+	DEAL_COST_METHOD_AVG  = "avg"  // 按平均成本卖出
+)
+
 // deal 买入风格类型
 const (
 	BI_DEAL_BUY_TYPE_UNKNOWN int = iota
@@ -167,11 +174,23 @@ type BiDeal struct {
 	SellPrice  float64 `json:"sellPrice" bson:"sellPrice"`
 	SellType   int     `json:"sellType" bson:"sellType"`
 
+	SellCount int `json:"sellCount" bson:"sellCount"` // 卖出笔数, 可能分多次卖出
+
+	// transfer 转入转出的数量, 转出不算卖出, 成本随 token 一起转走
+	TransferInAmount  float64 `json:"transferInAmount" bson:"transferInAmount"`
+	TransferOutAmount float64 `json:"transferOutAmount" bson:"transferOutAmount"`
+
 	// summary
-	Earn       float64 `json:"earn" bson:"earn"`             // 盈利金额
-	EarnChange float64 `json:"earnChange" bson:"earnChange"` //盈利比例
+	Earn       float64 `json:"earn" bson:"earn"`             // 已实现盈利金额, 已扣除 gas
+	EarnChange float64 `json:"earnChange" bson:"earnChange"` // 盈利比例, 即 Earn/CostBasis
 	HoldBlocks uint64  `json:"holdBlocks" bson:"holdBlocks"` // 持有的区块数
 
+	CostMethod    string  `json:"costMethod" bson:"costMethod"`       // 成本计算方式, fifo 或 avg
+	CostBasis     float64 `json:"costBasis" bson:"costBasis"`         // 已卖出部分的成本, 含买入 gas
+	GasCost       float64 `json:"gasCost" bson:"gasCost"`             // 买卖消耗的 gas(usd)
+	HoldAmount    float64 `json:"holdAmount" bson:"holdAmount"`       // 统计时仍持有的数量
+	UnrealizedPnl float64 `json:"unrealizedPnl" bson:"unrealizedPnl"` // 仍持有部分按当前价格计算的盈亏
+
 	// 如果持有至今的盈利率, 防止坑人币最终都归零
 	UptoTodayYield float64 `json:"uptoTodayYield" bson:"uptoTodayYield"`
 
@@ -181,6 +200,49 @@ type BiDeal struct {
 	CreatedAt time.Time `json:"createdAt" bson:"createdAt"`
 }
 
+// 增量统计时每个地址的进度
+type WiserCursor struct {
+	Account string `json:"account" bson:"account"` // unique key
+	BlockNo uint64 `json:"blockNo" bson:"blockNo"` // 已统计到的区块(含)
+
+	UpdatedAt time.Time `json:"updatedAt" bson:"updatedAt"`
+}
+
+// 某个地址某个token的持仓与未结束的deal, 增量统计时从这里继续
+type DealPosition struct {
+	Account string `json:"account" bson:"account"`
+	Token   string `json:"token" bson:"token"`
+
+	Lots []DealLot `json:"lots" bson:"lots"`
+
+	Deal   *BiDeal `json:"deal,omitempty" bson:"deal,omitempty"` // 未结束的deal, 为空表示还没有买入
+	Broken bool    `json:"broken" bson:"broken"`                 // 有卖出没有价格, 该deal结束时丢弃
+
+	// 未结束的deal按当前价格结算后保存过的 key, key 变化时删除旧记录
+	SavedKey string `json:"savedKey" bson:"savedKey"`
+
+	UpdatedAt time.Time `json:"updatedAt" bson:"updatedAt"`
+}
+
+type DealLot struct {
+	Amount float64 `json:"amount" bson:"amount"`
+	Cost   float64 `json:"cost" bson:"cost"`
+}
+
+// 转出方统计时记录随 transfer 转走的 lots, 转入方统计时读取, 使 token 的成本跟随转移
+// txHash + token + to 为唯一key
+type MovedLots struct {
+	TxHash string `json:"txHash" bson:"txHash"`
+	Token  string `json:"token" bson:"token"`
+	From   string `json:"from" bson:"from"`
+	To     string `json:"to" bson:"to"`
+
+	BlockNo uint64    `json:"blockNo" bson:"blockNo"`
+	Lots    []DealLot `json:"lots" bson:"lots"`
+
+	CreatedAt time.Time `json:"createdAt" bson:"createdAt"`
+}
+
 // pair_addr为key, value为rank数组, 以createdAt倒排
 type HRankMap map[string][]HotPairRank
 
@@ -210,6 +272,8 @@ type HotPairRank struct {
 
 // 买卖策略记录
 type BiTrade struct {
+	Strategy string `json:"strategy" bson:"strategy"` // 产生该交易的策略, 各策略的持仓互不影响
+
 	MainToken     string        `json:"mainToken" bson:"mainToken"`
 	PairAddress   string        `json:"pairAddress" bson:"pairAddress"`
 	PairName      string        `json:"pairName" bson:"pairName"`
@@ -261,6 +325,9 @@ type AccountTokenTrade struct {
 	Amount     float64
 	USDValue   float64 // 法币价值
 	PriceInUSD float64 // 法币价格
+
+	GasInUsd     float64 // swap 消耗的 gas, 同一笔交易只计一次
+	Counterparty string  // transfer 的对方地址
 }
 
 var HotPairRankIndexModel = []mongo.IndexModel{
@@ -318,6 +385,10 @@ var BiTradeIndexModel = []mongo.IndexModel{
 		Keys:    bson.D{{Key: "status", Value: -1}},
 		Options: options.Index().SetName("status_index"),
 	},
+	{
+		Keys:    bson.D{{Key: "strategy", Value: 1}, {Key: "status", Value: 1}},
+		Options: options.Index().SetName("strategy_status_index"),
+	},
 }
 
 var WiserIndexModel = []mongo.IndexModel{
@@ -471,3 +542,28 @@ var BiDealIndexModel = []mongo.IndexModel{
 		Options: options.Index().SetName("holdBlocks_index"),
 	},
 }
+
+var WiserCursorIndexModel = []mongo.IndexModel{
+	{
+		Keys:    bson.D{{Key: "account", Value: 1}},
+		Options: options.Index().SetName("account_index").SetUnique(true),
+	},
+}
+
+var DealPositionIndexModel = []mongo.IndexModel{
+	{
+		Keys:    bson.D{{Key: "account", Value: 1}, {Key: "token", Value: 1}},
+		Options: options.Index().SetName("account_token_index").SetUnique(true),
+	},
+}
+
+var MovedLotsIndexModel = []mongo.IndexModel{
+	{
+		Keys:    bson.D{{Key: "txHash", Value: 1}, {Key: "token", Value: 1}, {Key: "to", Value: 1}},
+		Options: options.Index().SetName("txHash_token_to_index").SetUnique(true),
+	},
+	{
+		Keys:    bson.D{{Key: "createdAt", Value: 1}},
+		Options: options.Index().SetName("createdAt_index").SetExpireAfterSeconds(config.MovedLotsSaveTime),
+	},
+}
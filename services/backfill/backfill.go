@@ -0,0 +1,43 @@
+package backfill
+
+import (
+	"context"
+	"sfilter/config"
+	"sfilter/schema"
+	"sfilter/utils"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func GetCheckpoint(taskId string, mongodb *mongo.Client) (*schema.BackfillCheckpoint, error) {
+	collection := mongodb.Database(config.DatabaseName).Collection(config.BackfillTableName)
+
+	var result schema.BackfillCheckpoint
+	err := collection.FindOne(context.Background(), bson.M{"taskId": taskId}).Decode(&result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func SaveCheckpoint(cp *schema.BackfillCheckpoint, mongodb *mongo.Client) {
+	collection := mongodb.Database(config.DatabaseName).Collection(config.BackfillTableName)
+
+	cp.UpdatedAt = time.Now()
+	if cp.CreatedAt.IsZero() {
+		cp.CreatedAt = cp.UpdatedAt
+	}
+
+	filter := bson.D{{Key: "taskId", Value: cp.TaskId}}
+	update := bson.D{{Key: "$set", Value: cp}}
+	opt := options.Update().SetUpsert(true)
+
+	_, err := collection.UpdateOne(context.Background(), filter, update, opt)
+	if err != nil {
+		utils.Warnf("[ SaveCheckpoint ] UpdateOne error: %v, task: %v", err, cp.TaskId)
+	}
+}
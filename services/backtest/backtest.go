@@ -0,0 +1,147 @@
+package backtest
+
+import (
+	"errors"
+	"time"
+
+	"sfilter/config"
+	"sfilter/schema"
+	"sfilter/utils"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// 回测引擎: 按分钟回放db中的1分钟k线与 hrank 快照, 交给策略决定买卖
+// 成交按池子流动性估算滑点并扣除手续费, 最后输出交易明细、胜率、最大回撤与资金曲线
+// 注意 1 分钟k线只保留 Kline1MinTableSaveTime, 更早的区间没有数据
+
+var ErrInvalidRange = errors.New("invalid backtest range")
+
+type Config struct {
+	Start time.Time
+	End   time.Time
+
+	InitialCapital float64 // 初始资金(usd)
+	PositionSize   float64 // 每次买入的金额(usd), 资金不足时用剩余资金
+	MaxPositions   int     // 同时持有的最大仓位数, 0为不限制
+
+	FeeRate float64 // pair 没有记录手续费时使用的费率
+	GasUsd  float64 // 每笔成交的固定成本(usd)
+
+	EquityInterval time.Duration // 资金曲线的记录间隔
+}
+
+func NewConfig(start, end time.Time) *Config {
+	return &Config{
+		Start: start,
+		End:   end,
+
+		InitialCapital: config.BacktestInitialCapital,
+		PositionSize:   config.BacktestPositionSize,
+
+		FeeRate: config.BacktestFeeRate,
+
+		EquityInterval: config.BacktestEquityInterval,
+	}
+}
+
+// 回测策略
+type Strategy interface {
+	Name() string
+
+	// 回测开始前确定需要回放k线的 pair, snapshots 为区间内的全部 hrank 快照
+	Pairs(start time.Time, snapshots []*Snapshot) []string
+
+	// 每分钟调用一次, 通过 ctx 读取行情并下单
+	OnTick(ctx *Context)
+}
+
+type Engine struct {
+	cfg     *Config
+	mongodb *mongo.Client
+}
+
+func NewEngine(cfg *Config, mongodb *mongo.Client) *Engine {
+	return &Engine{cfg: cfg, mongodb: mongodb}
+}
+
+func (e *Engine) Run(s Strategy) (*Report, error) {
+	cfg := e.cfg
+	if !cfg.Start.Before(cfg.End) {
+		return nil, ErrInvalidRange
+	}
+
+	snapshots, err := loadSnapshots(cfg.Start, cfg.End, e.mongodb)
+	if err != nil {
+		return nil, err
+	}
+
+	pairs := s.Pairs(cfg.Start, snapshots)
+
+	market, err := loadMarket(pairs, cfg.Start, cfg.End, e.mongodb)
+	if err != nil {
+		return nil, err
+	}
+
+	utils.Infof("[ Engine.Run ] backtest %v from %v to %v, pairs: %v, snapshots: %v", s.Name(), cfg.Start.Format("2006-01-02 15:04"), cfg.End.Format("2006-01-02 15:04"), len(market.pairs), len(snapshots))
+
+	ctx := newContext(cfg, market, snapshots)
+	report := newReport(s.Name(), cfg)
+
+	var lastEquity time.Time
+	for now := cfg.Start.Truncate(time.Minute).Add(time.Minute); !now.After(cfg.End); now = now.Add(time.Minute) {
+		ctx.advance(now)
+
+		s.OnTick(ctx)
+
+		report.markEquity(now, ctx.Equity())
+		if now.Sub(lastEquity) >= cfg.EquityInterval {
+			report.addEquityPoint(now, ctx.Equity())
+			lastEquity = now
+		}
+	}
+
+	// 结束时仍持有的仓位按最新价格平仓, 便于统计
+	for _, pos := range ctx.Positions() {
+		ctx.Sell(pos.Pair, REASON_BACKTEST_END)
+	}
+	report.addEquityPoint(cfg.End, ctx.Equity())
+
+	report.finish(ctx.trades, ctx.cash)
+	return report, nil
+}
+
+// hrank 快照, 同一时刻生成的一组排名
+type Snapshot struct {
+	Time  time.Time
+	Ranks []schema.HotPairRank // 按 SortRank 升序
+}
+
+// 快照中 pair 的排名, 不在快照中返回 0
+func (s *Snapshot) Rank(pair string) int {
+	if s == nil {
+		return 0
+	}
+
+	for _, r := range s.Ranks {
+		if r.PairAddress == pair {
+			return r.SortRank
+		}
+	}
+
+	return 0
+}
+
+func (s *Snapshot) Get(pair string) (*schema.HotPairRank, bool) {
+	if s == nil {
+		return nil, false
+	}
+
+	for i := range s.Ranks {
+		if s.Ranks[i].PairAddress == pair {
+			return &s.Ranks[i], true
+		}
+	}
+
+	return nil, false
+}
@@ -0,0 +1,273 @@
+package backtest
+
+import (
+	"sort"
+	"time"
+
+	"sfilter/schema"
+)
+
+const (
+	REASON_BACKTEST_END = "backtest end"
+)
+
+// 持仓, 价格均为usd
+type Position struct {
+	Pair     string
+	PairName string
+
+	BuyTime  time.Time
+	BuyPrice float64 // 含滑点的成交均价
+	Amount   float64 // 持有的 token 数量
+	Cost     float64 // 买入花费, 含手续费与gas
+
+	HighPrice float64 // 持仓期间的最高价
+	BuyReason string
+}
+
+// 已平仓的一笔交易
+type Trade struct {
+	Pair     string `json:"pair"`
+	PairName string `json:"pairName"`
+
+	BuyTime   time.Time `json:"buyTime"`
+	BuyPrice  float64   `json:"buyPrice"`
+	BuyReason string    `json:"buyReason"`
+
+	SellTime   time.Time `json:"sellTime"`
+	SellPrice  float64   `json:"sellPrice"`
+	SellReason string    `json:"sellReason"`
+
+	Cost     float64 `json:"cost"`
+	Proceeds float64 `json:"proceeds"`
+	Profit   float64 `json:"profit"`
+	Return   float64 `json:"return"` // Profit / Cost
+}
+
+// 策略在每个 tick 中看到的回测状态
+// 只能看到当前时间之前已经收盘的柱子与已经生成的快照, 避免使用未来数据
+type Context struct {
+	cfg    *Config
+	market *market
+
+	now time.Time
+
+	visible   map[string]int // pair -> 已收盘的柱子数
+	snapshots []*Snapshot
+	snapIndex int // 已生成的快照数
+
+	cash      float64
+	positions map[string]*Position
+	trades    []*Trade
+}
+
+func newContext(cfg *Config, m *market, snapshots []*Snapshot) *Context {
+	return &Context{
+		cfg:       cfg,
+		market:    m,
+		visible:   make(map[string]int),
+		snapshots: snapshots,
+		cash:      cfg.InitialCapital,
+		positions: make(map[string]*Position),
+	}
+}
+
+func (c *Context) advance(now time.Time) {
+	c.now = now
+
+	for pair, bars := range c.market.bars {
+		i := c.visible[pair]
+		for i < len(bars) && !barCloseTime(&bars[i]).After(now) {
+			i++
+		}
+		c.visible[pair] = i
+	}
+
+	for c.snapIndex < len(c.snapshots) && !c.snapshots[c.snapIndex].Time.After(now) {
+		c.snapIndex++
+	}
+
+	for pair, pos := range c.positions {
+		if price := c.Price(pair); price > pos.HighPrice {
+			pos.HighPrice = price
+		}
+	}
+}
+
+func barCloseTime(k *schema.KLine) time.Time {
+	return time.Unix(k.UnixTime, 0).Add(time.Minute)
+}
+
+func (c *Context) Now() time.Time {
+	return c.now
+}
+
+func (c *Context) Cash() float64 {
+	return c.cash
+}
+
+// 参与回放的 pair
+func (c *Context) Pairs() []string {
+	pairs := make([]string, 0, len(c.market.bars))
+	for pair := range c.market.bars {
+		pairs = append(pairs, pair)
+	}
+	sort.Strings(pairs)
+
+	return pairs
+}
+
+func (c *Context) PairInfo(pair string) (*schema.Pair, bool) {
+	info, ok := c.market.pairs[pair]
+	return info, ok
+}
+
+// 已收盘的1分钟柱子, 只包含有交易的分钟, 按时间正序
+func (c *Context) Bars(pair string) []schema.KLine {
+	return c.market.bars[pair][:c.visible[pair]]
+}
+
+// 最近一笔成交的usd价格, 还没有成交时返回 0
+func (c *Context) Price(pair string) float64 {
+	bars := c.Bars(pair)
+	if len(bars) == 0 {
+		return 0
+	}
+
+	return bars[len(bars)-1].PriceInUsd
+}
+
+// 最近 window 内的柱子
+func (c *Context) BarsSince(pair string, window time.Duration) []schema.KLine {
+	bars := c.Bars(pair)
+	since := c.now.Add(-window).Unix()
+
+	i := sort.Search(len(bars), func(i int) bool {
+		return bars[i].UnixTime >= since
+	})
+
+	return bars[i:]
+}
+
+// 最近生成的 hrank 快照, 还没有时返回 nil
+func (c *Context) Snapshot() *Snapshot {
+	if c.snapIndex == 0 {
+		return nil
+	}
+
+	return c.snapshots[c.snapIndex-1]
+}
+
+func (c *Context) Position(pair string) (*Position, bool) {
+	pos, ok := c.positions[pair]
+	return pos, ok
+}
+
+// 当前持仓, 按买入时间排序
+func (c *Context) Positions() []*Position {
+	positions := make([]*Position, 0, len(c.positions))
+	for _, pos := range c.positions {
+		positions = append(positions, pos)
+	}
+
+	sort.Slice(positions, func(i, j int) bool {
+		if positions[i].BuyTime.Equal(positions[j].BuyTime) {
+			return positions[i].Pair < positions[j].Pair
+		}
+		return positions[i].BuyTime.Before(positions[j].BuyTime)
+	})
+
+	return positions
+}
+
+// 现金加持仓按当前价格的市值
+func (c *Context) Equity() float64 {
+	equity := c.cash
+	for pair, pos := range c.positions {
+		equity += pos.Amount * c.Price(pair)
+	}
+
+	return equity
+}
+
+// 以 PositionSize 买入, 已持仓、没有价格或资金不足时返回 false
+func (c *Context) Buy(pair, reason string) bool {
+	if _, ok := c.positions[pair]; ok {
+		return false
+	}
+
+	if c.cfg.MaxPositions > 0 && len(c.positions) >= c.cfg.MaxPositions {
+		return false
+	}
+
+	price := c.Price(pair)
+	if price <= 0 {
+		return false
+	}
+
+	cost := c.cfg.PositionSize
+	if cost > c.cash {
+		cost = c.cash
+	}
+
+	fill, ok := c.buyFill(pair, price, cost)
+	if !ok {
+		return false
+	}
+
+	c.cash -= cost
+	c.positions[pair] = &Position{
+		Pair:      pair,
+		PairName:  c.pairName(pair),
+		BuyTime:   c.now,
+		BuyPrice:  fill.price,
+		Amount:    fill.amount,
+		Cost:      cost,
+		HighPrice: price,
+		BuyReason: reason,
+	}
+
+	return true
+}
+
+// 全部卖出, 没有持仓时返回 false
+func (c *Context) Sell(pair, reason string) bool {
+	pos, ok := c.positions[pair]
+	if !ok {
+		return false
+	}
+
+	price := c.Price(pair)
+	fill := c.sellFill(pair, price, pos.Amount)
+
+	c.cash += fill.value
+	delete(c.positions, pair)
+
+	trade := &Trade{
+		Pair:       pair,
+		PairName:   pos.PairName,
+		BuyTime:    pos.BuyTime,
+		BuyPrice:   pos.BuyPrice,
+		BuyReason:  pos.BuyReason,
+		SellTime:   c.now,
+		SellPrice:  fill.price,
+		SellReason: reason,
+		Cost:       pos.Cost,
+		Proceeds:   fill.value,
+		Profit:     fill.value - pos.Cost,
+	}
+	if pos.Cost > 0 {
+		trade.Return = trade.Profit / pos.Cost
+	}
+	c.trades = append(c.trades, trade)
+
+	return true
+}
+
+func (c *Context) pairName(pair string) string {
+	if info, ok := c.market.pairs[pair]; ok {
+		return info.PairName
+	}
+
+	return ""
+}
@@ -0,0 +1,129 @@
+package backtest
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"sfilter/config"
+	"sfilter/schema"
+	"sfilter/services/kline"
+	"sfilter/services/wiser"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// 回放用到的行情: 每个 pair 区间内有交易的1分钟柱子, 以及 pair 的静态信息
+type market struct {
+	pairs map[string]*schema.Pair
+	bars  map[string][]schema.KLine // 按时间升序
+}
+
+func loadMarket(pairs []string, start, end time.Time, mongodb *mongo.Client) (*market, error) {
+	m := &market{
+		pairs: make(map[string]*schema.Pair),
+		bars:  make(map[string][]schema.KLine),
+	}
+
+	if len(pairs) == 0 {
+		return m, nil
+	}
+
+	db := mongodb.Database(config.DatabaseName)
+	collection := db.Collection(config.PairTableName)
+
+	ctx, cancel := context.WithTimeout(context.Background(), config.MONGO_FIND_TIMEOUT*time.Second)
+	defer cancel()
+
+	cursor, err := collection.Find(ctx, bson.M{"address": bson.M{"$in": pairs}})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var infos []*schema.Pair
+	if err := cursor.All(ctx, &infos); err != nil {
+		return nil, err
+	}
+
+	for _, info := range infos {
+		bars, err := kline.GetKlines(info.Address, kline.TIMEFRAME_1MIN, start, end, db)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(bars) == 0 {
+			continue
+		}
+
+		m.pairs[info.Address] = info
+		m.bars[info.Address] = bars
+	}
+
+	return m, nil
+}
+
+// 取出区间内的 hrank, 按生成时间分组为快照
+func loadSnapshots(start, end time.Time, mongodb *mongo.Client) ([]*Snapshot, error) {
+	filter := primitive.M{
+		"createdAt": bson.M{"$gte": start, "$lte": end},
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "createdAt", Value: 1}})
+
+	ranks, err := wiser.GetHotRanks(opts, &filter, mongodb)
+	if err != nil {
+		return nil, err
+	}
+
+	// 同一个周期的排名一起保存, 以 periodKey 分组
+	var snapshots []*Snapshot
+	index := make(map[string]*Snapshot)
+
+	for _, rank := range ranks {
+		s, ok := index[rank.PeriodKey]
+		if !ok {
+			s = &Snapshot{Time: rank.CreatedAt}
+			index[rank.PeriodKey] = s
+			snapshots = append(snapshots, s)
+		}
+
+		if rank.CreatedAt.After(s.Time) {
+			s.Time = rank.CreatedAt
+		}
+		s.Ranks = append(s.Ranks, rank)
+	}
+
+	for _, s := range snapshots {
+		sort.Slice(s.Ranks, func(i, j int) bool {
+			return s.Ranks[i].SortRank < s.Ranks[j].SortRank
+		})
+	}
+
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].Time.Before(snapshots[j].Time)
+	})
+
+	return snapshots, nil
+}
+
+// 快照中出现过且排名不低于 maxRank 的 pair, 供基于 hrank 的策略使用
+func SnapshotPairs(snapshots []*Snapshot, maxRank int) []string {
+	seen := make(map[string]bool)
+
+	var pairs []string
+	for _, s := range snapshots {
+		for _, r := range s.Ranks {
+			if r.SortRank > maxRank || seen[r.PairAddress] {
+				continue
+			}
+
+			seen[r.PairAddress] = true
+			pairs = append(pairs, r.PairAddress)
+		}
+	}
+
+	return pairs
+}
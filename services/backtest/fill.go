@@ -0,0 +1,76 @@
+package backtest
+
+// 成交模拟: 按恒定乘积池估算滑点
+// 价值币一侧的储备取最近快照中的流动性的一半, 没有快照时取 pair 当前的价值币流动性
+// 手续费从输入中扣除, gas 按每笔固定成本计算
+
+type fill struct {
+	price  float64 // 成交均价(usd)
+	amount float64 // 买入得到的 token 数量
+	value  float64 // 卖出得到的usd, 已扣除gas
+}
+
+// 用 cost 的usd买入, 不够支付 gas 时返回 false
+func (c *Context) buyFill(pair string, price, cost float64) (*fill, bool) {
+	in := (cost - c.cfg.GasUsd) * (1 - c.feeRate(pair))
+	if in <= 0 {
+		return nil, false
+	}
+
+	amount := in / price
+	if reserve := c.reserveInUsd(pair); reserve > 0 {
+		amount = reserve / price * in / (reserve + in)
+	}
+
+	return &fill{price: cost / amount, amount: amount}, true
+}
+
+// 卖出 amount 个 token, 得到的usd不足以支付 gas 时为 0
+func (c *Context) sellFill(pair string, price, amount float64) *fill {
+	in := amount * price * (1 - c.feeRate(pair))
+
+	out := in
+	if reserve := c.reserveInUsd(pair); reserve > 0 {
+		out = reserve * in / (reserve + in)
+	}
+
+	value := out - c.cfg.GasUsd
+	if value < 0 {
+		value = 0
+	}
+
+	f := &fill{value: value}
+	if amount > 0 {
+		f.price = value / amount
+	}
+
+	return f
+}
+
+// pair 记录的手续费以百万分之一为单位, 如 3000 为 0.3%
+func (c *Context) feeRate(pair string) float64 {
+	if info, ok := c.market.pairs[pair]; ok && info.PairFee > 0 {
+		return float64(info.PairFee) / 1e6
+	}
+
+	return c.cfg.FeeRate
+}
+
+func (c *Context) reserveInUsd(pair string) float64 {
+	for i := c.snapIndex - 1; i >= 0; i-- {
+		if rank, ok := c.snapshots[i].Get(pair); ok && rank.PairLiquidity > 0 {
+			return rank.PairLiquidity / 2
+		}
+	}
+
+	info, ok := c.market.pairs[pair]
+	if !ok {
+		return 0
+	}
+
+	if info.ValueCoinLiquidity > 0 {
+		return info.ValueCoinLiquidity
+	}
+
+	return info.LiquidityInUsd / 2
+}
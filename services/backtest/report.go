@@ -0,0 +1,150 @@
+package backtest
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"os"
+	"strconv"
+	"time"
+)
+
+type EquityPoint struct {
+	Time   time.Time `json:"time"`
+	Equity float64   `json:"equity"`
+}
+
+// 回测结果
+type Report struct {
+	Strategy string    `json:"strategy"`
+	Start    time.Time `json:"start"`
+	End      time.Time `json:"end"`
+
+	InitialCapital float64 `json:"initialCapital"`
+	FinalEquity    float64 `json:"finalEquity"`
+	TotalReturn    float64 `json:"totalReturn"`
+
+	TradeNum int     `json:"tradeNum"`
+	WinNum   int     `json:"winNum"`
+	WinRate  float64 `json:"winRate"`
+
+	// 最大回撤, 按每分钟的资金计算
+	MaxDrawdown     float64   `json:"maxDrawdown"`
+	MaxDrawdownTime time.Time `json:"maxDrawdownTime"`
+
+	Trades      []*Trade      `json:"trades"`
+	EquityCurve []EquityPoint `json:"equityCurve"`
+
+	peak float64
+}
+
+func newReport(name string, cfg *Config) *Report {
+	return &Report{
+		Strategy:       name,
+		Start:          cfg.Start,
+		End:            cfg.End,
+		InitialCapital: cfg.InitialCapital,
+		peak:           cfg.InitialCapital,
+	}
+}
+
+func (r *Report) markEquity(now time.Time, equity float64) {
+	if equity > r.peak {
+		r.peak = equity
+		return
+	}
+
+	if r.peak <= 0 {
+		return
+	}
+
+	if drawdown := (r.peak - equity) / r.peak; drawdown > r.MaxDrawdown {
+		r.MaxDrawdown = drawdown
+		r.MaxDrawdownTime = now
+	}
+}
+
+func (r *Report) addEquityPoint(now time.Time, equity float64) {
+	r.EquityCurve = append(r.EquityCurve, EquityPoint{Time: now, Equity: equity})
+}
+
+func (r *Report) finish(trades []*Trade, cash float64) {
+	r.Trades = trades
+	r.FinalEquity = cash
+	r.markEquity(r.End, cash)
+
+	if r.InitialCapital > 0 {
+		r.TotalReturn = (r.FinalEquity - r.InitialCapital) / r.InitialCapital
+	}
+
+	r.TradeNum = len(trades)
+	for _, t := range trades {
+		if t.Profit > 0 {
+			r.WinNum++
+		}
+	}
+
+	if r.TradeNum > 0 {
+		r.WinRate = float64(r.WinNum) / float64(r.TradeNum)
+	}
+}
+
+func (r *Report) WriteJSON(path string) error {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0644)
+}
+
+func (r *Report) WriteTradesCSV(path string) error {
+	rows := [][]string{{"pair", "pairName", "buyTime", "buyPrice", "buyReason", "sellTime", "sellPrice", "sellReason", "cost", "proceeds", "profit", "return"}}
+
+	for _, t := range r.Trades {
+		rows = append(rows, []string{
+			t.Pair,
+			t.PairName,
+			t.BuyTime.Format(time.RFC3339),
+			formatFloat(t.BuyPrice),
+			t.BuyReason,
+			t.SellTime.Format(time.RFC3339),
+			formatFloat(t.SellPrice),
+			t.SellReason,
+			formatFloat(t.Cost),
+			formatFloat(t.Proceeds),
+			formatFloat(t.Profit),
+			formatFloat(t.Return),
+		})
+	}
+
+	return writeCSV(path, rows)
+}
+
+func (r *Report) WriteEquityCSV(path string) error {
+	rows := [][]string{{"time", "equity"}}
+
+	for _, p := range r.EquityCurve {
+		rows = append(rows, []string{p.Time.Format(time.RFC3339), formatFloat(p.Equity)})
+	}
+
+	return writeCSV(path, rows)
+}
+
+func writeCSV(path string, rows [][]string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	w := csv.NewWriter(f)
+	if err := w.WriteAll(rows); err != nil {
+		return err
+	}
+
+	return f.Close()
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', 10, 64)
+}
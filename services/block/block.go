@@ -6,10 +6,12 @@ import (
 
 	"sfilter/config"
 	"sfilter/schema"
+	"sfilter/services/bulk"
 	"sfilter/utils"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 func IsBlockProceeded(blkNo int64, mongodb *mongo.Client) bool {
@@ -43,6 +45,14 @@ func SetBlockProceeded(bps *schema.BlockProceeded, mongodb *mongo.Client) {
 
 }
 
+// 加入区块的批量写入, 与区块的其他记录一起写入
+func AddBlockProceededToBatch(bps *schema.BlockProceeded, b *bulk.Batch) {
+	bps.CreatedAt = time.Now()
+
+	filter := bson.M{"blockNo": bps.BlockNo}
+	b.Upsert(config.BlockProceededTableName, filter, bps)
+}
+
 func SetUnProceeded(blockNo int64, mongodb *mongo.Client) {
 	collection := mongodb.Database(config.DatabaseName).Collection(config.BlockProceededTableName)
 
@@ -55,3 +65,60 @@ func SetUnProceeded(blockNo int64, mongodb *mongo.Client) {
 
 	utils.Infof("[ SetUnProceeded ] set success")
 }
+
+func GetBlockProceeded(blkNo int64, mongodb *mongo.Client) (*schema.BlockProceeded, error) {
+	collection := mongodb.Database(config.DatabaseName).Collection(config.BlockProceededTableName)
+
+	filter := bson.M{"blockNo": blkNo}
+
+	var result schema.BlockProceeded
+	err := collection.FindOne(context.Background(), filter).Decode(&result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// 将 blockNo 及以前的未确认区块标记为已确认
+func ConfirmBlocks(blockNo int64, mongodb *mongo.Client) {
+	collection := mongodb.Database(config.DatabaseName).Collection(config.BlockProceededTableName)
+
+	filter := bson.M{
+		"blockNo": bson.M{"$lte": blockNo},
+		"status":  schema.BLOCK_STATUS_UNCONFIRMED,
+	}
+	update := bson.M{"$set": bson.M{"status": schema.BLOCK_STATUS_CONFIRMED}}
+
+	_, err := collection.UpdateMany(context.Background(), filter, update)
+	if err != nil {
+		utils.Warnf("[ ConfirmBlocks ] UpdateMany error: %v, blockNo: %v", err, blockNo)
+	}
+}
+
+// 一次性取出 [from, to] 区间内已处理的区块号
+func GetProceededBlockNos(from, to int64, mongodb *mongo.Client) (map[int64]bool, error) {
+	collection := mongodb.Database(config.DatabaseName).Collection(config.BlockProceededTableName)
+
+	filter := bson.M{"blockNo": bson.M{"$gte": from, "$lte": to}}
+	opts := options.Find().SetProjection(bson.M{"blockNo": 1})
+
+	cursor, err := collection.Find(context.Background(), filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(context.Background())
+
+	var result []schema.BlockProceeded
+	err = cursor.All(context.Background(), &result)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := make(map[int64]bool, len(result))
+	for _, bp := range result {
+		blocks[bp.BlockNo] = true
+	}
+
+	return blocks, nil
+}
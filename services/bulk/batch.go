@@ -0,0 +1,164 @@
+package bulk
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+
+	"sfilter/utils"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// 一个区块内所有待写入的记录, 处理完成后一次性写入
+// 支持事务时在同一个事务中写完, 否则按表依次 BulkWrite
+type Batch struct {
+	dbName string
+
+	tables []string // 按首次写入的顺序
+	models map[string][]mongo.WriteModel
+	keyed  map[string]map[string]*keyedDoc
+}
+
+// 同一条记录在一个区块内会被多次修改(如k线), 只保留最后的结果
+type keyedDoc struct {
+	filter interface{}
+	doc    interface{}
+}
+
+// 单机 mongo 不支持事务, 第一次失败后不再尝试
+var noTransaction atomic.Bool
+
+func NewBatch(dbName string) *Batch {
+	return &Batch{
+		dbName: dbName,
+		models: make(map[string][]mongo.WriteModel),
+		keyed:  make(map[string]map[string]*keyedDoc),
+	}
+}
+
+func (b *Batch) addTable(table string) {
+	if _, ok := b.models[table]; ok {
+		return
+	}
+	if _, ok := b.keyed[table]; ok {
+		return
+	}
+
+	b.tables = append(b.tables, table)
+}
+
+// 按 filter 整条替换, 不存在则插入, 重复写入结果一样
+func (b *Batch) Upsert(table string, filter, doc interface{}) {
+	b.addTable(table)
+
+	model := mongo.NewReplaceOneModel().SetFilter(filter).SetReplacement(doc).SetUpsert(true)
+	b.models[table] = append(b.models[table], model)
+}
+
+// 按 key 记录, 同一个 key 只在 flush 时写入一次
+func (b *Batch) Put(table, key string, filter, doc interface{}) {
+	b.addTable(table)
+
+	if b.keyed[table] == nil {
+		b.keyed[table] = make(map[string]*keyedDoc)
+	}
+	b.keyed[table][key] = &keyedDoc{filter: filter, doc: doc}
+}
+
+// 取出本批次中还未写入的记录, 没有则返回 nil
+func (b *Batch) Get(table, key string) interface{} {
+	kd, ok := b.keyed[table][key]
+	if !ok {
+		return nil
+	}
+
+	return kd.doc
+}
+
+func (b *Batch) Len() int {
+	n := 0
+	for _, models := range b.models {
+		n += len(models)
+	}
+	for _, docs := range b.keyed {
+		n += len(docs)
+	}
+
+	return n
+}
+
+func (b *Batch) writeModels(table string) []mongo.WriteModel {
+	models := b.models[table]
+
+	for _, kd := range b.keyed[table] {
+		update := bson.D{{Key: "$set", Value: kd.doc}}
+		models = append(models, mongo.NewUpdateOneModel().SetFilter(kd.filter).SetUpdate(update).SetUpsert(true))
+	}
+
+	return models
+}
+
+func (b *Batch) write(ctx context.Context, mongodb *mongo.Client) error {
+	db := mongodb.Database(b.dbName)
+
+	for _, table := range b.tables {
+		models := b.writeModels(table)
+		if len(models) == 0 {
+			continue
+		}
+
+		_, err := db.Collection(table).BulkWrite(ctx, models, options.BulkWrite().SetOrdered(true))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// 写入所有记录, 返回错误时调用方不应标记区块已处理
+func (b *Batch) Flush(mongodb *mongo.Client) error {
+	if b.Len() == 0 {
+		return nil
+	}
+
+	if !noTransaction.Load() {
+		err := b.flushInTransaction(mongodb)
+		if !isTransactionNotSupported(err) {
+			return err
+		}
+
+		noTransaction.Store(true)
+		utils.Warnf("[ Batch.Flush ] mongo does not support transaction, fallback to bulk write without it: %v", err)
+	}
+
+	// 不支持事务时, 调用方需要把区块已处理标记放在最后写入
+	return b.write(context.Background(), mongodb)
+}
+
+func (b *Batch) flushInTransaction(mongodb *mongo.Client) error {
+	session, err := mongodb.StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(context.Background())
+
+	_, err = session.WithTransaction(context.Background(), func(ctx mongo.SessionContext) (interface{}, error) {
+		return nil, b.write(ctx, mongodb)
+	})
+
+	return err
+}
+
+// 20: IllegalOperation, 单机模式下使用事务时返回
+func isTransactionNotSupported(err error) bool {
+	var cmdErr mongo.CommandError
+	if errors.As(err, &cmdErr) {
+		return cmdErr.Code == 20
+	}
+
+	return false
+}
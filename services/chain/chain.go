@@ -2,37 +2,20 @@ package chain
 
 import (
 	"context"
+	"fmt"
 	"math/big"
-	"sfilter/config"
 	"sfilter/utils"
 	"strings"
 
-	"github.com/cloudfresco/ethblocks"
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/ethclient"
-	"go.mongodb.org/mongo-driver/mongo"
-	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
-// 本地使用的全局变量, low...
+// abi 只需要解析一次
 var chainStaticAbi *abi.ABI
 var chainStaticBackupAbi *abi.ABI
 
-var staticClient *ethclient.Client
-var infuraClient *ethclient.Client
-
-var mongoClient *mongo.Client
-
-func GetMongo() *mongo.Client {
-	return getMongo()
-}
-
-func GetEthClient() *ethclient.Client {
-	return getClient()
-}
-
 func getAbi() *abi.ABI {
 	if chainStaticAbi == nil {
 		abi, err := abi.JSON(strings.NewReader(ChainAbiJson))
@@ -59,47 +42,7 @@ func getBackupAbi() *abi.ABI {
 	return chainStaticBackupAbi
 }
 
-func getMongo() *mongo.Client {
-	if mongoClient == nil {
-		clientOptions := options.Client().ApplyURI(config.MONGO_ADDR)
-		mongodb, err := mongo.Connect(context.Background(), clientOptions)
-		if err != nil {
-			utils.Fatalf("getMongo error! err: %v", err)
-		}
-
-		mongoClient = mongodb
-	}
-
-	return mongoClient
-}
-
-func getClient() *ethclient.Client {
-	if staticClient == nil {
-		cli, err := ethblocks.GetClient(config.WS_ADDR)
-		if err != nil {
-			utils.Fatalf("getClient error! err: %v", err)
-		}
-
-		staticClient = cli
-	}
-
-	return staticClient
-}
-
-func getInfuraClient() *ethclient.Client {
-	if infuraClient == nil {
-		cli, err := ethblocks.GetClient(config.INFURA_API_KEY)
-		if err != nil {
-			utils.Fatalf("infuraClient error! err: %v", err)
-		}
-
-		infuraClient = cli
-	}
-
-	return infuraClient
-}
-
-func _getSingleProp(abi *abi.ABI, address, info string, client *ethclient.Client, height *big.Int) (interface{}, error) {
+func _getSingleProp(abi *abi.ABI, address, info string, client RPC, height *big.Int) (interface{}, error) {
 	contractAddr := common.HexToAddress(address)
 	bytes, _ := abi.Pack(info)
 	msg := ethereum.CallMsg{
@@ -123,10 +66,8 @@ func _getSingleProp(abi *abi.ABI, address, info string, client *ethclient.Client
 	return intr[0], err
 }
 
-func GetCurrentBlockNumber() (uint64, error) {
-	client := getClient()
-
-	header, err := client.HeaderByNumber(context.Background(), nil)
+func (s *Service) GetCurrentBlockNumber() (uint64, error) {
+	header, err := s.rpc.HeaderByNumber(context.Background(), nil)
 	if err != nil {
 		return 0, err
 	}
@@ -134,10 +75,8 @@ func GetCurrentBlockNumber() (uint64, error) {
 	return header.Number.Uint64(), nil
 }
 
-func GetAccountEthBalance(address string) (float64, error) {
-	client := getClient()
-
-	balanceBig, err := client.BalanceAt(context.Background(), common.HexToAddress(address), nil)
+func (s *Service) GetAccountEthBalance(address string) (float64, error) {
+	balanceBig, err := s.rpc.BalanceAt(context.Background(), common.HexToAddress(address), nil)
 	if err != nil {
 		return 0, err
 	}
@@ -149,8 +88,8 @@ func GetAccountEthBalance(address string) (float64, error) {
 	return balance, nil
 }
 
-func GetAccountWEthBalance(address string) (float64, error) {
-	balanceBig, err := BalanceOf(address, config.WETH_ADDRESS)
+func (s *Service) GetAccountWEthBalance(address string) (float64, error) {
+	balanceBig, err := s.BalanceOf(address, s.cfg.WethAddress)
 	if err != nil {
 		return 0, err
 	}
@@ -162,9 +101,8 @@ func GetAccountWEthBalance(address string) (float64, error) {
 	return balance, nil
 }
 
-func GetNoParamProp(address, info string) (interface{}, error) {
+func (s *Service) GetNoParamProp(address, info string) (interface{}, error) {
 	abi := getAbi()
-	client := getClient()
 
 	contractAddr := common.HexToAddress(address)
 	bytes, _ := abi.Pack(info)
@@ -174,7 +112,7 @@ func GetNoParamProp(address, info string) (interface{}, error) {
 		Data: bytes,
 	}
 
-	ret, err := client.CallContract(context.Background(), msg, nil)
+	ret, err := s.rpc.CallContract(context.Background(), msg, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -187,22 +125,17 @@ func GetNoParamProp(address, info string) (interface{}, error) {
 	return intr, err
 }
 
-func GetSingleProp(address, info string) (interface{}, error) {
-	return getSingleProp(address, info, getClient(), nil)
+func (s *Service) GetSingleProp(address, info string) (interface{}, error) {
+	return getSingleProp(address, info, s.rpc, nil)
 }
 
-func getSingleProp(address, info string, client *ethclient.Client, height *big.Int) (interface{}, error) {
+func getSingleProp(address, info string, client RPC, height *big.Int) (interface{}, error) {
 	abi := getAbi()
 	return _getSingleProp(abi, address, info, client, height)
 }
 
-func getSingleBackupProp(address, info string, client *ethclient.Client, height *big.Int) (interface{}, error) {
-	abi := getBackupAbi()
-	return _getSingleProp(abi, address, info, client, height)
-}
-
 // 判断是否是合约地址
-func IsContract(address string) int {
+func (s *Service) IsContract(address string) int {
 	const CHECK_CONTRACT = "0x4E013d527f23CD7Cb5b08f6A908de68ce6C57C3e"
 
 	abi := getAbi()
@@ -219,7 +152,7 @@ func IsContract(address string) int {
 		Data: data,
 	}
 
-	ret, err := getClient().CallContract(context.Background(), msg, nil)
+	ret, err := s.rpc.CallContract(context.Background(), msg, nil)
 	if err != nil {
 		utils.Debugf("[ IsContract ] CallContract error. addr: %v, err: %v", address, err)
 		return 0
@@ -239,61 +172,73 @@ func IsContract(address string) int {
 	return 0
 }
 
-func GetBasicCoinPrice(client *ethclient.Client, height *big.Int, chain string) (float64, error) {
-	if client == nil {
-		client = getClient()
+// 获取链上原生币(eth/bnb等)的usd价格, 通过链配置中的价格池计算
+// 如果配置了height, 需要节点支持archive查询功能
+func (s *Service) GetBasicCoinPrice(height *big.Int) (float64, error) {
+	pool := s.cfg.NativePricePool
+	if pool.Address == "" {
+		return 0, fmt.Errorf("chain %v has no native price pool", s.cfg.Name)
+	}
+
+	if pool.Type == "v2" {
+		return s.getNativePriceByV2Pool()
+	}
+
+	return s.getNativePriceByV3Pool(height)
+}
+
+// 价格池中另一边为稳定币
+func (s *Service) getNativePriceByV2Pool() (float64, error) {
+	pool := s.cfg.NativePricePool
+
+	r0, r1, err := s.GetUniV2PairReserves(pool.Address)
+	if err != nil {
+		return 0, err
 	}
 
-	if chain == "bsc" {
-		return GetBnbPriceByBsc(client)
+	var price utils.Decimal
+	if pool.NativeIsToken0 {
+		price = utils.TokenPriceToDecimal(r1, pool.Decimal1, r0, pool.Decimal0)
+	} else {
+		price = utils.TokenPriceToDecimal(r0, pool.Decimal0, r1, pool.Decimal1)
 	}
 
-	return GetEthPrice(client, height)
+	return price.Float64(), nil
 }
 
-// 获取eth价格,
-// 如果配置了height, 需要client支持archive查询功能, 可以用infura
-func GetEthPrice(client *ethclient.Client, height *big.Int) (float64, error) {
+func (s *Service) getNativePriceByV3Pool(height *big.Int) (float64, error) {
+	pool := s.cfg.NativePricePool
+
+	client := s.rpc
 	if height != nil {
-		client = getInfuraClient() // 当指定高度时, 则需要去infura上获取
+		client = s.archive // 当指定高度时, 则需要去archive节点上获取
 	}
 
-	const ETH_UNI_POOL = "0x88e6A0c2dDD26FEEb64F039a2c41296FcB3f5640"
-	priceSqrt, err := getSingleProp(ETH_UNI_POOL, "slot0", client, height)
+	priceSqrt, err := getSingleProp(pool.Address, "slot0", client, height)
 
 	if err != nil {
-		utils.Warnf("[ GetEthPrice ] get price error, will retry via infura. error: %v, height: %v\n", err, height)
+		utils.Warnf("[ getNativePriceByV3Pool ] get price error, will retry via archive. error: %v, height: %v\n", err, height)
 
-		client = getInfuraClient() // 出错了, 重新获取一次
-		priceSqrt, err = getSingleProp(ETH_UNI_POOL, "slot0", client, height)
+		priceSqrt, err = getSingleProp(pool.Address, "slot0", s.archive, height) // 出错了, 重新获取一次
 		if err != nil {
-			utils.Errorf("[ GetEthPrice ] failed in **infura** again! error: %v, height: %v\n", err, height)
+			utils.Errorf("[ getNativePriceByV3Pool ] failed in **archive** again! error: %v, height: %v\n", err, height)
 
 			return 0, err
 		}
 	}
 
-	price := priceSqrt.(*big.Int)
-	price = price.Mul(price, price)
-	price = price.Mul(price, big.NewInt(1e18))
-
-	newPrice := new(big.Float).SetInt(price)
-	newPrice = newPrice.Quo(newPrice, new(big.Float).SetFloat64(1<<192))
-
-	// 还需要处理decimal问题, 这里由于是固定eth价格且固定交易对, 直接写死了
-	newPrice = newPrice.Quo(newPrice, new(big.Float).SetFloat64(1e30))
-	newPrice = newPrice.Quo(new(big.Float).SetFloat64(1), newPrice)
-
-	ret, _ := newPrice.Float64()
+	// sqrtPriceX96 为 token0 以 token1 计价, 原生币为 token1 时取倒数
+	price := utils.SqrtPriceX96ToDecimal(priceSqrt.(*big.Int), pool.Decimal0, pool.Decimal1, !pool.NativeIsToken0)
 
+	ret := price.Float64()
 	ret = float64(int(ret*100)) / 100
 
-	// utils.Debugf("[ GetEthPrice ] block height: %v, price: %v", height, ret)
+	// utils.Debugf("[ getNativePriceByV3Pool ] block height: %v, price: %v", height, ret)
 	return ret, nil
 }
 
-func TEST_CHAIN() {
-	block, err := GetCurrentBlockNumber()
+func TEST_CHAIN(s *Service) {
+	block, err := s.GetCurrentBlockNumber()
 	utils.Debugf("block: %v, err: %v", block, err)
 }
 
@@ -0,0 +1,100 @@
+package chain
+
+import (
+	"context"
+	"math/big"
+	"sfilter/utils"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// 非 uniswap 系 dex 用到的合约接口与事件
+var chainDexAbi *abi.ABI
+
+func getDexAbi() *abi.ABI {
+	if chainDexAbi == nil {
+		abi, err := abi.JSON(strings.NewReader(DexAbiJson))
+		if err != nil {
+			utils.Fatalf("getDexAbi error! err: %v", err)
+		}
+
+		chainDexAbi = &abi
+	}
+
+	return chainDexAbi
+}
+
+func (s *Service) callDexMethod(address, method string, args ...interface{}) ([]interface{}, error) {
+	abi := getDexAbi()
+
+	data, err := abi.Pack(method, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	contractAddr := common.HexToAddress(address)
+	msg := ethereum.CallMsg{
+		From: common.Address{},
+		To:   &contractAddr,
+		Data: data,
+	}
+
+	ret, err := s.rpc.CallContract(context.Background(), msg, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return abi.Methods[method].Outputs.UnpackValues(ret)
+}
+
+// curve 池子第 index 个币的地址
+// 新池子为 coins(uint256), 老池子为 coins(int128)
+func (s *Service) GetCurvePoolCoin(pool string, index int64) (string, error) {
+	ret, err := s.callDexMethod(pool, "coins", big.NewInt(index))
+	if err != nil {
+		ret, err = s.callDexMethod(pool, "coins0", big.NewInt(index))
+	}
+
+	if err != nil {
+		utils.Debugf("[ GetCurvePoolCoin ] call coins error. pool: %v, index: %v, err: %v", pool, index, err)
+		return "", err
+	}
+
+	return ret[0].(common.Address).String(), nil
+}
+
+func (s *Service) GetMaverickPoolTokens(pool string) (string, string, error) {
+	tokenA, err := s.callDexMethod(pool, "tokenA")
+	if err != nil {
+		return "", "", err
+	}
+
+	tokenB, err := s.callDexMethod(pool, "tokenB")
+	if err != nil {
+		return "", "", err
+	}
+
+	return tokenA[0].(common.Address).String(), tokenB[0].(common.Address).String(), nil
+}
+
+// 解析事件中非indexed的字段
+func UnpackDexEvent(event string, data []byte) ([]interface{}, error) {
+	return getDexAbi().Events[event].Inputs.NonIndexed().UnpackValues(data)
+}
+
+const DexAbiJson = `[
+	{"inputs":[{"name":"i","type":"uint256"}],"name":"coins","outputs":[{"name":"","type":"address"}],"stateMutability":"view","type":"function"},
+	{"inputs":[{"name":"i","type":"int128"}],"name":"coins","outputs":[{"name":"","type":"address"}],"stateMutability":"view","type":"function"},
+	{"inputs":[],"name":"tokenA","outputs":[{"name":"","type":"address"}],"stateMutability":"view","type":"function"},
+	{"inputs":[],"name":"tokenB","outputs":[{"name":"","type":"address"}],"stateMutability":"view","type":"function"},
+	{"anonymous":false,"inputs":[
+		{"indexed":true,"name":"poolId","type":"bytes32"},
+		{"indexed":true,"name":"liquidityProvider","type":"address"},
+		{"indexed":false,"name":"tokens","type":"address[]"},
+		{"indexed":false,"name":"deltas","type":"int256[]"},
+		{"indexed":false,"name":"protocolFeeAmounts","type":"uint256[]"}
+	],"name":"PoolBalanceChanged","type":"event"}
+]`
@@ -0,0 +1,126 @@
+package chain
+
+import (
+	"context"
+	"strings"
+
+	"sfilter/config"
+	"sfilter/utils"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var chainMulticallAbi *abi.ABI
+
+func getMulticallAbi() *abi.ABI {
+	if chainMulticallAbi == nil {
+		abi, err := abi.JSON(strings.NewReader(MulticallAbiJson))
+		if err != nil {
+			utils.Fatalf("getMulticallAbi error! err: %v", err)
+		}
+
+		chainMulticallAbi = &abi
+	}
+
+	return chainMulticallAbi
+}
+
+// multicall 中的一次调用
+type Call struct {
+	Target string
+	Data   []byte
+}
+
+// 单个调用的结果, 调用失败时 Success 为 false
+type CallResult struct {
+	Success    bool
+	ReturnData []byte
+}
+
+// 通过 Multicall3.aggregate3 一次请求完成多个调用, 单个调用失败不影响其他调用
+// 链上没有部署 multicall 时逐个调用
+func (s *Service) Multicall(calls []Call) ([]CallResult, error) {
+	type call3 struct {
+		Target       common.Address
+		AllowFailure bool
+		CallData     []byte
+	}
+
+	args := make([]call3, 0, len(calls))
+	for _, c := range calls {
+		args = append(args, call3{Target: common.HexToAddress(c.Target), AllowFailure: true, CallData: c.Data})
+	}
+
+	multicallAbi := getMulticallAbi()
+
+	data, err := multicallAbi.Pack("aggregate3", args)
+	if err != nil {
+		return nil, err
+	}
+
+	contractAddr := common.HexToAddress(config.Multicall3Address)
+	msg := ethereum.CallMsg{
+		From: common.Address{},
+		To:   &contractAddr,
+		Data: data,
+	}
+
+	ret, err := s.rpc.CallContract(context.Background(), msg, nil)
+	if err == nil {
+		var results []CallResult
+		err = multicallAbi.UnpackIntoInterface(&results, "aggregate3", ret)
+		if err == nil && len(results) == len(calls) {
+			return results, nil
+		}
+	}
+
+	utils.Debugf("[ Multicall ] aggregate3 failed, call one by one. err: %v", err)
+	return s.callOneByOne(calls)
+}
+
+// 只有 rpc 本身出错时返回错误, 合约调用 revert 记为失败
+func (s *Service) callOneByOne(calls []Call) ([]CallResult, error) {
+	results := make([]CallResult, len(calls))
+
+	for i, c := range calls {
+		contractAddr := common.HexToAddress(c.Target)
+		msg := ethereum.CallMsg{
+			From: common.Address{},
+			To:   &contractAddr,
+			Data: c.Data,
+		}
+
+		ret, err := s.rpc.CallContract(context.Background(), msg, nil)
+		if err != nil {
+			if isRevertError(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		results[i] = CallResult{Success: true, ReturnData: ret}
+	}
+
+	return results, nil
+}
+
+func isRevertError(err error) bool {
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "revert") || strings.Contains(msg, "invalid opcode")
+}
+
+const MulticallAbiJson = `[
+	{"inputs":[{"components":[
+		{"name":"target","type":"address"},
+		{"name":"allowFailure","type":"bool"},
+		{"name":"callData","type":"bytes"}
+	],"name":"calls","type":"tuple[]"}],
+	"name":"aggregate3",
+	"outputs":[{"components":[
+		{"name":"success","type":"bool"},
+		{"name":"returnData","type":"bytes"}
+	],"name":"returnData","type":"tuple[]"}],
+	"stateMutability":"payable","type":"function"}
+]`
@@ -13,13 +13,8 @@ import (
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/ethclient"
-
-	"github.com/daoleno/uniswapv3-sdk/examples/quoter/uniswapv3"
 )
 
-var chain_quoter *uniswapv3.Quoter
-
 /*
 refer: https://etherscan.io/tx/0x414eb36cfea5fb34e068a79196480e20caf021fb0921cf148f5aa0967faffe1d#eventlog
 
@@ -28,20 +23,20 @@ refer: https://etherscan.io/tx/0x414eb36cfea5fb34e068a79196480e20caf021fb0921cf1
 2. 获得factory地址(不写死是为了防止有人fork v3代码导致混乱)
 3. 调用factory的 getPool 函数获得pool地址
 */
-func GetUniV3PoolAddr(postionManagerAddr string, tokenId *big.Int) (string, error) {
+func (s *Service) GetUniV3PoolAddr(postionManagerAddr string, tokenId *big.Int) (string, error) {
 	var pool string
-	token0, token1, fee, err := getUniV3Position(postionManagerAddr, tokenId)
+	token0, token1, fee, err := s.getUniV3Position(postionManagerAddr, tokenId)
 	if err != nil {
 		return pool, err
 	}
 
-	addr, err := getSingleProp(postionManagerAddr, "factory", getClient(), nil)
+	addr, err := getSingleProp(postionManagerAddr, "factory", s.rpc, nil)
 	if err != nil {
 		return pool, err
 	}
 	factory := addr.(common.Address)
 
-	poolAddr, err := getPoolAddr(factory, token0, token1, fee)
+	poolAddr, err := s.getPoolAddr(factory, token0, token1, fee)
 	if err != nil {
 		return pool, err
 	}
@@ -50,7 +45,7 @@ func GetUniV3PoolAddr(postionManagerAddr string, tokenId *big.Int) (string, erro
 	return pool, nil
 }
 
-func getPoolAddr(factoryAddr, token0, token1 common.Address, fee *big.Int) (common.Address, error) {
+func (s *Service) getPoolAddr(factoryAddr, token0, token1 common.Address, fee *big.Int) (common.Address, error) {
 	var pool common.Address
 
 	abi := getAbi()
@@ -66,7 +61,7 @@ func getPoolAddr(factoryAddr, token0, token1 common.Address, fee *big.Int) (comm
 		Data: data,
 	}
 
-	ret, err := getClient().CallContract(context.Background(), msg, nil)
+	ret, err := s.rpc.CallContract(context.Background(), msg, nil)
 	if err != nil {
 		utils.Debugf("[ getPoolAddr ] CallContract error. addr: %v, factory: %v\n", factoryAddr, err)
 		return pool, err
@@ -83,7 +78,7 @@ func getPoolAddr(factoryAddr, token0, token1 common.Address, fee *big.Int) (comm
 	return pool, nil
 }
 
-func getUniV3Position(postionManagerAddr string, tokenId *big.Int) (common.Address, common.Address, *big.Int, error) {
+func (s *Service) getUniV3Position(postionManagerAddr string, tokenId *big.Int) (common.Address, common.Address, *big.Int, error) {
 	var token0, token1 common.Address
 	var fee *big.Int
 
@@ -101,7 +96,7 @@ func getUniV3Position(postionManagerAddr string, tokenId *big.Int) (common.Addre
 		Data: data,
 	}
 
-	ret, err := getClient().CallContract(context.Background(), msg, nil)
+	ret, err := s.rpc.CallContract(context.Background(), msg, nil)
 	if err != nil {
 		// 回溯历史的时候, 由于 tokenId可能已被burn, 这里是可能报错的
 		utils.Debugf("[ getUniV3Position ] CallContract error. addr: %v, tokenId: %v, err: %v\n", postionManagerAddr, tokenId, err)
@@ -122,8 +117,8 @@ func getUniV3Position(postionManagerAddr string, tokenId *big.Int) (common.Addre
 }
 
 // UniV2 getReserves
-func GetUniV2PairReserves(pair string) (*big.Int, *big.Int, error) {
-	reserves, err := GetNoParamProp(pair, "getReserves")
+func (s *Service) GetUniV2PairReserves(pair string) (*big.Int, *big.Int, error) {
+	reserves, err := s.GetNoParamProp(pair, "getReserves")
 	if err != nil {
 		log.Printf("[ GetUniV2PairReserves ] getReserves error. pair: %v, err: %v\n", pair, err)
 		return nil, nil, err
@@ -152,8 +147,8 @@ func GetUniV2PairReserves(pair string) (*big.Int, *big.Int, error) {
 }
 
 // uni v2
-func GetUniV2SwapAmountOut(pair string, token0 string, tokenIn string, amountIn *big.Int) (*big.Int, error) {
-	r0, r1, err := GetUniV2PairReserves(pair)
+func (s *Service) GetUniV2SwapAmountOut(pair string, token0 string, tokenIn string, amountIn *big.Int) (*big.Int, error) {
+	r0, r1, err := s.GetUniV2PairReserves(pair)
 	if err != nil {
 		return nil, err
 	}
@@ -180,20 +175,17 @@ func getAmountOut(amountIn *big.Int, reserveIn *big.Int, reserveOut *big.Int) *b
 	return numerator.Div(numerator, denominator)
 }
 
-func GetUniV3SwapAmountOut(tokenIn, tokenOut string, fee, amountIn *big.Int) (*big.Int, error) {
-	var err error
-	if chain_quoter == nil {
-		chain_quoter, err = uniswapv3.NewQuoter(common.HexToAddress(config.Quoter_Contract_Address), getClient())
-		if err != nil {
-			utils.Errorf("[ GetUniV3SwapAmountOut ] NewQuoter error: %v", err)
-			return nil, err
-		}
+func (s *Service) GetUniV3SwapAmountOut(tokenIn, tokenOut string, fee, amountIn *big.Int) (*big.Int, error) {
+	quoter, err := s.getQuoter()
+	if err != nil {
+		utils.Errorf("[ GetUniV3SwapAmountOut ] NewQuoter error: %v", err)
+		return nil, err
 	}
 
 	tokenInAddr := common.HexToAddress(tokenIn)
 	tokenOutAddr := common.HexToAddress(tokenOut)
 
-	amountOut, err := chain_quoter.QuoterCaller.QuoteExactInputSingle(&bind.CallOpts{}, tokenInAddr, tokenOutAddr, fee, amountIn, big.NewInt(0))
+	amountOut, err := quoter.QuoteExactInputSingle(&bind.CallOpts{}, tokenInAddr, tokenOutAddr, fee, amountIn, big.NewInt(0))
 	if err != nil {
 		utils.Warnf("[ GetUniV3SwapAmountOut ] call QuoteExactInputSingle error: %v", err)
 		return nil, err
@@ -202,13 +194,13 @@ func GetUniV3SwapAmountOut(tokenIn, tokenOut string, fee, amountIn *big.Int) (*b
 	return amountOut, nil
 }
 
-func GetUniV2PoolTokenHackType(_pair *schema.Pair) int {
+func (s *Service) GetUniV2PoolTokenHackType(_pair *schema.Pair) int {
 	// 如下假设我们合约有钱, 没钱也会误判..!
 
 	// 先检测是否是正常币
-	ret := getHackStatusFromContract(_pair, 1)
+	ret := s.getHackStatusFromContract(_pair, 1)
 	if ret == 1 { // 如果执行失败, 说明是通缩币或者坑人币
-		retDeflat := getHackStatusFromContract(_pair, 2)
+		retDeflat := s.getHackStatusFromContract(_pair, 2)
 		if retDeflat == 0 {
 			// 此时一定为通缩币
 			return schema.PAIR_MAINTOKEN_HACK_TYPE_DEFLAT
@@ -230,20 +222,20 @@ func GetUniV2PoolTokenHackType(_pair *schema.Pair) int {
 // 获取pair中的maintoken是否为通缩币、坑人币等
 //
 //	0表示检测合约通过; 1表示检测合约不通过; 2表示无法检测; 3 表示pair没钱
-func getHackStatusFromContract(_pair *schema.Pair, divFactorInt int) int {
+func (s *Service) getHackStatusFromContract(_pair *schema.Pair, divFactorInt int) int {
 	hackCheckAddr := config.Hacker_Check_Contract_Address
 
 	abi := getAbi()
 	contractAddr := common.HexToAddress(hackCheckAddr)
 
 	// 必须有一个是eth token, 否则认为是不识别类型
-	if !utils.Contains(config.QuoteEthCoinList, _pair.Token0) && !utils.Contains(config.QuoteEthCoinList, _pair.Token1) {
+	if !utils.Contains(s.cfg.QuoteEthCoinList, _pair.Token0) && !utils.Contains(s.cfg.QuoteEthCoinList, _pair.Token1) {
 		return 2
 	}
 
 	// 找出weth
 	var tokenFrom, tokenTo string
-	if utils.Contains(config.QuoteEthCoinList, _pair.Token0) {
+	if utils.Contains(s.cfg.QuoteEthCoinList, _pair.Token0) {
 		tokenFrom = _pair.Token0
 		tokenTo = _pair.Token1
 	} else {
@@ -252,7 +244,7 @@ func getHackStatusFromContract(_pair *schema.Pair, divFactorInt int) int {
 	}
 
 	//先判断里面余额是否 >0, 否则合约肯定失败
-	balanceOfMainToken, err := BalanceOf(_pair.Address, tokenTo)
+	balanceOfMainToken, err := s.BalanceOf(_pair.Address, tokenTo)
 	if err != nil || balanceOfMainToken.String() == "0" {
 		return 3 // 余额不足
 	}
@@ -270,7 +262,7 @@ func getHackStatusFromContract(_pair *schema.Pair, divFactorInt int) int {
 		Data: data,
 	}
 
-	_, err = getClient().CallContract(context.Background(), msg, nil)
+	_, err = s.rpc.CallContract(context.Background(), msg, nil)
 	if err != nil {
 		// utils.Warnf("[ getHackStatusFromContract ] CallContract error. addr: %v, err: %v\n", _pair.Address, err)
 
@@ -283,32 +275,15 @@ func getHackStatusFromContract(_pair *schema.Pair, divFactorInt int) int {
 	return 0
 }
 
-// 获取bsc链上的wbnb等价格
-// 这是基础函数, 写挫一点, 不引入任何第三方接口
-func GetBnbPriceByBsc(client *ethclient.Client) (float64, error) {
-	poolAddr := "0x16b9a82891338f9bA80E2D6970FddA79D1eb0daE"
-
-	r0, r1, err := GetUniV2PairReserves(poolAddr)
-	if err != nil {
-		return 0, nil
-	}
-
-	r0 = r0.Mul(r0, big.NewInt(1e18))
-	r0 = r0.Div(r0, r1)
-	priceWith1e18, _ := r0.Float64()
-
-	return priceWith1e18 / 1e18, nil
-}
-
-func GetUniPoolType(poolAddr string) (int, error) {
+func (s *Service) GetUniPoolType(poolAddr string) (int, error) {
 	// uni v2 check
-	_, err := getSingleProp(poolAddr, "kLast", getClient(), nil)
+	_, err := getSingleProp(poolAddr, "kLast", s.rpc, nil)
 	if err == nil {
 		return schema.SWAP_EVENT_UNISWAPV2_LIKE, nil
 	}
 
 	// uni v3 check
-	_, err = getSingleProp(poolAddr, "maxLiquidityPerTick", getClient(), nil)
+	_, err = getSingleProp(poolAddr, "maxLiquidityPerTick", s.rpc, nil)
 	if err == nil {
 		return schema.SWAP_EVENT_UNISWAPV3_LIKE, nil
 	}
@@ -316,8 +291,8 @@ func GetUniPoolType(poolAddr string) (int, error) {
 	return 0, err
 }
 
-func GetUniV3PairFee(poolAddr string) (*big.Int, error) {
-	fee, err := getSingleProp(poolAddr, "fee", getClient(), nil)
+func (s *Service) GetUniV3PairFee(poolAddr string) (*big.Int, error) {
+	fee, err := getSingleProp(poolAddr, "fee", s.rpc, nil)
 	if err != nil {
 		utils.Warnf("[ GetUniV3PairFee ] get prop of pool(%v) error: %v", poolAddr, err)
 		return nil, err
@@ -326,18 +301,18 @@ func GetUniV3PairFee(poolAddr string) (*big.Int, error) {
 	return fee.(*big.Int), nil
 }
 
-func TEST_POOL() {
+func TEST_POOL(s *Service) {
 	// tokenIn := "0x6a8C648C7635B50836285fD02ba5482d9526DEc0"
 	// tokenOut := "0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2"
 
 	// fee := big.NewInt(3000)
 	// amountIn, _ := big.NewInt(0).SetString("2375067830253394", 10)
 
-	// v3, err := GetUniV3SwapAmountOut(tokenIn, tokenOut, fee, amountIn)
+	// v3, err := s.GetUniV3SwapAmountOut(tokenIn, tokenOut, fee, amountIn)
 
 	// fmt.Printf("[ TEST_POOL ] v3: %v, err: %v\n\n", v3, err)
 
-	price, err := GetBnbPriceByBsc(nil)
+	price, err := s.GetBasicCoinPrice(nil)
 	utils.Infof("price: %v, err: %v", price, err)
 
 }
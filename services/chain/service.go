@@ -0,0 +1,81 @@
+package chain
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	"sfilter/config"
+	"sfilter/services/rpcpool"
+
+	"github.com/daoleno/uniswapv3-sdk/examples/quoter/uniswapv3"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// 链上调用需要的 rpc 接口, *ethclient.Client 和 *rpcpool.Pool 都已实现
+// 测试时可以替换为 mock
+type RPC interface {
+	bind.ContractCaller
+
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+	StorageAt(ctx context.Context, account common.Address, key common.Hash, blockNumber *big.Int) ([]byte, error)
+}
+
+// 单条链的链上查询服务, 所有依赖都由外部传入
+type Service struct {
+	rpc     RPC
+	archive RPC // 支持历史高度查询的节点, 为空时使用 rpc
+	db      *mongo.Database
+	cfg     *config.ChainConfig
+
+	quoterOnce sync.Once
+	quoter     *uniswapv3.QuoterCaller
+	quoterErr  error
+}
+
+func NewService(cfg *config.ChainConfig, rpc, archive RPC, db *mongo.Database) *Service {
+	if archive == nil {
+		archive = rpc
+	}
+
+	return &Service{
+		rpc:     rpc,
+		archive: archive,
+		db:      db,
+		cfg:     cfg,
+	}
+}
+
+// 按链配置连接节点池, 历史高度的查询由节点池路由到 archive 节点
+func Dial(cfg *config.ChainConfig, db *mongo.Database) (*Service, error) {
+	pool, err := rpcpool.Dial(cfg.Name, cfg.RPCEndpoints())
+	if err != nil {
+		return nil, err
+	}
+
+	return NewService(cfg, pool, nil, db), nil
+}
+
+func (s *Service) Config() *config.ChainConfig {
+	return s.cfg
+}
+
+func (s *Service) DB() *mongo.Database {
+	return s.db
+}
+
+func (s *Service) RPC() RPC {
+	return s.rpc
+}
+
+func (s *Service) getQuoter() (*uniswapv3.QuoterCaller, error) {
+	s.quoterOnce.Do(func() {
+		s.quoter, s.quoterErr = uniswapv3.NewQuoterCaller(common.HexToAddress(config.Quoter_Contract_Address), s.rpc)
+	})
+
+	return s.quoter, s.quoterErr
+}
@@ -0,0 +1,213 @@
+package chain
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+
+	"sfilter/config"
+	"sfilter/schema"
+	"sfilter/utils"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var ErrTokenMetadataInvalid = errors.New("token metadata invalid")
+
+// 代理合约的存储槽位
+var (
+	eip1967ImplementationSlot = common.HexToHash("0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc")
+	eip1967BeaconSlot         = common.HexToHash("0xa3f0ad74e5423aebfd80d3ef4346578335a9a72aeaee59ff6cb3582b35133d50")
+	eip1822ProxiableSlot      = common.HexToHash("0xc5f16f0fcc639fa48a6947836d9850f504798523bf8c9a3a87d5876cf622bcf7")
+)
+
+var erc777TokenHash = crypto.Keccak256Hash([]byte("ERC777Token"))
+
+// 能调用成功即认为是 rebasing token: stETH、aToken、OUSD
+var rebasingMethods = []string{"getTotalShares", "scaledTotalSupply", "rebasingCreditsPerToken"}
+
+var chainResolverAbi *abi.ABI
+
+func getResolverAbi() *abi.ABI {
+	if chainResolverAbi == nil {
+		abi, err := abi.JSON(strings.NewReader(ResolverAbiJson))
+		if err != nil {
+			utils.Fatalf("getResolverAbi error! err: %v", err)
+		}
+
+		chainResolverAbi = &abi
+	}
+
+	return chainResolverAbi
+}
+
+// 从链上获取 token 元数据, 所有查询通过一次 multicall 完成
+// 识别代理合约及 token 标准; 取不到的元数据记录在 MetadataStatus 中, 不使用0值冒充
+// 只有 rpc 出错时返回错误
+func (s *Service) ResolveToken(address string) (*schema.Token, error) {
+	tokenAbi, resolverAbi := getAbi(), getResolverAbi()
+
+	pack := func(a *abi.ABI, method string, args ...interface{}) []byte {
+		data, _ := a.Pack(method, args...)
+		return data
+	}
+
+	calls := []Call{
+		{Target: address, Data: pack(tokenAbi, "decimals")},
+		{Target: address, Data: pack(tokenAbi, "name")},
+		{Target: address, Data: pack(tokenAbi, "symbol")},
+		{Target: address, Data: pack(tokenAbi, "totalSupply")},
+		{Target: config.ERC1820RegistryAddress, Data: pack(resolverAbi, "getInterfaceImplementer", common.HexToAddress(address), erc777TokenHash)},
+	}
+	for _, method := range rebasingMethods {
+		calls = append(calls, Call{Target: address, Data: pack(resolverAbi, method)})
+	}
+
+	results, err := s.Multicall(calls)
+	if err != nil {
+		return nil, err
+	}
+
+	token := &schema.Token{}
+	token.Address = address
+	token.MetadataStatus = schema.TOKEN_METADATA_OK
+
+	decimals, ok := unpackResult(tokenAbi, "decimals", results[0])
+	if ok {
+		token.Decimal = decimals.(uint8)
+	} else {
+		token.MetadataStatus = schema.TOKEN_METADATA_INVALID
+	}
+
+	token.Name = unpackStringResult("name", results[1])
+	token.Symbol = unpackStringResult("symbol", results[2])
+
+	if totalSupply, ok := unpackResult(tokenAbi, "totalSupply", results[3]); ok {
+		token.TotalSupply = totalSupply.(*big.Int).String()
+	} else {
+		token.TotalSupply = big.NewInt(0).String()
+		markPartial(token)
+	}
+
+	if token.Name == "" {
+		token.Name = token.Symbol
+		markPartial(token)
+	}
+
+	// symbol 取不到时用 name 代替, 都取不到则 pair 名称无法生成
+	if token.Symbol == "" {
+		token.Symbol = token.Name
+		markPartial(token)
+
+		if token.Symbol == "" {
+			token.MetadataStatus = schema.TOKEN_METADATA_INVALID
+		}
+	}
+
+	token.Standard = schema.TOKEN_STANDARD_ERC20
+	if implementer, ok := unpackResult(resolverAbi, "getInterfaceImplementer", results[4]); ok && implementer.(common.Address) != (common.Address{}) {
+		token.Standard = schema.TOKEN_STANDARD_ERC777
+	}
+
+	for i, method := range rebasingMethods {
+		if _, ok := unpackResult(resolverAbi, method, results[5+i]); ok {
+			token.Standard = schema.TOKEN_STANDARD_REBASING
+			break
+		}
+	}
+
+	token.ProxyType, token.Implementation = s.detectProxy(address)
+
+	if token.MetadataStatus != schema.TOKEN_METADATA_OK {
+		utils.Warnf("[ ResolveToken ] bad metadata, token: %v, status: %v, symbol: %v", address, token.MetadataStatus, token.Symbol)
+	}
+
+	return token, nil
+}
+
+func markPartial(token *schema.Token) {
+	if token.MetadataStatus == schema.TOKEN_METADATA_OK {
+		token.MetadataStatus = schema.TOKEN_METADATA_PARTIAL
+	}
+}
+
+func unpackResult(a *abi.ABI, method string, r CallResult) (interface{}, bool) {
+	if !r.Success || len(r.ReturnData) == 0 {
+		return nil, false
+	}
+
+	values, err := a.Methods[method].Outputs.UnpackValues(r.ReturnData)
+	if err != nil || len(values) == 0 {
+		return nil, false
+	}
+
+	return values[0], true
+}
+
+// 部分老 token 的 name、symbol 返回 bytes32
+func unpackStringResult(method string, r CallResult) string {
+	if value, ok := unpackResult(getAbi(), method, r); ok {
+		return value.(string)
+	}
+
+	if value, ok := unpackResult(getBackupAbi(), method, r); ok {
+		b := value.([32]byte)
+		return string(bytes.TrimRight(b[:], "\x00"))
+	}
+
+	return ""
+}
+
+// 依次检查 EIP-1967 实现槽、beacon 槽与 EIP-1822 槽, 返回代理类型与实现合约
+// 非代理合约或查询失败时返回空
+func (s *Service) detectProxy(address string) (string, string) {
+	contractAddr := common.HexToAddress(address)
+
+	if impl, ok := s.storageAddress(contractAddr, eip1967ImplementationSlot); ok {
+		return schema.TOKEN_PROXY_EIP1967, impl.String()
+	}
+
+	if beacon, ok := s.storageAddress(contractAddr, eip1967BeaconSlot); ok {
+		results, err := s.callOneByOne([]Call{{Target: beacon.String(), Data: getResolverAbi().Methods["implementation"].ID}})
+		if err != nil {
+			utils.Debugf("[ detectProxy ] get beacon implementation error: %v, token: %v", err, address)
+			return schema.TOKEN_PROXY_EIP1967_BEACON, ""
+		}
+
+		impl, _ := unpackResult(getResolverAbi(), "implementation", results[0])
+		if impl == nil {
+			return schema.TOKEN_PROXY_EIP1967_BEACON, ""
+		}
+
+		return schema.TOKEN_PROXY_EIP1967_BEACON, impl.(common.Address).String()
+	}
+
+	if impl, ok := s.storageAddress(contractAddr, eip1822ProxiableSlot); ok {
+		return schema.TOKEN_PROXY_EIP1822, impl.String()
+	}
+
+	return "", ""
+}
+
+func (s *Service) storageAddress(contractAddr common.Address, slot common.Hash) (common.Address, bool) {
+	data, err := s.rpc.StorageAt(context.Background(), contractAddr, slot, nil)
+	if err != nil {
+		utils.Debugf("[ storageAddress ] StorageAt error: %v, contract: %v", err, contractAddr)
+		return common.Address{}, false
+	}
+
+	addr := common.BytesToAddress(data)
+	return addr, addr != (common.Address{})
+}
+
+const ResolverAbiJson = `[
+	{"inputs":[{"name":"account","type":"address"},{"name":"interfaceHash","type":"bytes32"}],"name":"getInterfaceImplementer","outputs":[{"name":"","type":"address"}],"stateMutability":"view","type":"function"},
+	{"inputs":[],"name":"implementation","outputs":[{"name":"","type":"address"}],"stateMutability":"view","type":"function"},
+	{"inputs":[],"name":"getTotalShares","outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"},
+	{"inputs":[],"name":"scaledTotalSupply","outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"},
+	{"inputs":[],"name":"rebasingCreditsPerToken","outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"}
+]`
@@ -0,0 +1,136 @@
+package eventbus
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"sfilter/config"
+	"sfilter/utils"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	TRANSPORT_LOCAL = "local"
+	TRANSPORT_MONGO = "mongo"
+)
+
+type Handler func(ev *Event)
+
+// 事件总线, 按 topic 分发给订阅者
+// 订阅者在同一个协程中依次执行, 不要在 handler 里做太重的事
+type Bus struct {
+	chain     string
+	transport Transport
+
+	mu       sync.RWMutex
+	handlers map[string][]Handler
+
+	cancel context.CancelFunc
+}
+
+func New(chain string, transport Transport) *Bus {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	b := &Bus{
+		chain:     chain,
+		transport: transport,
+		handlers:  make(map[string][]Handler),
+		cancel:    cancel,
+	}
+
+	go b.receive(ctx)
+
+	return b
+}
+
+// 按配置选择传输方式
+func Dial(chain string, db *mongo.Database) (*Bus, error) {
+	if config.EventBusTransport == TRANSPORT_LOCAL {
+		return New(chain, NewLocalTransport()), nil
+	}
+
+	transport, err := NewMongoTransport(db)
+	if err != nil {
+		return nil, err
+	}
+
+	return New(chain, transport), nil
+}
+
+// 发布失败只打印日志, 不影响区块处理; bus 为空时什么都不做
+func (b *Bus) Publish(topic string, blockNo int64, data interface{}) {
+	if b == nil {
+		return
+	}
+
+	payload, err := bson.Marshal(data)
+	if err != nil {
+		utils.Errorf("[ Bus.Publish ] marshal %v failed: %v, block: %v", topic, err, blockNo)
+		return
+	}
+
+	ev := &Event{
+		Topic:     topic,
+		Chain:     b.chain,
+		BlockNo:   blockNo,
+		Payload:   payload,
+		CreatedAt: time.Now(),
+	}
+
+	// 缓冲满时由 LocalTransport 记录丢弃的事件
+	if err := b.transport.Publish(ev); err != nil && err != errBusFull {
+		utils.Warnf("[ Bus.Publish ] publish %v failed: %v, block: %v", topic, err, blockNo)
+	}
+}
+
+func (b *Bus) Subscribe(topic string, handler Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.handlers[topic] = append(b.handlers[topic], handler)
+}
+
+func (b *Bus) Close() {
+	b.cancel()
+	b.transport.Close()
+}
+
+func (b *Bus) receive(ctx context.Context) {
+	for {
+		err := b.transport.Receive(ctx, b.dispatch)
+		if ctx.Err() != nil {
+			return
+		}
+
+		utils.Warnf("[ Bus.receive ] chain: %v, receive failed: %v, retry later", b.chain, err)
+		time.Sleep(config.EventBusRetryInterval)
+	}
+}
+
+func (b *Bus) dispatch(ev *Event) {
+	if ev.Chain != b.chain {
+		return
+	}
+
+	b.mu.RLock()
+	handlers := b.handlers[ev.Topic]
+	b.mu.RUnlock()
+
+	for _, handler := range handlers {
+		b.call(handler, ev)
+	}
+}
+
+// 单个订阅者 panic 不影响其他订阅者
+func (b *Bus) call(handler Handler, ev *Event) {
+	defer func() {
+		if r := recover(); r != nil {
+			utils.Errorf("[ Bus.dispatch ] handler panic: %v, topic: %v, block: %v", r, ev.Topic, ev.BlockNo)
+		}
+	}()
+
+	handler(ev)
+}
@@ -0,0 +1,74 @@
+package eventbus
+
+import (
+	"time"
+
+	"sfilter/schema"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// sfilter 处理完一个区块后发布的事件
+const (
+	TopicBlockProcessed   = "BlockProcessed"
+	TopicSwapsPersisted   = "SwapsPersisted"
+	TopicPairCreated      = "PairCreated"
+	TopicLiquidityChanged = "LiquidityChanged"
+	TopicBlockReorged     = "BlockReorged"
+)
+
+// wiser 策略发出的买卖信号
+const (
+	TopicTradeSignal = "TradeSignal"
+
+	SIGNAL_SIDE_BUY  = "buy"
+	SIGNAL_SIDE_SELL = "sell"
+)
+
+// payload 使用 bson 编码, 与存入 mongo 的格式一致, 进程内和跨进程的消费方式相同
+type Event struct {
+	Id        primitive.ObjectID `bson:"_id,omitempty"`
+	Topic     string             `bson:"topic"`
+	Chain     string             `bson:"chain"`
+	BlockNo   int64              `bson:"blockNo"`
+	Payload   bson.Raw           `bson:"payload"`
+	CreatedAt time.Time          `bson:"createdAt"`
+}
+
+func (e *Event) Decode(v interface{}) error {
+	return bson.Unmarshal(e.Payload, v)
+}
+
+// 各 topic 对应的 payload
+type BlockProcessed struct {
+	Block *schema.BlockProceeded `bson:"block"`
+
+	// 该高度及之前的区块都已写入, 为 0 时未知(如回填、调试的区块)
+	// 重组重新处理时会回退
+	IndexedThrough int64 `bson:"indexedThrough"`
+}
+
+type SwapsPersisted struct {
+	Swaps []*schema.Swap `bson:"swaps"`
+}
+
+type PairCreated struct {
+	Pairs []*schema.Pair `bson:"pairs"`
+}
+
+type LiquidityChanged struct {
+	Events []*schema.LiquidityEvent `bson:"events"`
+}
+
+// 孤块的数据已从db删除, 之前发布的这些区块的事件作废
+// 事件的 BlockNo 为最低的孤块, 主链上的区块之后会重新发布
+type BlockReorged struct {
+	Blocks   []int64  `bson:"blocks"`   // 回滚的区块号, 从高到低
+	TxHashes []string `bson:"txHashes"` // 被删除的 swap 所在的交易
+}
+
+type TradeSignal struct {
+	Side  string          `bson:"side"`
+	Trade *schema.BiTrade `bson:"trade"`
+}
@@ -0,0 +1,180 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"sfilter/config"
+	"sfilter/utils"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// 事件的传输方式, 可替换为 nats、redis stream 等
+type Transport interface {
+	Publish(ev *Event) error
+
+	// 阻塞接收事件, 直到 ctx 结束或出错
+	Receive(ctx context.Context, handle func(*Event)) error
+
+	Close() error
+}
+
+var errBusFull = errors.New("event bus is full")
+
+// 进程内传输, 发布方与订阅方在同一个进程
+type LocalTransport struct {
+	ch chan *Event
+}
+
+func NewLocalTransport() *LocalTransport {
+	return &LocalTransport{
+		ch: make(chan *Event, config.EventBusBufferSize),
+	}
+}
+
+// 缓冲满时直接丢弃, 不阻塞发布方
+func (t *LocalTransport) Publish(ev *Event) error {
+	select {
+	case t.ch <- ev:
+		return nil
+	default:
+		utils.Errorf("[ LocalTransport.Publish ] buffer is full, drop event: %v, chain: %v, block: %v", ev.Topic, ev.Chain, ev.BlockNo)
+		return errBusFull
+	}
+}
+
+func (t *LocalTransport) Receive(ctx context.Context, handle func(*Event)) error {
+	for {
+		select {
+		case ev := <-t.ch:
+			handle(ev)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (t *LocalTransport) Close() error {
+	return nil
+}
+
+// 基于 capped collection 和 tailable cursor 的跨进程传输
+// _id 由各发布进程生成, 大小与写入顺序不一定一致, 因此按 capped collection 的写入顺序($natural)读取
+type MongoTransport struct {
+	collection *mongo.Collection
+
+	started bool
+	lastId  primitive.ObjectID // 最后处理的事件, 重新打开 cursor 时从它之后继续
+}
+
+func NewMongoTransport(db *mongo.Database) (*MongoTransport, error) {
+	opts := options.CreateCollection().SetCapped(true).SetSizeInBytes(config.EventTableSize)
+
+	err := db.CreateCollection(context.Background(), config.EventTableName, opts)
+	if err != nil {
+		// 48: NamespaceExists
+		var cmdErr mongo.CommandError
+		if !errors.As(err, &cmdErr) || cmdErr.Code != 48 {
+			return nil, err
+		}
+	}
+
+	return &MongoTransport{
+		collection: db.Collection(config.EventTableName),
+	}, nil
+}
+
+func (t *MongoTransport) Publish(ev *Event) error {
+	ctx, cancel := context.WithTimeout(context.Background(), config.MONGO_FIND_TIMEOUT*time.Second)
+	defer cancel()
+
+	_, err := t.collection.InsertOne(ctx, ev)
+	return err
+}
+
+// 只接收第一次订阅之后发布的事件
+// 表为空或者 cursor 失效时会直接返回, 因此需要循环重新打开
+func (t *MongoTransport) Receive(ctx context.Context, handle func(*Event)) error {
+	if !t.started {
+		lastId, err := t.latestId(ctx)
+		if err != nil {
+			return err
+		}
+
+		t.lastId = lastId
+		t.started = true
+	}
+
+	for {
+		// 重新打开时从最后处理的事件之后继续
+		// 最后处理的事件已被覆盖时, 中间的事件可能已丢失
+		filter := bson.M{}
+		if !t.lastId.IsZero() {
+			filter = bson.M{"_id": bson.M{"$gt": t.lastId}}
+
+			n, err := t.collection.CountDocuments(ctx, bson.M{"_id": t.lastId})
+			if err != nil {
+				return err
+			}
+			if n == 0 {
+				utils.Warnf("[ MongoTransport.Receive ] last event %v has been overwritten, some events may be lost", t.lastId.Hex())
+			}
+		}
+
+		opts := options.Find().SetCursorType(options.TailableAwait).SetSort(bson.D{{Key: "$natural", Value: 1}})
+
+		cursor, err := t.collection.Find(ctx, filter, opts)
+		if err != nil {
+			return err
+		}
+
+		for cursor.Next(ctx) {
+			var ev Event
+			if err := cursor.Decode(&ev); err != nil {
+				utils.Warnf("[ MongoTransport.Receive ] decode event failed: %v", err)
+				continue
+			}
+
+			t.lastId = ev.Id
+			handle(&ev)
+		}
+
+		err = cursor.Err()
+		cursor.Close(context.Background())
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+// 按写入顺序的最后一个事件, 表为空时返回零值
+func (t *MongoTransport) latestId(ctx context.Context) (primitive.ObjectID, error) {
+	opts := options.FindOne().SetSort(bson.D{{Key: "$natural", Value: -1}})
+
+	var ev Event
+	err := t.collection.FindOne(ctx, bson.D{}, opts).Decode(&ev)
+	if err == mongo.ErrNoDocuments {
+		return primitive.NilObjectID, nil
+	}
+
+	return ev.Id, err
+}
+
+func (t *MongoTransport) Close() error {
+	return nil
+}
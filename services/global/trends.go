@@ -70,3 +70,22 @@ func UpsertTrends(trends *schema.GlobalTrend, mongodb *mongo.Client) {
 		utils.Warnf("[ UpsertTrends ] failed. key: %v, err: %v\n", trends.TimelineKey, err)
 	}
 }
+
+// 重组时减去孤块计入的数据
+func RollbackTrend(key string, txNums, pairCreatedNum int, volumeByUsd float64, mongodb *mongo.Client) {
+	collection := mongodb.Database(config.DatabaseName).Collection(config.GlobalTrendTableName)
+	filter := bson.D{{Key: "timelineKey", Value: key}}
+
+	update := bson.D{
+		{Key: "$inc", Value: bson.M{
+			"txNums":         -txNums,
+			"pairCreatedNum": -pairCreatedNum,
+			"volumeByUsd":    -volumeByUsd,
+		}},
+	}
+
+	_, err := collection.UpdateOne(context.Background(), filter, update)
+	if err != nil {
+		utils.Warnf("[ RollbackTrend ] failed. key: %v, err: %v\n", key, err)
+	}
+}
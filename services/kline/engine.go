@@ -0,0 +1,168 @@
+package kline
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"sfilter/config"
+	"sfilter/schema"
+	"sfilter/services/bulk"
+	"sfilter/utils"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// 内存中的k线聚合
+// 每个 pair 正在更新的行(1min 的小时行、1hour 的天行、1day 的月行)缓存在内存中,
+// swap 只修改内存, 区块处理完成时把有修改的行一次性加入 batch, 不再每笔 swap 都读写一次db
+// 行只在第一次用到时从db读取一次, 结束或长时间没有交易的行移出内存
+type Engine struct {
+	mu sync.Mutex
+
+	rows  map[string]*candleRow // key: 表名 + 行key
+	dirty map[string]bool
+
+	latest    time.Time // 已计入的最新交易时间, 早于它所在行的行视为已结束
+	lastEvict time.Time
+}
+
+type candleRow struct {
+	base *klineBase
+	key  string
+	row  klineRow
+
+	touched time.Time
+}
+
+func NewEngine() *Engine {
+	return &Engine{
+		rows:      make(map[string]*candleRow),
+		dirty:     make(map[string]bool),
+		lastEvict: time.Now(),
+	}
+}
+
+// 把 swap 计入所有基础周期, 只修改内存, 需要调用 Flush 写入
+// swap 需要按交易顺序调用, 否则开盘、收盘价不正确
+func (e *Engine) Update(swap *schema.Swap, mongodb *mongo.Client) {
+	if swap.Price == 0 {
+		utils.Warnf("[ Engine.Update ] wrong price. tx: %v", swap.LogIndexWithTx)
+		return
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	t := swap.SwapTime.Local() // 以交易(区块)时间为准, 而不是当前时间
+
+	for _, base := range bases {
+		if time.Since(t) > base.saveTime {
+			continue
+		}
+
+		cr, err := e.getRow(base, swap.PairAddr, t, mongodb)
+		if err != nil {
+			utils.Warnf("[ Engine.Update ] load %v kline failed: %v, tx: %v", base.name, err, swap.LogIndexWithTx)
+			continue
+		}
+
+		if cr.row.Pair == "" {
+			// 说明没有这条k线, 需要新建; 填充基础信息
+			cr.row.Pair = swap.PairAddr
+			cr.row.BaseToken = swap.MainToken
+			cr.row.QuoteToken = swap.Token1
+			if swap.MainToken == swap.Token1 {
+				cr.row.QuoteToken = swap.Token0
+			}
+
+			cr.row.Timestamp = t
+		} else if !base.rowStart(cr.row.Timestamp.Local()).Equal(base.rowStart(t)) {
+			// key 相同但不是同一段时间, 是之前周期遗留的老行, 清空柱子
+			cr.row.Timestamp = t
+			cr.row.Kline = make([]schema.KLine, base.slots)
+		}
+
+		updateKLineWithNewData(&cr.row.Kline[base.slot(t)], swap)
+
+		cr.row.UpdatedAt = time.Now()
+		cr.touched = time.Now()
+
+		e.dirty[base.table+cr.key] = true
+	}
+
+	if t.After(e.latest) {
+		e.latest = t
+	}
+}
+
+// 有修改的行加入 batch, 与区块的其他记录一起写入
+// 写入失败时需要调用 Reset, 否则内存与db不一致
+func (e *Engine) Flush(b *bulk.Batch) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for id := range e.dirty {
+		cr := e.rows[id]
+		filter := bson.M{cr.base.keyField: cr.key}
+		b.Put(cr.base.table, cr.key, filter, cr.row.document(cr.base, cr.key))
+	}
+	e.dirty = make(map[string]bool)
+
+	if time.Since(e.lastEvict) > config.KlineEngineEvictInterval {
+		e.evict()
+		e.lastEvict = time.Now()
+	}
+}
+
+// 清空内存, 之后用到时重新从db读取
+func (e *Engine) Reset() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.rows = make(map[string]*candleRow)
+	e.dirty = make(map[string]bool)
+}
+
+// 移出已结束或长时间没有交易的行, 有未写入修改的行保留
+func (e *Engine) evict() {
+	for id, cr := range e.rows {
+		if e.dirty[id] {
+			continue
+		}
+
+		closed := cr.base.rowStart(cr.row.Timestamp.Local()).Before(cr.base.rowStart(e.latest))
+		if closed || time.Since(cr.touched) > config.KlineEngineIdleTime {
+			delete(e.rows, id)
+		}
+	}
+}
+
+func (e *Engine) getRow(base *klineBase, pair string, t time.Time, mongodb *mongo.Client) (*candleRow, error) {
+	key := base.key(pair, t)
+	id := base.table + key
+
+	if cr, ok := e.rows[id]; ok {
+		return cr, nil
+	}
+
+	cr := &candleRow{base: base, key: key}
+
+	collection := mongodb.Database(config.DatabaseName).Collection(base.table)
+	err := collection.FindOne(context.Background(), bson.M{base.keyField: key}).Decode(&cr.row)
+	if err != nil && err != mongo.ErrNoDocuments {
+		return nil, err
+	}
+
+	// 行中的柱子数量固定
+	if len(cr.row.Kline) != base.slots {
+		klines := make([]schema.KLine, base.slots)
+		copy(klines, cr.row.Kline)
+		cr.row.Kline = klines
+	}
+
+	e.rows[id] = cr
+
+	return cr, nil
+}
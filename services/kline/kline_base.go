@@ -0,0 +1,130 @@
+package kline
+
+import (
+	"fmt"
+	"time"
+
+	"sfilter/config"
+	"sfilter/schema"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// 支持的k线周期, 其中 1m/1h/1d 为存储的基础周期, 其他周期读取时由基础周期合成
+const (
+	TIMEFRAME_1MIN  = "1m"
+	TIMEFRAME_5MIN  = "5m"
+	TIMEFRAME_15MIN = "15m"
+	TIMEFRAME_1HOUR = "1h"
+	TIMEFRAME_4HOUR = "4h"
+	TIMEFRAME_1DAY  = "1d"
+)
+
+// 一种基础周期的存储方式, 一行记录保存一段时间内的多根柱子
+// 1min: 每小时一行 60 根; 1hour: 每天一行 24 根; 1day: 每月一行 31 根
+type klineBase struct {
+	name     string
+	table    string
+	keyField string // 唯一索引字段, 如 pairDayHour
+	slots    int
+	saveTime time.Duration // 超过保存时间的交易不再更新该周期
+
+	key      func(pair string, t time.Time) string
+	rowStart func(t time.Time) time.Time // 所在行的开始时间
+	slot     func(t time.Time) int       // 柱子在行中的下标
+	slotTime func(rowStart time.Time, i int) time.Time
+}
+
+var base1Min = &klineBase{
+	name:     TIMEFRAME_1MIN,
+	table:    config.Kline1MinTableName,
+	keyField: "pairDayHour",
+	slots:    60,
+	saveTime: time.Duration(config.Kline1MinTableSaveTime) * time.Second,
+
+	key: func(pair string, t time.Time) string {
+		return fmt.Sprintf("%v_%v_%v", pair, t.Day(), t.Hour())
+	},
+	rowStart: func(t time.Time) time.Time {
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, t.Location())
+	},
+	slot: func(t time.Time) int { return t.Minute() },
+	slotTime: func(rowStart time.Time, i int) time.Time {
+		return rowStart.Add(time.Duration(i) * time.Minute)
+	},
+}
+
+var base1Hour = &klineBase{
+	name:     TIMEFRAME_1HOUR,
+	table:    config.Kline1HourTableName,
+	keyField: "pairMonthDay",
+	slots:    24,
+	saveTime: time.Duration(config.Kline1HourTableSaveTime) * time.Second,
+
+	key: func(pair string, t time.Time) string {
+		return fmt.Sprintf("%v_%v_%v", pair, t.Month(), t.Day())
+	},
+	rowStart: func(t time.Time) time.Time {
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	},
+	slot: func(t time.Time) int { return t.Hour() },
+	slotTime: func(rowStart time.Time, i int) time.Time {
+		return time.Date(rowStart.Year(), rowStart.Month(), rowStart.Day(), i, 0, 0, 0, rowStart.Location())
+	},
+}
+
+var base1Day = &klineBase{
+	name:     TIMEFRAME_1DAY,
+	table:    config.Kline1DayTableName,
+	keyField: "pairYearMonth",
+	slots:    31,
+	saveTime: time.Duration(config.Kline1DayTableSaveTime) * time.Second,
+
+	key: func(pair string, t time.Time) string {
+		return fmt.Sprintf("%v_%v_%v", pair, t.Year(), int(t.Month()))
+	},
+	rowStart: func(t time.Time) time.Time {
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+	},
+	slot: func(t time.Time) int { return t.Day() - 1 },
+	slotTime: func(rowStart time.Time, i int) time.Time {
+		return rowStart.AddDate(0, 0, i)
+	},
+}
+
+var bases = []*klineBase{base1Min, base1Hour, base1Day}
+
+func getBase(name string) (*klineBase, bool) {
+	for _, base := range bases {
+		if base.name == name {
+			return base, true
+		}
+	}
+
+	return nil, false
+}
+
+// 三种基础周期的表结构相同, 只有唯一索引字段名和柱子数量不同, 读写时统一使用该结构
+type klineRow struct {
+	schema.KLinePairInfo `bson:",inline"`
+
+	Kline []schema.KLine `bson:"klines"`
+
+	schema.KLineCreatTime `bson:",inline"`
+}
+
+// 写入时带上唯一索引字段, 柱子数组拷贝一份, 避免写入前被修改
+func (r *klineRow) document(base *klineBase, key string) bson.D {
+	klines := make([]schema.KLine, len(r.Kline))
+	copy(klines, r.Kline)
+
+	return bson.D{
+		{Key: "pair", Value: r.Pair},
+		{Key: "baseToken", Value: r.BaseToken},
+		{Key: "quoteToken", Value: r.QuoteToken},
+		{Key: base.keyField, Value: key},
+		{Key: "klines", Value: klines},
+		{Key: "updatedAt", Value: r.UpdatedAt},
+		{Key: "timestamp", Value: r.Timestamp},
+	}
+}
@@ -34,6 +34,12 @@ func Get1HourKlineWithFullGenerated(pair string, end time.Time, days int, mongod
 					kline.ClosePrice = last.ClosePrice
 					kline.HighPrice = last.ClosePrice
 					kline.LowPrice = last.ClosePrice
+
+					kline.OpenPriceDecimal = last.ClosePriceDecimal
+					kline.ClosePriceDecimal = last.ClosePriceDecimal
+					kline.HighPriceDecimal = last.ClosePriceDecimal
+					kline.LowPriceDecimal = last.ClosePriceDecimal
+
 					kline.PriceInUsd = last.PriceInUsd
 
 					// 加60min...
@@ -65,6 +71,11 @@ finish:
 					HighPrice:  first.OpenPrice,
 					LowPrice:   first.OpenPrice,
 
+					OpenPriceDecimal:  first.OpenPriceDecimal,
+					ClosePriceDecimal: first.OpenPriceDecimal,
+					HighPriceDecimal:  first.OpenPriceDecimal,
+					LowPriceDecimal:   first.OpenPriceDecimal,
+
 					// 其他均为0
 				}
 				new.PriceInUsd = first.PriceInUsd // 价格必须赋值
@@ -40,6 +40,12 @@ func Get1MinKlineWithFullGenerated(pair string, end time.Time, hours int, mongod
 					kline.ClosePrice = last.ClosePrice
 					kline.HighPrice = last.ClosePrice
 					kline.LowPrice = last.ClosePrice
+
+					kline.OpenPriceDecimal = last.ClosePriceDecimal
+					kline.ClosePriceDecimal = last.ClosePriceDecimal
+					kline.HighPriceDecimal = last.ClosePriceDecimal
+					kline.LowPriceDecimal = last.ClosePriceDecimal
+
 					kline.PriceInUsd = last.PriceInUsd
 
 					// 加1分钟
@@ -70,6 +76,11 @@ finish:
 					ClosePrice: first.OpenPrice,
 					HighPrice:  first.OpenPrice,
 					LowPrice:   first.OpenPrice,
+
+					OpenPriceDecimal:  first.OpenPriceDecimal,
+					ClosePriceDecimal: first.OpenPriceDecimal,
+					HighPriceDecimal:  first.OpenPriceDecimal,
+					LowPriceDecimal:   first.OpenPriceDecimal,
 				}
 				new.PriceInUsd = first.PriceInUsd
 
@@ -0,0 +1,66 @@
+package kline
+
+import (
+	"context"
+	"time"
+
+	"sfilter/config"
+	"sfilter/schema"
+	"sfilter/utils"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// 区块回滚时使用: 清空某个基础周期的一根柱子, 并用该周期内剩余(有效)的swap重新计算
+// slotTime 为该柱子所在的时间, swaps 需要按交易顺序排列
+// 内存中的行同时更新, 结果直接写入db; 与区块写入k线互斥由调用方保证
+func (e *Engine) RebuildSlot(timeframe, pair string, slotTime time.Time, swaps []schema.Swap, mongodb *mongo.Client) {
+	base, ok := getBase(timeframe)
+	if !ok {
+		utils.Warnf("[ Engine.RebuildSlot ] not a stored timeframe: %v", timeframe)
+		return
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	slotTime = slotTime.Local()
+
+	cr, err := e.getRow(base, pair, slotTime, mongodb)
+	if err != nil {
+		utils.Warnf("[ Engine.RebuildSlot ] load %v kline failed: %v, pair: %v", timeframe, err, pair)
+		return
+	}
+
+	// 没有这行, 或者行已经是别的时间段的了, 无需处理
+	if cr.row.Pair == "" || !base.rowStart(cr.row.Timestamp.Local()).Equal(base.rowStart(slotTime)) {
+		return
+	}
+
+	candisk := &cr.row.Kline[base.slot(slotTime)]
+	*candisk = schema.KLine{}
+
+	for i := range swaps {
+		if swaps[i].Price == 0 {
+			continue
+		}
+		updateKLineWithNewData(candisk, &swaps[i])
+	}
+
+	cr.row.UpdatedAt = time.Now()
+
+	collection := mongodb.Database(config.DatabaseName).Collection(base.table)
+
+	filter := bson.M{base.keyField: cr.key}
+	update := bson.D{{Key: "$set", Value: cr.row.document(base, cr.key)}}
+
+	_, err = collection.UpdateOne(context.Background(), filter, update, options.Update().SetUpsert(true))
+	if err != nil {
+		utils.Warnf("[ Engine.RebuildSlot ] UpdateOne error: %v, key: %v", err, cr.key)
+
+		// 写入失败, 内存中的行作废, 下次从db重新读取
+		delete(e.rows, base.table+cr.key)
+	}
+}
@@ -0,0 +1,308 @@
+package kline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"sfilter/schema"
+	"sfilter/utils"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var ErrUnknownTimeframe = errors.New("unknown timeframe")
+
+// 每个周期由哪个基础周期合成
+type timeframe struct {
+	interval time.Duration
+	base     *klineBase
+}
+
+var timeframes = map[string]timeframe{
+	TIMEFRAME_1MIN:  {time.Minute, base1Min},
+	TIMEFRAME_5MIN:  {5 * time.Minute, base1Min},
+	TIMEFRAME_15MIN: {15 * time.Minute, base1Min},
+	TIMEFRAME_1HOUR: {time.Hour, base1Hour},
+	TIMEFRAME_4HOUR: {4 * time.Hour, base1Hour},
+	TIMEFRAME_1DAY:  {24 * time.Hour, base1Day},
+}
+
+// 图表常用的周期写法, 如 TradingView 的 1、60、1D
+var resolutions = map[string]string{
+	"1":   TIMEFRAME_1MIN,
+	"5":   TIMEFRAME_5MIN,
+	"15":  TIMEFRAME_15MIN,
+	"60":  TIMEFRAME_1HOUR,
+	"240": TIMEFRAME_4HOUR,
+	"D":   TIMEFRAME_1DAY,
+	"1D":  TIMEFRAME_1DAY,
+}
+
+// 支持 1m/5m 等周期名, 也支持图表的周期写法
+func ParseResolution(resolution string) (string, bool) {
+	if _, ok := timeframes[resolution]; ok {
+		return resolution, true
+	}
+
+	name, ok := resolutions[resolution]
+	return name, ok
+}
+
+// 图表支持的周期写法
+func SupportedResolutions() []string {
+	return []string{"1", "5", "15", "60", "240", "1D"}
+}
+
+// 周期长度, 未知周期返回 0
+func TimeframeInterval(name string) time.Duration {
+	return timeframes[name].interval
+}
+
+// 取出 [start, end) 内某个周期的柱子, 按时间正序, 只包含有交易的柱子
+// 非基础周期由基础周期合成; 返回柱子的 UnixTime 为周期开始时间
+func GetKlines(pair, name string, start, end time.Time, mongodb *mongo.Database) ([]schema.KLine, error) {
+	tf, ok := timeframes[name]
+	if !ok {
+		return nil, ErrUnknownTimeframe
+	}
+
+	start, end = start.Local(), end.Local()
+
+	rows, err := getKlineRows(tf.base, pair, start, end, mongodb)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []schema.KLine
+	for _, row := range rows {
+		rowStart := tf.base.rowStart(row.Timestamp.Local())
+
+		for i, k := range row.Kline {
+			if k.UnixTime == 0 {
+				continue
+			}
+
+			slotTime := tf.base.slotTime(rowStart, i)
+			if slotTime.Before(start) || !slotTime.Before(end) {
+				continue
+			}
+
+			bucket := truncateLocal(slotTime, tf.interval).Unix()
+			if len(result) > 0 && result[len(result)-1].UnixTime == bucket {
+				mergeKLine(&result[len(result)-1], &k)
+				continue
+			}
+
+			fillStoredKLineDecimal(&k)
+			k.UnixTime = bucket
+			result = append(result, k)
+		}
+	}
+
+	return result, nil
+}
+
+// 周期长度对应的周期名, 如 5m
+func TimeframeByInterval(interval time.Duration) (string, bool) {
+	for name, tf := range timeframes {
+		if tf.interval == interval {
+			return name, true
+		}
+	}
+
+	return "", false
+}
+
+// 取 now 之前最近 n 根已收盘的柱子, 按时间正序
+// 没有交易的周期用上一根的收盘价补齐, 交易量为0; 最早的柱子之前没有交易时不补, 返回数量少于 n
+func GetRecentKlines(pair, name string, n int, now time.Time, mongodb *mongo.Database) ([]schema.KLine, error) {
+	tf, ok := timeframes[name]
+	if !ok {
+		return nil, ErrUnknownTimeframe
+	}
+
+	end := truncateLocal(now.Local(), tf.interval) // 当前未收盘柱子的开始时间
+	start := end.Add(-time.Duration(n) * tf.interval)
+
+	bars, err := GetKlines(pair, name, start, end, mongodb)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []schema.KLine
+	var last *schema.KLine
+
+	i := 0
+	for t := start; t.Before(end); t = t.Add(tf.interval) {
+		if i < len(bars) && bars[i].UnixTime == t.Unix() {
+			last = &bars[i]
+			result = append(result, bars[i])
+			i++
+			continue
+		}
+
+		if last == nil {
+			continue
+		}
+
+		result = append(result, schema.KLine{
+			OpenPrice:         last.ClosePrice,
+			ClosePrice:        last.ClosePrice,
+			HighPrice:         last.ClosePrice,
+			LowPrice:          last.ClosePrice,
+			OpenPriceDecimal:  last.ClosePriceDecimal,
+			ClosePriceDecimal: last.ClosePriceDecimal,
+			HighPriceDecimal:  last.ClosePriceDecimal,
+			LowPriceDecimal:   last.ClosePriceDecimal,
+			UnixTime:          t.Unix(),
+			DeepEyeInfo:       schema.DeepEyeInfo{PriceInUsd: last.PriceInUsd},
+		})
+	}
+
+	return result, nil
+}
+
+// 按本地时间的当天 0 点对齐, interval 不超过1天
+// 与 Get1MinKlineWithFullGenerated 一样, 从第一根有交易的柱子开始, 没有交易的周期用前一根的收盘价补齐
+// klines 为 GetKlines 的结果, 补到 end 之前(不含)且已经开始的最后一个周期
+func FillKlines(klines []schema.KLine, name string, end time.Time) []schema.KLine {
+	tf, ok := timeframes[name]
+	if !ok || len(klines) == 0 {
+		return klines
+	}
+
+	end = end.Local()
+	if now := time.Now(); end.After(now) {
+		end = now
+	}
+
+	result := make([]schema.KLine, 0, len(klines))
+	next := 0
+
+	// 加上 1.5 个周期再对齐, 日k遇到夏令时等不是 24h 的日期时也能走到下一天
+	for slot := time.Unix(klines[0].UnixTime, 0).Local(); slot.Before(end); slot = truncateLocal(slot.Add(tf.interval*3/2), tf.interval) {
+		// 对齐方式与 GetKlines 一致, 一般时间相等; 早于当前周期的也按顺序加入
+		if next < len(klines) && klines[next].UnixTime <= slot.Unix() {
+			for next < len(klines) && klines[next].UnixTime <= slot.Unix() {
+				result = append(result, klines[next])
+				next++
+			}
+			continue
+		}
+
+		last := result[len(result)-1]
+		kline := schema.KLine{
+			OpenPrice:  last.ClosePrice,
+			ClosePrice: last.ClosePrice,
+			HighPrice:  last.ClosePrice,
+			LowPrice:   last.ClosePrice,
+
+			OpenPriceDecimal:  last.ClosePriceDecimal,
+			ClosePriceDecimal: last.ClosePriceDecimal,
+			HighPriceDecimal:  last.ClosePriceDecimal,
+			LowPriceDecimal:   last.ClosePriceDecimal,
+
+			UnixTime: slot.Unix(),
+		}
+		kline.PriceInUsd = last.PriceInUsd
+
+		result = append(result, kline)
+	}
+
+	return append(result, klines[next:]...)
+}
+
+func truncateLocal(t time.Time, interval time.Duration) time.Time {
+	dayStart := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	return dayStart.Add(t.Sub(dayStart) / interval * interval)
+}
+
+// 4h、1D 等柱子按服务器本地时间对齐(日k在写入时就按本地日期保存), 返回本地时区的 IANA 名称供图表使用
+func Timezone() string {
+	if name := time.Local.String(); name != "Local" {
+		return name // TZ 环境变量指定
+	}
+
+	// /etc/localtime 一般链接到 zoneinfo 下的文件, 如 /usr/share/zoneinfo/Asia/Shanghai
+	if link, err := os.Readlink("/etc/localtime"); err == nil {
+		if i := strings.Index(link, "zoneinfo/"); i >= 0 {
+			return link[i+len("zoneinfo/"):]
+		}
+	}
+
+	// 无法识别时按 UTC 偏移表示, 注意 Etc/GMT-8 为 UTC+8; 非整点的偏移没有对应的名称, 只能返回 UTC
+	_, offset := time.Now().Zone()
+	if offset == 0 || offset%3600 != 0 {
+		return "Etc/UTC"
+	}
+
+	return fmt.Sprintf("Etc/GMT%+d", -offset/3600)
+}
+
+// 把后一根柱子合并到前一根
+func mergeKLine(kline, next *schema.KLine) {
+	fillStoredKLineDecimal(next)
+
+	if next.HighPriceDecimal.Cmp(kline.HighPriceDecimal) > 0 {
+		kline.HighPriceDecimal = next.HighPriceDecimal
+	}
+	if next.LowPriceDecimal.Cmp(kline.LowPriceDecimal) < 0 {
+		kline.LowPriceDecimal = next.LowPriceDecimal
+	}
+
+	kline.ClosePriceDecimal = next.ClosePriceDecimal
+	kline.PriceInUsd = next.PriceInUsd
+
+	kline.VolumeDecimal = kline.VolumeDecimal.Add(next.VolumeDecimal)
+
+	kline.ClosePrice = kline.ClosePriceDecimal.Float64()
+	kline.HighPrice = kline.HighPriceDecimal.Float64()
+	kline.LowPrice = kline.LowPriceDecimal.Float64()
+	kline.Volume = kline.VolumeDecimal.Float64()
+
+	kline.TxNum += next.TxNum
+	kline.VolumeInUsd += next.VolumeInUsd
+}
+
+// 老柱子只有 float 值, 用其填充所有精确值
+func fillStoredKLineDecimal(kline *schema.KLine) {
+	fillKLineDecimal(kline)
+
+	if kline.ClosePriceDecimal == "" {
+		kline.ClosePriceDecimal = utils.NewDecimalFromFloat(kline.ClosePrice)
+	}
+}
+
+// 取出覆盖 [start, end) 的所有行, 按时间正序
+func getKlineRows(base *klineBase, pair string, start, end time.Time, mongodb *mongo.Database) ([]klineRow, error) {
+	collection := mongodb.Collection(base.table)
+
+	// timestamp 为该行第一笔交易的时间, 不早于行的开始时间
+	filter := bson.M{
+		"pair": pair,
+		"timestamp": bson.M{
+			"$gte": base.rowStart(start), "$lt": end,
+		},
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "timestamp", Value: 1}})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	cursor, err := collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var rows []klineRow
+	err = cursor.All(ctx, &rows)
+
+	return rows, err
+}
@@ -0,0 +1,72 @@
+package kline
+
+import (
+	"sfilter/schema"
+	"sfilter/utils"
+)
+
+// 把一笔 swap 计入柱子, 各周期通用
+func updateKLineWithNewData(kline *schema.KLine, swap *schema.Swap) {
+	curPrice := swap.PriceDecimal
+	volume := swap.AmountOfMainDecimal
+	if curPrice.IsZero() { // 老数据没有精确值
+		curPrice = utils.NewDecimalFromFloat(swap.Price)
+		volume = utils.NewDecimalFromFloat(swap.AmountOfMainToken)
+	}
+
+	kline.ClosePriceDecimal = curPrice // 不管新老柱子, 先更新close
+	kline.PriceInUsd = swap.PriceInUsd
+
+	if kline.UnixTime == 0 {
+		// 新柱子
+		kline.OpenPriceDecimal = curPrice
+
+		kline.HighPriceDecimal = curPrice
+		kline.LowPriceDecimal = curPrice
+	} else {
+		// 不是新柱子
+		fillKLineDecimal(kline)
+
+		if curPrice.Cmp(kline.HighPriceDecimal) > 0 {
+			kline.HighPriceDecimal = curPrice
+		}
+
+		if curPrice.Cmp(kline.LowPriceDecimal) < 0 {
+			kline.LowPriceDecimal = curPrice
+		}
+	}
+
+	kline.UnixTime = swap.SwapTime.Unix()
+
+	// volume啥都不用考虑, 直接加
+	kline.VolumeDecimal = kline.VolumeDecimal.Add(volume)
+
+	// float 字段由精确值转换而来
+	kline.OpenPrice = kline.OpenPriceDecimal.Float64()
+	kline.ClosePrice = kline.ClosePriceDecimal.Float64()
+	kline.HighPrice = kline.HighPriceDecimal.Float64()
+	kline.LowPrice = kline.LowPriceDecimal.Float64()
+	kline.Volume = kline.VolumeDecimal.Float64()
+
+	// 更新 deepeye info
+	kline.TxNum++
+	kline.VolumeInUsd += swap.VolumeInUsd
+
+	// log.Printf("[ updateKLineWithNewData ] debug.. after update, kline: %v\n", kline)
+}
+
+// 老柱子只有 float 值, 先用其填充精确值(close 已被更新, 不需要)
+func fillKLineDecimal(kline *schema.KLine) {
+	if kline.OpenPriceDecimal == "" {
+		kline.OpenPriceDecimal = utils.NewDecimalFromFloat(kline.OpenPrice)
+	}
+	if kline.HighPriceDecimal == "" {
+		kline.HighPriceDecimal = utils.NewDecimalFromFloat(kline.HighPrice)
+	}
+	if kline.LowPriceDecimal == "" {
+		kline.LowPriceDecimal = utils.NewDecimalFromFloat(kline.LowPrice)
+	}
+	if kline.VolumeDecimal == "" {
+		kline.VolumeDecimal = utils.NewDecimalFromFloat(kline.Volume)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"sfilter/config"
 	"sfilter/schema"
+	"sfilter/services/bulk"
 	"sfilter/utils"
 	"time"
 
@@ -66,3 +67,22 @@ func SaveLiquidityEvent(event *schema.LiquidityEvent, mongodb *mongo.Client) {
 		}
 	}
 }
+
+// 加入区块的批量写入, 按 logIndexWithTx 覆盖写, 区块重试时不会重复
+func AddLiquidityEventToBatch(event *schema.LiquidityEvent, b *bulk.Batch) {
+	event.CreatedAt = time.Now()
+
+	filter := bson.M{"logIndexWithTx": event.LogIndexWithTx}
+	b.Upsert(config.LiquidityEventTableName, filter, event)
+}
+
+func DeleteLiquidityEventsByBlock(blockNo uint64, mongodb *mongo.Client) error {
+	collection := mongodb.Database(config.DatabaseName).Collection(config.LiquidityEventTableName)
+
+	_, err := collection.DeleteMany(context.Background(), bson.M{"eventBlockNo": blockNo})
+	if err != nil {
+		utils.Warnf("[ DeleteLiquidityEventsByBlock ] DeleteMany error: %v, blockNo: %v", err, blockNo)
+	}
+
+	return err
+}
@@ -0,0 +1,148 @@
+package nativeprice
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"sfilter/config"
+	"sfilter/schema"
+	"sfilter/services/bulk"
+	"sfilter/utils"
+
+	"github.com/ethereum/go-ethereum/common"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// 由参考池子的swap生成原生币价格序列
+// 记录每个参考池子最近一次成交的价格, 区块内有参考池子成交时, 取所有未过期池子价格的中位数写入
+// 需要按区块号顺序调用 Record
+type Series struct {
+	mu sync.Mutex
+
+	pools  map[string]bool
+	latest map[string]poolPrice // pool -> 最近一次成交
+}
+
+type poolPrice struct {
+	price   float64
+	blockNo int64
+}
+
+func NewSeries(pools []string) *Series {
+	s := &Series{
+		pools:  make(map[string]bool),
+		latest: make(map[string]poolPrice),
+	}
+
+	for _, pool := range pools {
+		s.pools[common.HexToAddress(pool).String()] = true
+	}
+
+	return s
+}
+
+// 本区块有参考池子成交时, 加入价格序列的写入
+func (s *Series) Record(blockNo int64, blockTime time.Time, swaps []*schema.Swap, b *bulk.Batch) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	traded := false
+	for _, swap := range swaps {
+		if !s.pools[swap.PairAddr] {
+			continue
+		}
+
+		price := nativePriceOfSwap(swap)
+		if price <= 0 {
+			continue
+		}
+
+		// swaps 按交易顺序排列, 后面的覆盖前面的即为区块内最新价格
+		if old, ok := s.latest[swap.PairAddr]; ok && old.blockNo > blockNo {
+			continue
+		}
+		s.latest[swap.PairAddr] = poolPrice{price: price, blockNo: blockNo}
+		traded = true
+	}
+
+	if !traded {
+		return
+	}
+
+	var prices []float64
+	for _, p := range s.latest {
+		if p.blockNo > blockNo-config.NativePriceMaxGap && p.blockNo <= blockNo {
+			prices = append(prices, p.price)
+		}
+	}
+
+	np := &schema.NativePrice{
+		BlockNo:   blockNo,
+		BlockTime: blockTime,
+		Price:     median(prices),
+		Pools:     len(prices),
+		CreatedAt: time.Now(),
+	}
+
+	b.Upsert(config.NativePriceTableName, bson.M{"blockNo": blockNo}, np)
+}
+
+// 参考池子一边为原生币, 一边为稳定币; swap 的价格为 main token 以 quote token 计价
+func nativePriceOfSwap(swap *schema.Swap) float64 {
+	price := swap.PriceDecimal.Float64()
+	if price <= 0 {
+		return 0
+	}
+
+	if utils.CheckExistString(swap.MainToken, config.QuoteEthCoinList) {
+		return price
+	}
+
+	return 1 / price
+}
+
+func median(prices []float64) float64 {
+	sort.Float64s(prices)
+
+	n := len(prices)
+	if n%2 == 1 {
+		return prices[n/2]
+	}
+
+	return (prices[n/2-1] + prices[n/2]) / 2
+}
+
+// 取该区块之前最近的价格, 超过 NativePriceMaxGap 个区块没有价格时返回 mongo.ErrNoDocuments
+func GetPrice(blockNo int64, mongodb *mongo.Client) (float64, error) {
+	collection := mongodb.Database(config.DatabaseName).Collection(config.NativePriceTableName)
+
+	filter := bson.M{
+		"blockNo": bson.M{
+			"$lt":  blockNo,
+			"$gte": blockNo - config.NativePriceMaxGap,
+		},
+	}
+	opts := options.FindOne().SetSort(bson.D{{Key: "blockNo", Value: -1}})
+
+	var result schema.NativePrice
+	err := collection.FindOne(context.Background(), filter, opts).Decode(&result)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.Price, nil
+}
+
+// 区块回滚时删除
+func DeleteByBlock(blockNo int64, mongodb *mongo.Client) {
+	collection := mongodb.Database(config.DatabaseName).Collection(config.NativePriceTableName)
+
+	_, err := collection.DeleteOne(context.Background(), bson.M{"blockNo": blockNo})
+	if err != nil {
+		utils.Warnf("[ DeleteByBlock ] DeleteOne error: %v, block: %v", err, blockNo)
+	}
+}
@@ -15,19 +15,14 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 )
 
-func GetPairInfoForRead(address string) (*schema.Pair, error) {
-	mongodb := chain.GetMongo()
-
-	return GetPairInfo(address, mongodb)
-}
-
-func GetPairInfo(address string, mongodb *mongo.Client) (*schema.Pair, error) {
+// 先查db, 不存在时去链上查并保存
+func GetPairInfo(address string, svc *chain.Service) (*schema.Pair, error) {
 	if address == "" {
 		log.Printf("[ GetPairInfo ] error! empty address. address!")
 		return nil, errors.New("GetPairInfo: empty address")
 	}
 
-	collection := mongodb.Database(config.DatabaseName).Collection(config.PairTableName)
+	collection := svc.DB().Collection(config.PairTableName)
 
 	filter := bson.M{"address": address}
 
@@ -36,9 +31,9 @@ func GetPairInfo(address string, mongodb *mongo.Client) (*schema.Pair, error) {
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
 			// 不存在，去链上查并返回
-			_pair := getPairOnChainInfoFromChain(address, mongodb)
+			_pair := getPairOnChainInfoFromChain(address, svc)
 			if _pair != nil {
-				UpSertOnChainInfo(_pair.Address, &_pair.InfoOnChain, mongodb)
+				UpSertOnChainInfo(_pair.Address, &_pair.InfoOnChain, svc.DB().Client())
 				return _pair, nil
 			}
 
@@ -66,12 +61,12 @@ func GetPairInfoForApi(address string, db *mongo.Database) (*schema.Pair, error)
 }
 
 // 当db中不存在时，update一下信息
-func getPairOnChainInfoFromChain(address string, mongodb *mongo.Client) *schema.Pair {
+func getPairOnChainInfoFromChain(address string, svc *chain.Service) *schema.Pair {
 	var pair schema.Pair
 
 	pair.Address = address
-	token0Addr, err0 := chain.GetSingleProp(address, "token0")
-	token1Addr, err1 := chain.GetSingleProp(address, "token1")
+	token0Addr, err0 := svc.GetSingleProp(address, "token0")
+	token1Addr, err1 := svc.GetSingleProp(address, "token1")
 
 	if err0 != nil || err1 != nil {
 		return nil
@@ -79,8 +74,8 @@ func getPairOnChainInfoFromChain(address string, mongodb *mongo.Client) *schema.
 	pair.Token0 = token0Addr.(common.Address).String()
 	pair.Token1 = token1Addr.(common.Address).String()
 
-	token0, err0 := chain.GetTokenInfo(pair.Token0, mongodb)
-	token1, err1 := chain.GetTokenInfo(pair.Token1, mongodb)
+	token0, err0 := svc.GetTokenInfo(pair.Token0)
+	token1, err1 := svc.GetTokenInfo(pair.Token1)
 	if err0 != nil || err1 != nil {
 		log.Printf("[ getPairInfoOnChain ] getTokenInfo error. token0: %v, err0: %v, token1: %v, err1: %v\n", pair.Token0, err0, pair.Token1, err1)
 		return nil
@@ -89,12 +84,12 @@ func getPairOnChainInfoFromChain(address string, mongodb *mongo.Client) *schema.
 	pair.Decimal0 = token0.Decimal
 	pair.Decimal1 = token1.Decimal
 
-	GeneratePairName(&pair, token0, token1)
+	GeneratePairName(&pair, token0, token1, svc)
 
 	return &pair
 }
 
-func GeneratePairName(pair *schema.Pair, token0, token1 *schema.Token) {
+func GeneratePairName(pair *schema.Pair, token0, token1 *schema.Token, svc *chain.Service) {
 	// 组装pairName, 如果一方是价值token, 则作为quoteToken
 	pair.PairName = fmt.Sprintf("%s/%s", token0.Symbol, token1.Symbol)
 
@@ -105,7 +100,7 @@ func GeneratePairName(pair *schema.Pair, token0, token1 *schema.Token) {
 
 	// 更新下 pair.Type
 	if pair.Type == 0 {
-		pair.Type = getPairTypeFromChain(pair.Address)
+		pair.Type = getPairTypeFromChain(pair.Address, svc)
 	}
 
 	// 再加上UniV2 or UniV3等尾巴
@@ -113,24 +108,32 @@ func GeneratePairName(pair *schema.Pair, token0, token1 *schema.Token) {
 		pair.PairName = fmt.Sprintf("%s_%s", pair.PairName, "UniV2")
 	} else if pair.Type == schema.SWAP_EVENT_UNISWAPV3_LIKE {
 		pair.PairName = fmt.Sprintf("%s_%s", pair.PairName, "UniV3")
+	} else if pair.Type == schema.SWAP_EVENT_CURVE_LIKE {
+		pair.PairName = fmt.Sprintf("%s_%s", pair.PairName, "Curve")
+	} else if pair.Type == schema.SWAP_EVENT_BALANCERV2_LIKE {
+		pair.PairName = fmt.Sprintf("%s_%s", pair.PairName, "BalV2")
+	} else if pair.Type == schema.SWAP_EVENT_UNISWAPV4_LIKE {
+		pair.PairName = fmt.Sprintf("%s_%s", pair.PairName, "UniV4")
+	} else if pair.Type == schema.SWAP_EVENT_MAVERICK_LIKE {
+		pair.PairName = fmt.Sprintf("%s_%s", pair.PairName, "Maverick")
 	}
 }
 
-func getPairTypeFromChain(address string) int {
-	_type, _ := chain.GetUniPoolType(address)
+func getPairTypeFromChain(address string, svc *chain.Service) int {
+	_type, _ := svc.GetUniPoolType(address)
 
 	return _type
 }
 
-func TEST_PAIR() {
-	// pair, _ := GetPairInfo("0xEfC97fa9e615D6aE8D4Ed43c14611191f9390ab3", chain.GetMongo())  // 通缩币
-	pair, _ := GetPairInfo("0x43bEc83553828f02a4A20BAa536917F709866322", chain.GetMongo()) // normal token
+func TEST_PAIR(svc *chain.Service) {
+	// pair, _ := GetPairInfo("0xEfC97fa9e615D6aE8D4Ed43c14611191f9390ab3", svc)  // 通缩币
+	pair, _ := GetPairInfo("0x43bEc83553828f02a4A20BAa536917F709866322", svc) // normal token
 	pair.Type = schema.SWAP_EVENT_UNISWAPV2_LIKE
 
-	_type := chain.GetUniV2PoolTokenHackType(pair)
+	_type := svc.GetUniV2PoolTokenHackType(pair)
 
 	utils.Warnf("[ TEST_PAIR ] type: %v", _type)
 
-	// weth, err := chain.GetAccountWEthBalance("0xF97FAB3851F05a3ded46BAf325F58D57405332C3")
+	// weth, err := svc.GetAccountWEthBalance("0xF97FAB3851F05a3ded46BAf325F58D57405332C3")
 	// utils.Warnf("[ test ] weth balance: %v, err: %v", weth, err)
 }
@@ -2,6 +2,7 @@ package pair
 
 import (
 	"context"
+	"regexp"
 	"sfilter/config"
 	"sfilter/schema"
 	"sfilter/utils"
@@ -114,3 +115,28 @@ func GetPairMap(pageSize int64, mongodb *mongo.Database) (schema.PairMap, error)
 
 	return pairMap, nil
 }
+
+// 按地址或 pairName 模糊搜索, 按24h交易数排序
+func SearchPairs(query string, limit int64, mongodb *mongo.Database) ([]*schema.Pair, error) {
+	collection := mongodb.Collection(config.PairTableName)
+
+	filter := bson.M{"$or": bson.A{
+		bson.M{"address": query},
+		bson.M{"pairName": bson.M{"$regex": regexp.QuoteMeta(query), "$options": "i"}},
+	}}
+	opts := options.Find().SetSort(bson.D{{Key: "txNumIn24h", Value: -1}}).SetLimit(limit)
+
+	ctx, cancel := context.WithTimeout(context.Background(), config.MONGO_FIND_TIMEOUT*time.Second)
+	defer cancel()
+
+	cursor, err := collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var result []*schema.Pair
+	err = cursor.All(ctx, &result)
+
+	return result, err
+}
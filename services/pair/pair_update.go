@@ -40,6 +40,75 @@ func UpdatePoolInfo(address string, traderInfo *schema.InfoOnPools, mongodb *mon
 	}
 }
 
+// 更新集中流动性池子的当前状态
+// 只有比已保存状态更新的区块才会覆盖, 避免回溯老区块时把新状态覆盖掉
+func UpdatePoolState(address string, state *schema.InfoOnPoolState, mongodb *mongo.Client) {
+	collection := mongodb.Database(config.DatabaseName).Collection(config.PairTableName)
+
+	info := struct {
+		schema.InfoOnPoolState `bson:",inline"`
+		UpdatedAt              time.Time `bson:"updatedAt"`
+	}{
+		InfoOnPoolState: *state,
+		UpdatedAt:       time.Now(),
+	}
+
+	filter := bson.M{
+		"address": address,
+		"$or": bson.A{
+			bson.M{"poolStateBlockNo": bson.M{"$lte": state.PoolStateBlockNo}},
+			bson.M{"poolStateBlockNo": bson.M{"$exists": false}},
+		},
+	}
+
+	update := bson.D{
+		{Key: "$set", Value: info},
+	}
+
+	pairLock.Lock()
+	defer pairLock.Unlock()
+
+	_, err := collection.UpdateOne(context.Background(), filter, update)
+	if err != nil {
+		utils.Warnf("[ UpdatePoolState ] failed. pair: %v, err: %v\n", address, err)
+	}
+}
+
+// 重组时把 fromBlock 及之后写入的池子状态恢复为 state, state 为 nil 时清空
+func RestorePoolState(address string, state *schema.InfoOnPoolState, fromBlock uint64, mongodb *mongo.Client) {
+	collection := mongodb.Database(config.DatabaseName).Collection(config.PairTableName)
+
+	filter := bson.M{
+		"address":          address,
+		"poolStateBlockNo": bson.M{"$gte": fromBlock},
+	}
+
+	var update bson.D
+	if state != nil {
+		info := struct {
+			schema.InfoOnPoolState `bson:",inline"`
+			UpdatedAt              time.Time `bson:"updatedAt"`
+		}{
+			InfoOnPoolState: *state,
+			UpdatedAt:       time.Now(),
+		}
+		update = bson.D{{Key: "$set", Value: info}}
+	} else {
+		update = bson.D{
+			{Key: "$unset", Value: bson.M{"sqrtPriceX96": "", "activeLiquidity": "", "currentTick": "", "poolStateBlockNo": ""}},
+			{Key: "$set", Value: bson.M{"updatedAt": time.Now()}},
+		}
+	}
+
+	pairLock.Lock()
+	defer pairLock.Unlock()
+
+	_, err := collection.UpdateOne(context.Background(), filter, update)
+	if err != nil {
+		utils.Warnf("[ RestorePoolState ] failed. pair: %v, err: %v\n", address, err)
+	}
+}
+
 // 如果存在就更新, 不存在就插入
 func UpSertOnChainInfo(address string, infoOnChain *schema.InfoOnChain, mongodb *mongo.Client) {
 	collection := mongodb.Database(config.DatabaseName).Collection(config.PairTableName)
@@ -150,3 +219,45 @@ func UpsertPair(pair *schema.Pair, mongodb *mongo.Client) {
 		utils.Warnf("[ UpsertPair ] failed. pair: %v, err: %v\n", pair.Address, err)
 	}
 }
+
+// 回滚某个区块对pair表的影响: 删除该区块内创建的pair, 重置该区块内首次添加流动性的记录
+// 返回被删除的pair地址
+func RollbackPairsByBlock(blockNo uint64, mongodb *mongo.Client) []string {
+	collection := mongodb.Database(config.DatabaseName).Collection(config.PairTableName)
+
+	pairLock.Lock()
+	defer pairLock.Unlock()
+
+	var deleted []string
+
+	filter := bson.M{"pairCreatedBlockNo": blockNo}
+	cursor, err := collection.Find(context.Background(), filter)
+	if err != nil {
+		utils.Warnf("[ RollbackPairsByBlock ] Find error: %v, blockNo: %v", err, blockNo)
+		return deleted
+	}
+
+	var pairs []schema.Pair
+	err = cursor.All(context.Background(), &pairs)
+	if err != nil {
+		utils.Warnf("[ RollbackPairsByBlock ] cursor.All error: %v, blockNo: %v", err, blockNo)
+		return deleted
+	}
+
+	for _, p := range pairs {
+		deleted = append(deleted, p.Address)
+	}
+
+	_, err = collection.DeleteMany(context.Background(), filter)
+	if err != nil {
+		utils.Warnf("[ RollbackPairsByBlock ] DeleteMany error: %v, blockNo: %v", err, blockNo)
+	}
+
+	update := bson.M{"$set": bson.M{"firstAddPoolBlockNo": 0, "updatedAt": time.Now()}}
+	_, err = collection.UpdateMany(context.Background(), bson.M{"firstAddPoolBlockNo": blockNo}, update)
+	if err != nil {
+		utils.Warnf("[ RollbackPairsByBlock ] UpdateMany error: %v, blockNo: %v", err, blockNo)
+	}
+
+	return deleted
+}
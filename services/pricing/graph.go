@@ -0,0 +1,254 @@
+package pricing
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"sync"
+	"time"
+
+	"sfilter/config"
+	"sfilter/schema"
+	"sfilter/utils"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// 定价图: token 为节点, pair 为边, 边上记录最近一次成交的兑换比例
+// usd 稳定币与原生币(weth等)为锚点, 其他 token 沿流动性最深的路径换算到锚点得到 usd 价格
+// 流动性不足或太久没有成交的 pair 不参与定价
+type Graph struct {
+	mu sync.RWMutex
+
+	edges     map[string]map[string]*edge // token -> pair -> 边
+	liquidity map[string]float64          // pair -> usd 流动性
+}
+
+// 1 个 from = rate 个 to
+type edge struct {
+	pair      string
+	to        string
+	rate      *big.Rat
+	updatedAt time.Time
+}
+
+// token 的 usd 价格及其来源
+type Quote struct {
+	PriceInUsd utils.Decimal
+	Liquidity  float64   // 路径上流动性最浅一跳的流动性
+	Hops       int       // 到锚点经过的 pair 数
+	UpdatedAt  time.Time // 路径上最老一跳的成交时间
+}
+
+// 路径搜索时每个节点的最优状态
+type route struct {
+	rate      *big.Rat // 1 个起点 token = rate 个当前 token
+	liquidity float64
+	hops      int
+	updatedAt time.Time
+}
+
+func NewGraph() *Graph {
+	return &Graph{
+		edges:     make(map[string]map[string]*edge),
+		liquidity: make(map[string]float64),
+	}
+}
+
+// 启动时从 pair 表加载流动性足够的 pair, 之后由 swap 持续更新
+func (g *Graph) Load(db *mongo.Database) error {
+	filter := bson.M{
+		"liquidityInUsd": bson.M{"$gte": config.PricingMinLiquidityUsd},
+		"price":          bson.M{"$gt": 0},
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "liquidityInUsd", Value: -1}}).SetLimit(config.SELECT_UPPER_SIZE)
+
+	ctx, cancel := context.WithTimeout(context.Background(), config.MONGO_FIND_TIMEOUT*time.Second)
+	defer cancel()
+
+	cursor, err := db.Collection(config.PairTableName).Find(ctx, filter, opts)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	var pairs []*schema.Pair
+	if err := cursor.All(ctx, &pairs); err != nil {
+		return err
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for _, _pair := range pairs {
+		// pair 的 price 为 main token 以 quote token 计价的价格, 与 swap 一致
+		mainToken := utils.GetMainToken(_pair.Token0, _pair.Token1)
+		quoteToken := _pair.Token1
+		if mainToken == _pair.Token1 {
+			quoteToken = _pair.Token0
+		}
+
+		price := new(big.Rat)
+		if price.SetFloat64(_pair.Price) == nil {
+			continue
+		}
+
+		g.setRateLocked(_pair.Address, mainToken, quoteToken, price, _pair.TradeInfoUpdatedAt)
+		g.liquidity[_pair.Address] = _pair.LiquidityInUsd
+	}
+
+	utils.Infof("[ Graph.Load ] load pricing pairs: %v", len(pairs))
+	return nil
+}
+
+// 记录 swap 成交的兑换比例, 在区块按顺序写入时调用
+func (g *Graph) UpdateBySwap(swap *schema.Swap) {
+	price := swap.PriceDecimal.Rat()
+	if price.Sign() <= 0 {
+		return
+	}
+
+	quoteToken := swap.Token1
+	if swap.MainToken == swap.Token1 {
+		quoteToken = swap.Token0
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.setRateLocked(swap.PairAddr, swap.MainToken, quoteToken, price, swap.SwapTime)
+}
+
+func (g *Graph) setRateLocked(pair, from, to string, rate *big.Rat, updatedAt time.Time) {
+	if g.edges[from] == nil {
+		g.edges[from] = make(map[string]*edge)
+	}
+	if g.edges[to] == nil {
+		g.edges[to] = make(map[string]*edge)
+	}
+
+	g.edges[from][pair] = &edge{pair: pair, to: to, rate: rate, updatedAt: updatedAt}
+	g.edges[to][pair] = &edge{pair: pair, to: from, rate: new(big.Rat).Inv(rate), updatedAt: updatedAt}
+}
+
+// 重组时用回滚后 pair 最近的一笔 swap 恢复边, 没有 swap 时移除
+func (g *Graph) RestorePair(pair string, swap *schema.Swap) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for token, edges := range g.edges {
+		if _, ok := edges[pair]; !ok {
+			continue
+		}
+
+		delete(edges, pair)
+		if len(edges) == 0 {
+			delete(g.edges, token)
+		}
+	}
+
+	if swap == nil {
+		return
+	}
+
+	price := swap.PriceDecimal.Rat()
+	if price.Sign() <= 0 {
+		return
+	}
+
+	quoteToken := swap.Token1
+	if swap.MainToken == swap.Token1 {
+		quoteToken = swap.Token0
+	}
+
+	g.setRateLocked(pair, swap.MainToken, quoteToken, price, swap.SwapTime)
+}
+
+// 更新 pair 的 usd 流动性, 低于阈值的 pair 不参与定价
+func (g *Graph) SetLiquidity(pair string, liquidityInUsd float64) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.liquidity[pair] = liquidityInUsd
+}
+
+// 计算 token 在 at 时刻的 usd 价格, ethPrice 为该时刻的原生币价格
+// 在 PricingMaxHops 跳以内选择流动性瓶颈最大的路径
+func (g *Graph) PriceInUsd(token string, ethPrice float64, at time.Time) (*Quote, bool) {
+	if price, ok := anchorPrice(token, ethPrice); ok {
+		return &Quote{PriceInUsd: price, Liquidity: math.Inf(1), UpdatedAt: at}, true
+	}
+
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	best := map[string]*route{
+		token: {rate: big.NewRat(1, 1), liquidity: math.Inf(1), updatedAt: at},
+	}
+	frontier := []string{token}
+
+	var quote *Quote
+	for hop := 1; hop <= config.PricingMaxHops && len(frontier) > 0; hop++ {
+		var next []string
+
+		for _, from := range frontier {
+			cur := best[from]
+
+			for _, e := range g.edges[from] {
+				liquidity := g.liquidity[e.pair]
+				// 晚于 at 的成交不能用于定价, 如追赶历史区块时启动加载的最新价格
+				if liquidity < config.PricingMinLiquidityUsd || e.updatedAt.After(at) || at.Sub(e.updatedAt) > config.PricingMaxAge {
+					continue
+				}
+
+				r := &route{
+					rate:      new(big.Rat).Mul(cur.rate, e.rate),
+					liquidity: math.Min(cur.liquidity, liquidity),
+					hops:      hop,
+					updatedAt: cur.updatedAt,
+				}
+				if e.updatedAt.Before(r.updatedAt) {
+					r.updatedAt = e.updatedAt
+				}
+
+				if old, ok := best[e.to]; ok && old.liquidity >= r.liquidity {
+					continue
+				}
+				best[e.to] = r
+
+				// 到达锚点不再往下走, 记录流动性最深的一条
+				if price, ok := anchorPrice(e.to, ethPrice); ok {
+					if quote == nil || r.liquidity > quote.Liquidity {
+						quote = &Quote{
+							PriceInUsd: utils.NewDecimalFromRat(new(big.Rat).Mul(r.rate, price.Rat())),
+							Liquidity:  r.liquidity,
+							Hops:       r.hops,
+							UpdatedAt:  r.updatedAt,
+						}
+					}
+					continue
+				}
+
+				next = append(next, e.to)
+			}
+		}
+
+		frontier = next
+	}
+
+	return quote, quote != nil
+}
+
+func anchorPrice(token string, ethPrice float64) (utils.Decimal, bool) {
+	if utils.CheckExistString(token, config.QuoteUsdCoinList) {
+		return utils.NewDecimalFromFloat(1), true
+	}
+
+	if utils.CheckExistString(token, config.QuoteEthCoinList) && ethPrice > 0 {
+		return utils.NewDecimalFromFloat(ethPrice), true
+	}
+
+	return "", false
+}
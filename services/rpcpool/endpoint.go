@@ -0,0 +1,224 @@
+package rpcpool
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"sfilter/config"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// 延迟和错误率使用指数滑动平均
+const ewmaAlpha = 0.2
+
+// 单个节点及其健康状态
+type endpoint struct {
+	cfg    config.RPCEndpoint
+	client *ethclient.Client // 连接失败时为空, 健康检查时重新连接
+
+	limiter *limiter
+
+	mu        sync.Mutex
+	head      uint64
+	latency   float64 // 毫秒
+	errorRate float64 // 0~1
+	failures  int     // 连续失败次数
+}
+
+func newEndpoint(cfg config.RPCEndpoint, client *ethclient.Client) *endpoint {
+	e := &endpoint{
+		cfg:     cfg,
+		client:  client,
+		limiter: newLimiter(cfg.RateLimit),
+	}
+
+	// 没有连上的节点先视为不健康
+	if client == nil {
+		e.failures = config.RPCMaxFailures
+	}
+
+	return e
+}
+
+func (e *endpoint) getClient() *ethclient.Client {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.client
+}
+
+// 没有连上的节点重新连接, 返回可用的 client, 失败时为空
+func (e *endpoint) connect(ctx context.Context) *ethclient.Client {
+	if client := e.getClient(); client != nil {
+		return client
+	}
+
+	start := time.Now()
+	client, err := ethclient.DialContext(ctx, e.cfg.URL)
+	if err != nil {
+		e.record(start, true)
+		return nil
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.client != nil { // 并发连接时保留先连上的
+		client.Close()
+		return e.client
+	}
+	e.client = client
+
+	return client
+}
+
+func (e *endpoint) isWs() bool {
+	return strings.HasPrefix(e.cfg.URL, "ws")
+}
+
+// 打印日志时去掉 url 中的 key 等信息
+func (e *endpoint) name() string {
+	url := e.cfg.URL
+	if i := strings.Index(url, "://"); i >= 0 {
+		url = url[i+3:]
+	}
+	if i := strings.IndexAny(url, "/?"); i >= 0 {
+		url = url[:i]
+	}
+
+	return url
+}
+
+func (e *endpoint) record(start time.Time, failed bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	ms := float64(time.Since(start).Milliseconds())
+	e.latency = e.latency*(1-ewmaAlpha) + ms*ewmaAlpha
+
+	if failed {
+		e.errorRate = e.errorRate*(1-ewmaAlpha) + ewmaAlpha
+		e.failures++
+	} else {
+		e.errorRate = e.errorRate * (1 - ewmaAlpha)
+		e.failures = 0
+	}
+}
+
+func (e *endpoint) setHead(head uint64) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if head > e.head {
+		e.head = head
+	}
+}
+
+func (e *endpoint) getHead() uint64 {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.head
+}
+
+// 连续失败或者落后太多都视为不健康, 只有没有健康节点时才会使用
+func (e *endpoint) healthy(maxHead uint64) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.failures >= config.RPCMaxFailures {
+		return false
+	}
+
+	return maxHead <= e.head+uint64(config.RPCMaxHeadLag)
+}
+
+// 分数越高越优先, 落后区块数、错误率、延迟都会扣分
+func (e *endpoint) score(maxHead uint64) float64 {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	var lag uint64
+	if maxHead > e.head {
+		lag = maxHead - e.head
+	}
+
+	return 100 - float64(lag)*10 - e.errorRate*100 - e.latency/10
+}
+
+// 健康检查: 获取最新区块头, 同时更新延迟和错误率
+func (e *endpoint) check(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(config.ReceiptCallTimeout)*time.Second)
+	defer cancel()
+
+	client := e.connect(ctx)
+	if client == nil {
+		return
+	}
+
+	start := time.Now()
+	header, err := client.HeaderByNumber(ctx, nil)
+	e.record(start, err != nil)
+
+	if err == nil {
+		e.setHead(header.Number.Uint64())
+	}
+}
+
+// 令牌桶限流, rate 为每秒请求数, 0 为不限制
+type limiter struct {
+	mu     sync.Mutex
+	rate   float64
+	tokens float64
+	last   time.Time
+}
+
+func newLimiter(rate int) *limiter {
+	return &limiter{
+		rate:   float64(rate),
+		tokens: float64(rate),
+		last:   time.Now(),
+	}
+}
+
+func (l *limiter) refill() {
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.rate {
+		l.tokens = l.rate
+	}
+	l.last = now
+}
+
+func (l *limiter) allow() bool {
+	if l.rate <= 0 {
+		return true
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.refill()
+	if l.tokens < 1 {
+		return false
+	}
+
+	l.tokens--
+	return true
+}
+
+// 等待到有令牌为止
+func (l *limiter) wait(ctx context.Context) error {
+	for !l.allow() {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Duration(float64(time.Second) / l.rate)):
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package rpcpool
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// 与 ethclient.Client 同名的方法, 由节点池选择节点执行
+
+func (p *Pool) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	var header *types.Header
+	err := p.do(ctx, false, func(c *ethclient.Client) error {
+		var err error
+		header, err = c.HeaderByNumber(ctx, number)
+		return err
+	})
+
+	return header, err
+}
+
+func (p *Pool) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
+	var block *types.Block
+	err := p.do(ctx, false, func(c *ethclient.Client) error {
+		var err error
+		block, err = c.BlockByNumber(ctx, number)
+		return err
+	})
+
+	return block, err
+}
+
+func (p *Pool) BlockReceipts(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) ([]*types.Receipt, error) {
+	var receipts []*types.Receipt
+	err := p.do(ctx, false, func(c *ethclient.Client) error {
+		var err error
+		receipts, err = c.BlockReceipts(ctx, blockNrOrHash)
+		return err
+	})
+
+	return receipts, err
+}
+
+func (p *Pool) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	var ret []byte
+	err := p.do(ctx, p.needArchive(blockNumber), func(c *ethclient.Client) error {
+		var err error
+		ret, err = c.CallContract(ctx, msg, blockNumber)
+		return err
+	})
+
+	return ret, err
+}
+
+func (p *Pool) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	var code []byte
+	err := p.do(ctx, p.needArchive(blockNumber), func(c *ethclient.Client) error {
+		var err error
+		code, err = c.CodeAt(ctx, account, blockNumber)
+		return err
+	})
+
+	return code, err
+}
+
+func (p *Pool) StorageAt(ctx context.Context, account common.Address, key common.Hash, blockNumber *big.Int) ([]byte, error) {
+	var data []byte
+	err := p.do(ctx, p.needArchive(blockNumber), func(c *ethclient.Client) error {
+		var err error
+		data, err = c.StorageAt(ctx, account, key, blockNumber)
+		return err
+	})
+
+	return data, err
+}
+
+func (p *Pool) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	var balance *big.Int
+	err := p.do(ctx, p.needArchive(blockNumber), func(c *ethclient.Client) error {
+		var err error
+		balance, err = c.BalanceAt(ctx, account, blockNumber)
+		return err
+	})
+
+	return balance, err
+}
+
+// 原始 rpc 调用, 如 debug_traceBlockByHash
+func (p *Pool) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	return p.do(ctx, false, func(c *ethclient.Client) error {
+		return c.Client().CallContext(ctx, result, method, args...)
+	})
+}
+
+// 只有整体失败时才会切换节点, 单个请求的错误在 BatchElem.Error 中
+func (p *Pool) BatchCallContext(ctx context.Context, elems []rpc.BatchElem) error {
+	return p.do(ctx, false, func(c *ethclient.Client) error {
+		return c.Client().BatchCallContext(ctx, elems)
+	})
+}
@@ -0,0 +1,259 @@
+package rpcpool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"sfilter/config"
+	"sfilter/utils"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+var errNoEndpoint = errors.New("no available rpc endpoint")
+
+// 多个节点组成的节点池, 按健康状态选择节点, 失败时自动切换到下一个
+// 查询历史状态时只使用 archive 节点
+type Pool struct {
+	name      string
+	endpoints []*endpoint
+
+	mu      sync.RWMutex
+	maxHead uint64 // 所有节点中最高的区块
+
+	stop chan struct{}
+}
+
+// 连接所有节点, 至少一个成功即可, 之后后台定时做健康检查
+// 连接失败的节点保留为不健康, 健康检查时重新连接
+func Dial(name string, cfgs []config.RPCEndpoint) (*Pool, error) {
+	p := &Pool{
+		name: name,
+		stop: make(chan struct{}),
+	}
+
+	connected := 0
+	for _, cfg := range cfgs {
+		client, err := ethclient.Dial(cfg.URL)
+		if err != nil {
+			utils.Warnf("[ rpcpool.Dial ] chain: %v, dial %v failed: %v", name, cfg.URL, err)
+		} else {
+			connected++
+		}
+
+		p.endpoints = append(p.endpoints, newEndpoint(cfg, client))
+	}
+
+	if connected == 0 {
+		return nil, fmt.Errorf("chain %v: %w", name, errNoEndpoint)
+	}
+
+	p.checkHealth()
+	go p.healthLoop()
+
+	return p, nil
+}
+
+func (p *Pool) Close() {
+	close(p.stop)
+
+	for _, e := range p.endpoints {
+		if client := e.getClient(); client != nil {
+			client.Close()
+		}
+	}
+}
+
+func (p *Pool) healthLoop() {
+	ticker := time.NewTicker(time.Duration(config.RPCHealthCheckInterval) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.stop:
+			return
+		case <-ticker.C:
+			p.checkHealth()
+		}
+	}
+}
+
+func (p *Pool) checkHealth() {
+	var wg sync.WaitGroup
+	for _, e := range p.endpoints {
+		wg.Add(1)
+		go func(e *endpoint) {
+			defer wg.Done()
+			e.check(context.Background())
+			p.updateHead(e.getHead())
+		}(e)
+	}
+	wg.Wait()
+
+	maxHead := p.getMaxHead()
+	for _, e := range p.endpoints {
+		if !e.healthy(maxHead) {
+			utils.Warnf("[ checkHealth ] chain: %v, endpoint %v unhealthy. head: %v, max head: %v", p.name, e.name(), e.getHead(), maxHead)
+		}
+	}
+}
+
+func (p *Pool) updateHead(head uint64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if head > p.maxHead {
+		p.maxHead = head
+	}
+}
+
+func (p *Pool) getMaxHead() uint64 {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.maxHead
+}
+
+// 高度早于 RPCArchiveDepth 的状态查询需要 archive 节点
+func (p *Pool) needArchive(blockNumber *big.Int) bool {
+	if blockNumber == nil || blockNumber.Sign() < 0 {
+		return false
+	}
+
+	return blockNumber.Uint64()+uint64(config.RPCArchiveDepth) < p.getMaxHead()
+}
+
+// 返回按优先级排好序的候选节点: 健康的在前, 同样健康的按分数排序
+func (p *Pool) candidates(archive, wsOnly bool) []*endpoint {
+	maxHead := p.getMaxHead()
+
+	var list []*endpoint
+	for _, e := range p.endpoints {
+		if archive && !e.cfg.Archive {
+			continue
+		}
+		if wsOnly && !e.isWs() {
+			continue
+		}
+		if e.getClient() == nil {
+			continue // 还没有连上
+		}
+		list = append(list, e)
+	}
+
+	sort.SliceStable(list, func(i, j int) bool {
+		hi, hj := list[i].healthy(maxHead), list[j].healthy(maxHead)
+		if hi != hj {
+			return hi
+		}
+		return list[i].score(maxHead) > list[j].score(maxHead)
+	})
+
+	return list
+}
+
+// 依次在候选节点上执行, 节点本身出问题时切换到下一个
+// 超过限流的节点先跳过, 全部超限时等待最优节点
+func (p *Pool) do(ctx context.Context, archive bool, fn func(*ethclient.Client) error) error {
+	list := p.candidates(archive, false)
+	if len(list) == 0 && archive {
+		// 没有配置 archive 节点, 只能碰碰运气
+		list = p.candidates(false, false)
+	}
+	if len(list) == 0 {
+		return errNoEndpoint
+	}
+
+	var skipped []*endpoint
+	err := errNoEndpoint
+
+	for _, e := range list {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if !e.limiter.allow() {
+			skipped = append(skipped, e)
+			continue
+		}
+
+		var retry bool
+		retry, err = p.call(e, fn)
+		if !retry {
+			return err
+		}
+	}
+
+	for _, e := range skipped {
+		if waitErr := e.limiter.wait(ctx); waitErr != nil {
+			return waitErr
+		}
+
+		var retry bool
+		retry, err = p.call(e, fn)
+		if !retry {
+			return err
+		}
+	}
+
+	return err
+}
+
+// 返回是否需要换节点重试
+func (p *Pool) call(e *endpoint, fn func(*ethclient.Client) error) (bool, error) {
+	start := time.Now()
+	err := fn(e.getClient())
+
+	failed := isEndpointError(err)
+	e.record(start, failed)
+
+	if failed {
+		utils.Debugf("[ rpcpool.call ] chain: %v, endpoint %v failed: %v", p.name, e.name(), err)
+	}
+
+	return failed || canRetryOnOther(err), err
+}
+
+// 节点本身的问题: 网络错误、超时、限流、5xx 等
+// 节点正常返回的错误(如合约 revert)不算
+func isEndpointError(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, ethereum.NotFound) {
+		return false
+	}
+
+	var httpErr rpc.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode == http.StatusTooManyRequests || httpErr.StatusCode >= 500
+	}
+
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) {
+		// -32005: limit exceeded
+		return rpcErr.ErrorCode() == -32005
+	}
+
+	return true
+}
+
+// 不是节点的问题, 但其他节点可能成功: 区块还没同步到、不支持该方法、历史状态已裁剪
+func canRetryOnOther(err error) bool {
+	if errors.Is(err, ethereum.NotFound) {
+		return true
+	}
+
+	var rpcErr rpc.Error
+	if !errors.As(err, &rpcErr) {
+		return false
+	}
+
+	return rpcErr.ErrorCode() == -32601 || strings.Contains(rpcErr.Error(), "missing trie node")
+}
@@ -0,0 +1,167 @@
+package rpcpool
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"sfilter/config"
+	"sfilter/utils"
+
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// 所有 ws 节点都订阅失败时的重试间隔
+const resubscribeInterval = 5 * time.Second
+
+// 跨节点的区块头订阅, 当前节点断开或落后时切换到其他 ws 节点
+// 切换期间漏掉的区块头会补发, 调用方看到的是一个连续的订阅
+type HeadSubscription struct {
+	pool *Pool
+	ch   chan<- *types.Header
+
+	err       chan error
+	quit      chan struct{}
+	closeOnce sync.Once
+
+	last uint64 // 最后发出的区块号
+}
+
+func (p *Pool) SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (*HeadSubscription, error) {
+	if len(p.candidates(false, true)) == 0 {
+		return nil, errNoEndpoint
+	}
+
+	sub := &HeadSubscription{
+		pool: p,
+		ch:   ch,
+		err:  make(chan error, 1),
+		quit: make(chan struct{}),
+	}
+
+	go sub.loop(ctx)
+
+	return sub, nil
+}
+
+func (s *HeadSubscription) Unsubscribe() {
+	s.closeOnce.Do(func() {
+		close(s.quit)
+	})
+}
+
+// 只有 ctx 结束时才会返回错误, 节点错误在内部处理
+func (s *HeadSubscription) Err() <-chan error {
+	return s.err
+}
+
+func (s *HeadSubscription) loop(ctx context.Context) {
+	for {
+		select {
+		case <-s.quit:
+			return
+		case <-ctx.Done():
+			s.err <- ctx.Err()
+			return
+		default:
+		}
+
+		if !s.subscribeBest(ctx) {
+			utils.Warnf("[ HeadSubscription ] chain: %v, no ws endpoint available, retry after %v", s.pool.name, resubscribeInterval)
+
+			select {
+			case <-time.After(resubscribeInterval):
+			case <-s.quit:
+				return
+			case <-ctx.Done():
+				s.err <- ctx.Err()
+				return
+			}
+		}
+	}
+}
+
+// 在最优的 ws 节点上订阅, 直到该节点出错或不再健康才返回
+func (s *HeadSubscription) subscribeBest(ctx context.Context) bool {
+	for _, e := range s.pool.candidates(false, true) {
+		headers := make(chan *types.Header)
+		sub, err := e.getClient().SubscribeNewHead(ctx, headers)
+		if err != nil {
+			utils.Warnf("[ HeadSubscription ] chain: %v, subscribe on %v failed: %v", s.pool.name, e.name(), err)
+			e.record(time.Now(), true)
+			continue
+		}
+
+		utils.Infof("[ HeadSubscription ] chain: %v, subscribed on %v", s.pool.name, e.name())
+		s.run(ctx, e, sub.Err(), headers)
+		sub.Unsubscribe()
+
+		return true
+	}
+
+	return false
+}
+
+func (s *HeadSubscription) run(ctx context.Context, e *endpoint, subErr <-chan error, headers chan *types.Header) {
+	ticker := time.NewTicker(time.Duration(config.RPCHealthCheckInterval) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.quit:
+			return
+		case <-ctx.Done():
+			return
+
+		case err := <-subErr:
+			utils.Warnf("[ HeadSubscription ] chain: %v, subscription on %v error: %v, switching endpoint", s.pool.name, e.name(), err)
+			e.record(time.Now(), true)
+			return
+
+		case <-ticker.C:
+			// 订阅还在, 但节点已经落后, 换一个节点
+			if !e.healthy(s.pool.getMaxHead()) {
+				utils.Warnf("[ HeadSubscription ] chain: %v, endpoint %v is lagging, switching endpoint", s.pool.name, e.name())
+				return
+			}
+
+		case header := <-headers:
+			number := header.Number.Uint64()
+			e.setHead(number)
+			s.pool.updateHead(number)
+
+			s.fillGap(ctx, number)
+			s.deliver(header)
+		}
+	}
+}
+
+// 补发切换节点期间漏掉的区块头
+func (s *HeadSubscription) fillGap(ctx context.Context, number uint64) {
+	if s.last == 0 || number <= s.last+1 {
+		return
+	}
+
+	for n := s.last + 1; n < number; n++ {
+		header, err := s.pool.HeaderByNumber(ctx, new(big.Int).SetUint64(n))
+		if err != nil {
+			utils.Warnf("[ HeadSubscription ] chain: %v, get missed header %v failed: %v", s.pool.name, n, err)
+			return
+		}
+
+		s.deliver(header)
+	}
+}
+
+func (s *HeadSubscription) deliver(header *types.Header) {
+	select {
+	case s.ch <- header:
+	case <-s.quit:
+		return
+	}
+
+	if number := header.Number.Uint64(); number > s.last {
+		s.last = number
+	}
+}
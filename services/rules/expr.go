@@ -0,0 +1,607 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// 规则表达式, 所有值都是 float64, 条件成立为 1, 不成立为 0
+//
+//	运算: + - * / < <= > >= == != && || ! 以及括号, and/or/not 与 &&/||/! 相同
+//	数字: 10000, 0.5, 5% (=0.05), 时长 30s/5m/1h/7d (换算为秒)
+//	变量: pair 表字段(bson 名), 如 liquidityInUsd、txNumIn1h、mainTokenHackType, 以及 Env 提供的派生变量
+//	k线函数: bar(field, tf, i)          往前第 i 根已收盘柱子, 0 为最近一根
+//	         avg/sum/highest/lowest(field, tf, n)  最近 n 根柱子, 不足 n 根时用已有的
+//	         rising/falling(field, tf, n)  最近 n 根柱子严格递增/递减
+//	         没有柱子, 或 bar、rising、falling 的柱子不足时求值出错, 条件视为不成立
+//	         field 为 close(usd收盘价)、volume(usd交易量)、tx(交易数), tf 为周期, 如 5m、1h
+//	其他函数: abs(x), min(a, b), max(a, b)
+
+var (
+	ErrNotEnoughData = errors.New("not enough kline data")
+	ErrUnknownVar    = errors.New("unknown variable")
+	ErrDivideByZero  = errors.New("divide by zero")
+)
+
+// 表达式求值需要的数据
+type Env interface {
+	Var(name string) (float64, bool)
+
+	// 最近 n 根已收盘柱子的值, 按时间正序; 数据不足时返回已有的
+	Series(field string, tf time.Duration, n int) ([]float64, error)
+}
+
+var seriesFields = map[string]bool{"close": true, "volume": true, "tx": true}
+
+type Expr struct {
+	src  string
+	root node
+}
+
+func (e *Expr) String() string {
+	return e.src
+}
+
+func (e *Expr) Eval(env Env) (float64, error) {
+	return e.root.eval(env)
+}
+
+// 条件是否成立, 求值出错视为不成立
+func (e *Expr) Match(env Env) (bool, error) {
+	v, err := e.root.eval(env)
+	if err != nil {
+		return false, err
+	}
+
+	return v != 0, nil
+}
+
+func Parse(src string) (*Expr, error) {
+	tokens, err := tokenize(src)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.peek().kind != TOKEN_EOF {
+		return nil, fmt.Errorf("unexpected %q at %v", p.peek().text, p.peek().pos)
+	}
+
+	return &Expr{src: src, root: root}, nil
+}
+
+// ---------- 词法 ----------
+
+const (
+	TOKEN_EOF = iota
+	TOKEN_NUMBER
+	TOKEN_IDENT
+	TOKEN_OP
+)
+
+type token struct {
+	kind  int
+	text  string
+	value float64
+	pos   int
+}
+
+var durationUnits = map[byte]float64{'s': 1, 'm': 60, 'h': 60 * 60, 'd': 24 * 60 * 60}
+
+var twoCharOps = []string{"<=", ">=", "==", "!=", "&&", "||"}
+
+func tokenize(src string) ([]token, error) {
+	var tokens []token
+
+	for i := 0; i < len(src); {
+		c := src[i]
+
+		switch {
+		case c == ' ' || c == '\t' || c == '\n':
+			i++
+
+		case c >= '0' && c <= '9' || c == '.':
+			start := i
+			for i < len(src) && (src[i] >= '0' && src[i] <= '9' || src[i] == '.') {
+				i++
+			}
+
+			value, err := strconv.ParseFloat(src[start:i], 64)
+			if err != nil {
+				return nil, fmt.Errorf("bad number %q at %v", src[start:i], start)
+			}
+
+			// 后缀: 百分比或时长
+			if i < len(src) {
+				if src[i] == '%' {
+					value /= 100
+					i++
+				} else if unit, ok := durationUnits[src[i]]; ok && (i+1 == len(src) || !isIdentChar(src[i+1])) {
+					value *= unit
+					i++
+				}
+			}
+
+			tokens = append(tokens, token{kind: TOKEN_NUMBER, text: src[start:i], value: value, pos: start})
+
+		case isIdentChar(c):
+			start := i
+			for i < len(src) && isIdentChar(src[i]) {
+				i++
+			}
+
+			text := src[start:i]
+			switch text {
+			case "and":
+				tokens = append(tokens, token{kind: TOKEN_OP, text: "&&", pos: start})
+			case "or":
+				tokens = append(tokens, token{kind: TOKEN_OP, text: "||", pos: start})
+			case "not":
+				tokens = append(tokens, token{kind: TOKEN_OP, text: "!", pos: start})
+			default:
+				tokens = append(tokens, token{kind: TOKEN_IDENT, text: text, pos: start})
+			}
+
+		default:
+			op := ""
+			for _, two := range twoCharOps {
+				if strings.HasPrefix(src[i:], two) {
+					op = two
+					break
+				}
+			}
+			if op == "" {
+				if !strings.ContainsRune("+-*/<>!(),", rune(c)) {
+					return nil, fmt.Errorf("unexpected %q at %v", c, i)
+				}
+				op = string(c)
+			}
+
+			tokens = append(tokens, token{kind: TOKEN_OP, text: op, pos: i})
+			i += len(op)
+		}
+	}
+
+	return append(tokens, token{kind: TOKEN_EOF, pos: len(src)}), nil
+}
+
+func isIdentChar(c byte) bool {
+	return c == '_' || unicode.IsLetter(rune(c)) || c >= '0' && c <= '9'
+}
+
+// ---------- 语法 ----------
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != TOKEN_EOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) accept(ops ...string) (string, bool) {
+	t := p.peek()
+	if t.kind != TOKEN_OP {
+		return "", false
+	}
+
+	for _, op := range ops {
+		if t.text == op {
+			p.pos++
+			return op, true
+		}
+	}
+
+	return "", false
+}
+
+func (p *parser) expect(op string) error {
+	if _, ok := p.accept(op); !ok {
+		return fmt.Errorf("expect %q at %v", op, p.peek().pos)
+	}
+	return nil
+}
+
+func (p *parser) parseBinary(next func() (node, error), ops ...string) (node, error) {
+	left, err := next()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		op, ok := p.accept(ops...)
+		if !ok {
+			return left, nil
+		}
+
+		right, err := next()
+		if err != nil {
+			return nil, err
+		}
+
+		left = &binaryNode{op: op, left: left, right: right}
+	}
+}
+
+func (p *parser) parseOr() (node, error) {
+	return p.parseBinary(p.parseAnd, "||")
+}
+
+func (p *parser) parseAnd() (node, error) {
+	return p.parseBinary(p.parseNot, "&&")
+}
+
+func (p *parser) parseNot() (node, error) {
+	if _, ok := p.accept("!"); ok {
+		x, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return &unaryNode{op: "!", x: x}, nil
+	}
+
+	return p.parseCompare()
+}
+
+func (p *parser) parseCompare() (node, error) {
+	left, err := p.parseAdd()
+	if err != nil {
+		return nil, err
+	}
+
+	op, ok := p.accept("<", "<=", ">", ">=", "==", "!=")
+	if !ok {
+		return left, nil
+	}
+
+	right, err := p.parseAdd()
+	if err != nil {
+		return nil, err
+	}
+
+	return &binaryNode{op: op, left: left, right: right}, nil
+}
+
+func (p *parser) parseAdd() (node, error) {
+	return p.parseBinary(p.parseMul, "+", "-")
+}
+
+func (p *parser) parseMul() (node, error) {
+	return p.parseBinary(p.parseUnary, "*", "/")
+}
+
+func (p *parser) parseUnary() (node, error) {
+	if _, ok := p.accept("-"); ok {
+		x, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &unaryNode{op: "-", x: x}, nil
+	}
+
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	t := p.next()
+
+	switch t.kind {
+	case TOKEN_NUMBER:
+		return numberNode(t.value), nil
+
+	case TOKEN_IDENT:
+		if _, ok := p.accept("("); !ok {
+			return varNode(t.text), nil
+		}
+
+		var args []node
+		if _, ok := p.accept(")"); !ok {
+			for {
+				arg, err := p.parseOr()
+				if err != nil {
+					return nil, err
+				}
+				args = append(args, arg)
+
+				if _, ok := p.accept(","); !ok {
+					break
+				}
+			}
+
+			if err := p.expect(")"); err != nil {
+				return nil, err
+			}
+		}
+
+		return newCallNode(t.text, args, t.pos)
+
+	case TOKEN_OP:
+		if t.text == "(" {
+			x, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect(")"); err != nil {
+				return nil, err
+			}
+			return x, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unexpected %q at %v", t.text, t.pos)
+}
+
+// ---------- 求值 ----------
+
+type node interface {
+	eval(env Env) (float64, error)
+}
+
+type numberNode float64
+
+func (n numberNode) eval(env Env) (float64, error) {
+	return float64(n), nil
+}
+
+type varNode string
+
+func (n varNode) eval(env Env) (float64, error) {
+	v, ok := env.Var(string(n))
+	if !ok {
+		return 0, fmt.Errorf("%w: %v", ErrUnknownVar, string(n))
+	}
+	return v, nil
+}
+
+type unaryNode struct {
+	op string
+	x  node
+}
+
+func (n *unaryNode) eval(env Env) (float64, error) {
+	x, err := n.x.eval(env)
+	if err != nil {
+		return 0, err
+	}
+
+	if n.op == "-" {
+		return -x, nil
+	}
+
+	return boolValue(x == 0), nil
+}
+
+type binaryNode struct {
+	op          string
+	left, right node
+}
+
+func (n *binaryNode) eval(env Env) (float64, error) {
+	l, err := n.left.eval(env)
+	if err != nil {
+		return 0, err
+	}
+
+	// 短路求值, 避免不必要的k线查询
+	if n.op == "&&" && l == 0 {
+		return 0, nil
+	}
+	if n.op == "||" && l != 0 {
+		return 1, nil
+	}
+
+	r, err := n.right.eval(env)
+	if err != nil {
+		return 0, err
+	}
+
+	switch n.op {
+	case "+":
+		return l + r, nil
+	case "-":
+		return l - r, nil
+	case "*":
+		return l * r, nil
+	case "/":
+		if r == 0 {
+			return 0, ErrDivideByZero
+		}
+		return l / r, nil
+	case "<":
+		return boolValue(l < r), nil
+	case "<=":
+		return boolValue(l <= r), nil
+	case ">":
+		return boolValue(l > r), nil
+	case ">=":
+		return boolValue(l >= r), nil
+	case "==":
+		return boolValue(l == r), nil
+	case "!=":
+		return boolValue(l != r), nil
+	}
+
+	// && 与 || 走到这里时左边已确定, 结果取决于右边
+	return boolValue(r != 0), nil
+}
+
+func boolValue(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+type callNode struct {
+	name string
+	args []node
+
+	// k线函数的参数, 解析时确定
+	field string
+	tf    time.Duration
+	n     int
+}
+
+func newCallNode(name string, args []node, pos int) (node, error) {
+	c := &callNode{name: name, args: args}
+
+	switch name {
+	case "abs":
+		if len(args) != 1 {
+			return nil, fmt.Errorf("%v needs 1 argument at %v", name, pos)
+		}
+
+	case "min", "max":
+		if len(args) != 2 {
+			return nil, fmt.Errorf("%v needs 2 arguments at %v", name, pos)
+		}
+
+	case "bar", "avg", "sum", "highest", "lowest", "rising", "falling":
+		if len(args) != 3 {
+			return nil, fmt.Errorf("%v needs (field, tf, n) at %v", name, pos)
+		}
+
+		field, ok := args[0].(varNode)
+		if !ok || !seriesFields[string(field)] {
+			return nil, fmt.Errorf("%v: field must be close, volume or tx at %v", name, pos)
+		}
+
+		tf, ok := args[1].(numberNode)
+		if !ok || tf <= 0 {
+			return nil, fmt.Errorf("%v: tf must be a duration such as 5m at %v", name, pos)
+		}
+
+		n, ok := args[2].(numberNode)
+		if !ok || n < 0 || float64(n) != math.Trunc(float64(n)) {
+			return nil, fmt.Errorf("%v: n must be a non-negative integer at %v", name, pos)
+		}
+
+		c.field = string(field)
+		c.tf = time.Duration(tf) * time.Second
+		c.n = int(n)
+
+		if name != "bar" && c.n == 0 {
+			return nil, fmt.Errorf("%v: n must be positive at %v", name, pos)
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown function %v at %v", name, pos)
+	}
+
+	return c, nil
+}
+
+func (c *callNode) eval(env Env) (float64, error) {
+	switch c.name {
+	case "abs", "min", "max":
+		var values []float64
+		for _, arg := range c.args {
+			v, err := arg.eval(env)
+			if err != nil {
+				return 0, err
+			}
+			values = append(values, v)
+		}
+
+		if c.name == "abs" {
+			return math.Abs(values[0]), nil
+		}
+		if c.name == "min" {
+			return math.Min(values[0], values[1]), nil
+		}
+		return math.Max(values[0], values[1]), nil
+
+	case "bar":
+		values, err := c.series(env, c.n+1, true)
+		if err != nil {
+			return 0, err
+		}
+		return values[0], nil
+	}
+
+	exact := c.name == "rising" || c.name == "falling"
+	values, err := c.series(env, c.n, exact)
+	if err != nil {
+		return 0, err
+	}
+
+	switch c.name {
+	case "avg":
+		return sum(values) / float64(len(values)), nil
+
+	case "sum":
+		return sum(values), nil
+
+	case "highest":
+		result := values[0]
+		for _, v := range values {
+			result = math.Max(result, v)
+		}
+		return result, nil
+
+	case "lowest":
+		result := values[0]
+		for _, v := range values {
+			result = math.Min(result, v)
+		}
+		return result, nil
+
+	case "rising", "falling":
+		for i := 1; i < len(values); i++ {
+			if c.name == "rising" && values[i] <= values[i-1] {
+				return 0, nil
+			}
+			if c.name == "falling" && values[i] >= values[i-1] {
+				return 0, nil
+			}
+		}
+		return 1, nil
+	}
+
+	return 0, fmt.Errorf("unknown function %v", c.name)
+}
+
+// exact 表示必须取到 n 根
+func (c *callNode) series(env Env, n int, exact bool) ([]float64, error) {
+	values, err := env.Series(c.field, c.tf, n)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(values) == 0 || exact && len(values) < n {
+		return nil, ErrNotEnoughData
+	}
+
+	if len(values) > n {
+		values = values[len(values)-n:]
+	}
+
+	return values, nil
+}
+
+func sum(values []float64) float64 {
+	var total float64
+	for _, v := range values {
+		total += v
+	}
+	return total
+}
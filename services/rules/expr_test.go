@@ -0,0 +1,245 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+)
+
+// 变量与k线数据都是固定的, k线的 key 为 field_周期秒数
+type testEnv struct {
+	vars   map[string]float64
+	series map[string][]float64
+
+	seriesCalls int
+}
+
+func newTestEnv() *testEnv {
+	return &testEnv{
+		vars: map[string]float64{
+			"liquidityInUsd": 50000,
+			"txNumIn1h":      120,
+		},
+		series: map[string][]float64{
+			"close_300":   {1, 2, 3, 4, 5},
+			"volume_3600": {30, 20, 10},
+			"tx_300":      {7, 7, 8},
+		},
+	}
+}
+
+func (e *testEnv) Var(name string) (float64, bool) {
+	v, ok := e.vars[name]
+	return v, ok
+}
+
+func (e *testEnv) Series(field string, tf time.Duration, n int) ([]float64, error) {
+	e.seriesCalls++
+
+	values := e.series[fmt.Sprintf("%v_%v", field, int(tf.Seconds()))]
+	if len(values) > n {
+		values = values[len(values)-n:]
+	}
+
+	return values, nil
+}
+
+func TestEvalPrecedence(t *testing.T) {
+	cases := []struct {
+		src  string
+		want float64
+	}{
+		{"1 + 2 * 3", 7},
+		{"(1 + 2) * 3", 9},
+		{"10 - 2 - 3", 5},
+		{"8 / 4 / 2", 1},
+		{"-2 * 3", -6},
+		{"- -2", 2},
+		{"1 + 2 > 2", 1},
+		{"1 < 2 && 3 > 4 || 1", 1},
+		{"0 || 1 && 0", 0},
+		{"!0 && 0", 0},
+		{"not 1 == 2", 1},
+		{"1 and 0 or 1", 1},
+		{"abs(-3) + min(1, 2) * max(3, 4)", 7},
+		{"liquidityInUsd >= 10000 and txNumIn1h > 100", 1},
+	}
+
+	for _, c := range cases {
+		expr, err := Parse(c.src)
+		if err != nil {
+			t.Fatalf("Parse(%q) error: %v", c.src, err)
+		}
+
+		got, err := expr.Eval(newTestEnv())
+		if err != nil {
+			t.Fatalf("Eval(%q) error: %v", c.src, err)
+		}
+		if got != c.want {
+			t.Errorf("Eval(%q) = %v, want %v", c.src, got, c.want)
+		}
+	}
+}
+
+func TestParseNumberSuffix(t *testing.T) {
+	cases := []struct {
+		src  string
+		want float64
+	}{
+		{"5%", 0.05},
+		{"2.5%", 0.025},
+		{"30s", 30},
+		{"5m", 5 * 60},
+		{"1h", 60 * 60},
+		{"1d", 24 * 60 * 60},
+		{"7d / 1d", 7},
+		{"1d+1h", 25 * 60 * 60},
+		{"0.5", 0.5},
+	}
+
+	for _, c := range cases {
+		expr, err := Parse(c.src)
+		if err != nil {
+			t.Fatalf("Parse(%q) error: %v", c.src, err)
+		}
+
+		got, err := expr.Eval(newTestEnv())
+		if err != nil {
+			t.Fatalf("Eval(%q) error: %v", c.src, err)
+		}
+		if math.Abs(got-c.want) > 1e-12 {
+			t.Errorf("Eval(%q) = %v, want %v", c.src, got, c.want)
+		}
+	}
+}
+
+func TestParseError(t *testing.T) {
+	cases := []string{
+		"",
+		"1 +",
+		"(1",
+		"1 2",
+		"1 < 2 == 1", // 比较不能连写
+		"5min",       // 时长单位后面不能再跟字母
+		"1.2.3",      // 非法数字
+		"1 # 2",      // 非法字符
+		"foo(1)",     // 未知函数
+		"abs(1, 2)",
+		"min(1)",
+		"bar(price, 5m, 0)",   // field 只能是 close/volume/tx
+		"bar(close, x, 0)",    // tf 必须是时长
+		"bar(close, 5m, 1.5)", // n 必须是整数
+		"bar(close, 5m, -1)",
+		"avg(close, 5m, 0)", // 除 bar 外 n 必须为正数
+		"rising(close, 5m)",
+	}
+
+	for _, src := range cases {
+		if _, err := Parse(src); err == nil {
+			t.Errorf("Parse(%q) should fail", src)
+		}
+	}
+}
+
+func TestEvalShortCircuit(t *testing.T) {
+	cases := []struct {
+		src  string
+		want float64
+	}{
+		{"0 && bar(close, 5m, 0) > 0", 0},
+		{"1 || bar(close, 5m, 0) > 0", 1},
+		{"0 and unknown > 1", 0},
+		{"1 or unknown > 1", 1},
+		{"0 && 1 / 0", 0},
+	}
+
+	for _, c := range cases {
+		expr, err := Parse(c.src)
+		if err != nil {
+			t.Fatalf("Parse(%q) error: %v", c.src, err)
+		}
+
+		env := newTestEnv()
+		got, err := expr.Eval(env)
+		if err != nil {
+			t.Fatalf("Eval(%q) error: %v", c.src, err)
+		}
+		if got != c.want {
+			t.Errorf("Eval(%q) = %v, want %v", c.src, got, c.want)
+		}
+		if env.seriesCalls != 0 {
+			t.Errorf("Eval(%q) should not query klines, got %v calls", c.src, env.seriesCalls)
+		}
+	}
+}
+
+func TestEvalError(t *testing.T) {
+	cases := []struct {
+		src string
+		err error
+	}{
+		{"1 && unknown > 1", ErrUnknownVar},
+		{"0 || unknown > 1", ErrUnknownVar},
+		{"1 / 0", ErrDivideByZero},
+		{"bar(close, 5m, 5) > 0", ErrNotEnoughData},
+		{"rising(close, 5m, 6)", ErrNotEnoughData},
+		{"avg(close, 15m, 3) > 0", ErrNotEnoughData}, // 没有柱子
+	}
+
+	for _, c := range cases {
+		expr, err := Parse(c.src)
+		if err != nil {
+			t.Fatalf("Parse(%q) error: %v", c.src, err)
+		}
+
+		if _, err := expr.Eval(newTestEnv()); !errors.Is(err, c.err) {
+			t.Errorf("Eval(%q) error = %v, want %v", c.src, err, c.err)
+		}
+
+		// 求值出错时条件不成立
+		if ok, _ := expr.Match(newTestEnv()); ok {
+			t.Errorf("Match(%q) should be false", c.src)
+		}
+	}
+}
+
+func TestEvalKlineFunctions(t *testing.T) {
+	cases := []struct {
+		src  string
+		want float64
+	}{
+		{"bar(close, 5m, 0)", 5},
+		{"bar(close, 5m, 1)", 4},
+		{"bar(close, 5m, 4)", 1},
+		{"avg(close, 5m, 2)", 4.5},
+		{"avg(close, 5m, 10)", 3}, // 不足 n 根时用已有的
+		{"sum(close, 5m, 3)", 12},
+		{"sum(close, 5m, 10)", 15},
+		{"highest(close, 5m, 3)", 5},
+		{"lowest(close, 5m, 3)", 3},
+		{"lowest(close, 5m, 10)", 1},
+		{"rising(close, 5m, 5)", 1},
+		{"falling(close, 5m, 2)", 0},
+		{"falling(volume, 1h, 3)", 1},
+		{"rising(tx, 5m, 3)", 0}, // 严格递增, 相等不算
+		{"bar(volume, 1h, 0) / avg(volume, 1h, 3)", 0.5},
+		{"bar(close, 5m, 0) / bar(close, 5m, 1) - 1 >= 25%", 1},
+	}
+
+	for _, c := range cases {
+		expr, err := Parse(c.src)
+		if err != nil {
+			t.Fatalf("Parse(%q) error: %v", c.src, err)
+		}
+
+		got, err := expr.Eval(newTestEnv())
+		if err != nil {
+			t.Fatalf("Eval(%q) error: %v", c.src, err)
+		}
+		if math.Abs(got-c.want) > 1e-12 {
+			t.Errorf("Eval(%q) = %v, want %v", c.src, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package rules
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// 一个策略的规则文件, 例如:
+//
+//	schedule:
+//	  buy: 1h
+//	  sell: 10m
+//	universe:
+//	  sort: txNumIn1h
+//	  limit: 5
+//	buy:
+//	  - mainTokenHackType <= 2
+//	  - age < 1h && liquidityInUsd >= 10000
+//	sell:
+//	  - priceInUsd >= buyPrice * 5
+//	  - rank == 0 || rank > 5
+//
+// buy 中的条件全部成立才买入, sell 中任意一条成立即卖出, 卖出原因为该条件
+type Definition struct {
+	Schedule struct {
+		Buy    time.Duration `yaml:"buy"`
+		Sell   time.Duration `yaml:"sell"`
+		Offset time.Duration `yaml:"offset"`
+	} `yaml:"schedule"`
+
+	// 参与筛选的 pair: 最近 Active 内有交易, 按 Sort 字段倒序取前 Limit 个
+	Universe struct {
+		Sort   string        `yaml:"sort"`
+		Limit  int64         `yaml:"limit"`
+		Active time.Duration `yaml:"active"`
+	} `yaml:"universe"`
+
+	Buy  []*Expr `yaml:"-"`
+	Sell []*Expr `yaml:"-"`
+}
+
+type definitionFile struct {
+	Definition `yaml:",inline"`
+
+	Buy  []string `yaml:"buy"`
+	Sell []string `yaml:"sell"`
+}
+
+func Load(path string) (*Definition, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var f definitionFile
+	if err := yaml.Unmarshal(data, &f); err != nil {
+		return nil, err
+	}
+
+	def := &f.Definition
+
+	if def.Schedule.Buy <= 0 {
+		return nil, fmt.Errorf("schedule.buy is required")
+	}
+
+	if len(f.Buy) == 0 {
+		return nil, fmt.Errorf("buy rules are required")
+	}
+
+	if def.Universe.Sort == "" {
+		def.Universe.Sort = "txNumIn1h"
+	}
+	if def.Universe.Limit <= 0 {
+		def.Universe.Limit = 20
+	}
+	if def.Universe.Active <= 0 {
+		def.Universe.Active = time.Hour
+	}
+
+	if def.Buy, err = parseAll(f.Buy); err != nil {
+		return nil, fmt.Errorf("buy: %v", err)
+	}
+
+	if def.Sell, err = parseAll(f.Sell); err != nil {
+		return nil, fmt.Errorf("sell: %v", err)
+	}
+
+	return def, nil
+}
+
+func parseAll(srcs []string) ([]*Expr, error) {
+	var exprs []*Expr
+	for _, src := range srcs {
+		expr, err := Parse(src)
+		if err != nil {
+			return nil, fmt.Errorf("%q: %v", src, err)
+		}
+		exprs = append(exprs, expr)
+	}
+
+	return exprs, nil
+}
+
+// 所有买入条件都成立, 第一个不成立或求值出错的条件一并返回
+func (d *Definition) MatchBuy(env Env) (bool, *Expr, error) {
+	for _, expr := range d.Buy {
+		ok, err := expr.Match(env)
+		if err != nil || !ok {
+			return false, expr, err
+		}
+	}
+
+	return true, nil, nil
+}
+
+// 第一个成立的卖出条件, 没有时返回 nil
+func (d *Definition) MatchSell(env Env) *Expr {
+	for _, expr := range d.Sell {
+		if ok, _ := expr.Match(env); ok {
+			return expr
+		}
+	}
+
+	return nil
+}
+
+// 把结构体中的数值字段按 bson 名展开为变量, inline 的嵌入结构体一并展开
+func StructVars(v interface{}, vars map[string]float64) {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Struct {
+		return
+	}
+
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		tag := field.Tag.Get("bson")
+		name := strings.Split(tag, ",")[0]
+
+		if strings.Contains(tag, "inline") {
+			StructVars(rv.Field(i).Interface(), vars)
+			continue
+		}
+
+		if name == "" || name == "-" {
+			continue
+		}
+
+		fv := rv.Field(i)
+		switch fv.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			vars[name] = float64(fv.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			vars[name] = float64(fv.Uint())
+		case reflect.Float32, reflect.Float64:
+			vars[name] = fv.Float()
+		}
+	}
+}
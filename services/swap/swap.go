@@ -4,6 +4,7 @@ import (
 	"context"
 	"sfilter/config"
 	"sfilter/schema"
+	"sfilter/services/bulk"
 	"sfilter/utils"
 	"time"
 
@@ -64,3 +65,83 @@ func GetSwapEvents(findOpt *options.FindOptions, filter *primitive.M, mongodb *m
 	err = cursor.All(ctx, &result)
 	return result, totalCount, err
 }
+
+func GetSwapsByBlock(blockNo uint64, mongodb *mongo.Client) ([]schema.Swap, error) {
+	collection := mongodb.Database(config.DatabaseName).Collection(config.SwapTableName)
+
+	var result []schema.Swap
+	cursor, err := collection.Find(context.Background(), bson.M{"blockNo": blockNo})
+	if err != nil {
+		return result, err
+	}
+	defer cursor.Close(context.Background())
+
+	err = cursor.All(context.Background(), &result)
+	return result, err
+}
+
+// 按交易时间顺序取出pair在 [start, end) 区间内的swap, 用于重建k线
+func GetSwapsByPairAndTime(pair string, start, end time.Time, mongodb *mongo.Client) ([]schema.Swap, error) {
+	collection := mongodb.Database(config.DatabaseName).Collection(config.SwapTableName)
+
+	filter := bson.M{
+		"pairAddr": pair,
+		"swapTime": bson.M{"$gte": start, "$lt": end},
+	}
+	opts := options.Find().SetSort(bson.D{
+		{Key: "blockNo", Value: 1},
+		{Key: "position", Value: 1},
+	})
+
+	var result []schema.Swap
+	cursor, err := collection.Find(context.Background(), filter, opts)
+	if err != nil {
+		return result, err
+	}
+	defer cursor.Close(context.Background())
+
+	err = cursor.All(context.Background(), &result)
+	return result, err
+}
+
+// pair 最近的一笔swap, 没有时返回 nil
+func GetLatestSwapByPair(pair string, mongodb *mongo.Client) (*schema.Swap, error) {
+	collection := mongodb.Database(config.DatabaseName).Collection(config.SwapTableName)
+
+	opts := options.FindOne().SetSort(bson.D{
+		{Key: "blockNo", Value: -1},
+		{Key: "position", Value: -1},
+	})
+
+	var result schema.Swap
+	err := collection.FindOne(context.Background(), bson.M{"pairAddr": pair}, opts).Decode(&result)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func DeleteSwapsByBlock(blockNo uint64, mongodb *mongo.Client) error {
+	collection := mongodb.Database(config.DatabaseName).Collection(config.SwapTableName)
+
+	_, err := collection.DeleteMany(context.Background(), bson.M{"blockNo": blockNo})
+	if err != nil {
+		utils.Warnf("[ DeleteSwapsByBlock ] DeleteMany error: %v, blockNo: %v", err, blockNo)
+	}
+
+	return err
+}
+
+// 加入区块的批量写入, 按 logIndexWithTx 覆盖写, 区块重试时不会重复
+func AddSwapsToBatch(swaps []*schema.Swap, b *bulk.Batch) {
+	for _, swap := range swaps {
+		swap.CreatedAt = time.Now()
+
+		filter := bson.M{"logIndexWithTx": swap.LogIndexWithTx}
+		b.Upsert(config.SwapTableName, filter, swap)
+	}
+}
@@ -115,3 +115,19 @@ func DeleteOldEntries(username string, deleteCount int64, mongodb *mongo.Client)
 
 	utils.Infof("[ DeleteOldEntries ] delete success, count: %v", deleteCount)
 }
+
+// 重组时删除孤块中交易的跟踪记录
+func DeleteTrackSwapsByTx(txHashes []string, mongodb *mongo.Client) error {
+	if len(txHashes) == 0 {
+		return nil
+	}
+
+	collection := mongodb.Database(config.DatabaseName).Collection(config.TrackSwapTableName)
+
+	_, err := collection.DeleteMany(context.Background(), bson.M{"txhash": bson.M{"$in": txHashes}})
+	if err != nil {
+		utils.Warnf("[ DeleteTrackSwapsByTx ] DeleteMany error: %v", err)
+	}
+
+	return err
+}
@@ -44,18 +44,20 @@ func SaveTokenInfo(token *schema.Token, mongodb *mongo.Client) {
 }
 
 // 更新token价格字段
-func UpdateTokenPrice(address string, price float64, mongodb *mongo.Client) {
+func UpdateTokenPrice(address string, price utils.Decimal, mongodb *mongo.Client) {
 	collection := mongodb.Database(config.DatabaseName).Collection(config.TokenTableName)
 
 	filter := bson.D{{Key: "address", Value: address}}
 	opt := options.Update().SetUpsert(true)
 
 	info := struct {
-		PriceInUsd float64   `bson:"priceInUsd"`
-		UpdatedAt  time.Time `bson:"updatedAt"`
+		PriceInUsd        float64       `bson:"priceInUsd"`
+		PriceInUsdDecimal utils.Decimal `bson:"priceInUsdDecimal"`
+		UpdatedAt         time.Time     `bson:"updatedAt"`
 	}{
-		PriceInUsd: price,
-		UpdatedAt:  time.Now(),
+		PriceInUsd:        price.Float64(),
+		PriceInUsdDecimal: price,
+		UpdatedAt:         time.Now(),
 	}
 
 	update := bson.M{
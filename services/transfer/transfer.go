@@ -4,6 +4,7 @@ import (
 	"context"
 	"sfilter/config"
 	"sfilter/schema"
+	"sfilter/services/bulk"
 	"sfilter/utils"
 	"time"
 
@@ -62,3 +63,24 @@ func GetTransferEvents(findOpt *options.FindOptions, filter *primitive.M, mongod
 	err = cursor.All(ctx, &result)
 	return result, totalCount, err
 }
+
+func DeleteTransfersByBlock(blockNo uint64, mongodb *mongo.Client) error {
+	collection := mongodb.Database(config.DatabaseName).Collection(config.TransferTableName)
+
+	_, err := collection.DeleteMany(context.Background(), bson.M{"blockNo": blockNo})
+	if err != nil {
+		utils.Warnf("[ DeleteTransfersByBlock ] DeleteMany error: %v, blockNo: %v", err, blockNo)
+	}
+
+	return err
+}
+
+// 加入区块的批量写入, 按 logIndexWithTx 覆盖写, 区块重试时不会重复
+func AddTransfersToBatch(transfers []*schema.Transfer, b *bulk.Batch) {
+	for _, _transfer := range transfers {
+		_transfer.CreatedAt = time.Now()
+
+		filter := bson.M{"logIndexWithTx": _transfer.LogIndexWithTx}
+		b.Upsert(config.TransferTableName, filter, _transfer)
+	}
+}
@@ -0,0 +1,208 @@
+package wiser
+
+import (
+	"context"
+	"sfilter/config"
+	"sfilter/schema"
+	"sfilter/utils"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// 不存在时返回 nil, 表示该地址还没有统计过
+func GetWiserCursor(account string, mongodb *mongo.Client) (*schema.WiserCursor, error) {
+	collection := mongodb.Database(config.DatabaseName).Collection(config.WiserCursorTableName)
+
+	filter := bson.D{{Key: "account", Value: account}}
+
+	var result schema.WiserCursor
+	err := collection.FindOne(context.Background(), filter).Decode(&result)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// 所有统计过的地址
+func GetWiserCursorAccounts(mongodb *mongo.Client) ([]string, error) {
+	collection := mongodb.Database(config.DatabaseName).Collection(config.WiserCursorTableName)
+
+	ctx, cancel := context.WithTimeout(context.Background(), config.MONGO_FIND_TIMEOUT*time.Second*10)
+	defer cancel()
+
+	values, err := collection.Distinct(ctx, "account", bson.D{})
+	if err != nil {
+		return nil, err
+	}
+
+	var accounts []string
+	for _, value := range values {
+		if account, ok := value.(string); ok && account != "" {
+			accounts = append(accounts, account)
+		}
+	}
+
+	return accounts, nil
+}
+
+// 已统计到 blockNo 及之后的地址, 重组时需要重新统计
+func GetWiserCursorAccountsFrom(blockNo uint64, mongodb *mongo.Client) ([]string, error) {
+	collection := mongodb.Database(config.DatabaseName).Collection(config.WiserCursorTableName)
+
+	ctx, cancel := context.WithTimeout(context.Background(), config.MONGO_FIND_TIMEOUT*time.Second)
+	defer cancel()
+
+	values, err := collection.Distinct(ctx, "account", bson.M{"blockNo": bson.M{"$gte": blockNo}})
+	if err != nil {
+		return nil, err
+	}
+
+	var accounts []string
+	for _, value := range values {
+		if account, ok := value.(string); ok && account != "" {
+			accounts = append(accounts, account)
+		}
+	}
+
+	return accounts, nil
+}
+
+// 删除地址的 cursor 与所有持仓, 下次从头统计
+func ResetWiserAccount(account string, mongodb *mongo.Client) error {
+	database := mongodb.Database(config.DatabaseName)
+	filter := bson.D{{Key: "account", Value: account}}
+
+	if _, err := database.Collection(config.DealPositionTableName).DeleteMany(context.Background(), filter); err != nil {
+		utils.Errorf("[ ResetWiserAccount ] delete positions failed. account: %v, err: %v", account, err)
+		return err
+	}
+
+	if _, err := database.Collection(config.WiserCursorTableName).DeleteOne(context.Background(), filter); err != nil {
+		utils.Errorf("[ ResetWiserAccount ] delete cursor failed. account: %v, err: %v", account, err)
+		return err
+	}
+
+	return nil
+}
+
+func SaveWiserCursor(cursor *schema.WiserCursor, mongodb *mongo.Client) error {
+	collection := mongodb.Database(config.DatabaseName).Collection(config.WiserCursorTableName)
+
+	cursor.UpdatedAt = time.Now()
+
+	filter := bson.D{{Key: "account", Value: cursor.Account}}
+	update := bson.D{{Key: "$set", Value: cursor}}
+
+	_, err := collection.UpdateOne(context.Background(), filter, update, options.Update().SetUpsert(true))
+	if err != nil {
+		utils.Errorf("[ SaveWiserCursor ] failed. account: %v, err: %v", cursor.Account, err)
+	}
+
+	return err
+}
+
+// 某个地址所有的持仓
+func GetDealPositions(account string, mongodb *mongo.Client) ([]*schema.DealPosition, error) {
+	collection := mongodb.Database(config.DatabaseName).Collection(config.DealPositionTableName)
+
+	ctx, cancel := context.WithTimeout(context.Background(), config.MONGO_FIND_TIMEOUT*time.Second)
+	defer cancel()
+
+	filter := bson.D{{Key: "account", Value: account}}
+
+	cursor, err := collection.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var result []*schema.DealPosition
+	err = cursor.All(ctx, &result)
+	return result, err
+}
+
+func SaveDealPosition(pos *schema.DealPosition, mongodb *mongo.Client) error {
+	collection := mongodb.Database(config.DatabaseName).Collection(config.DealPositionTableName)
+
+	pos.UpdatedAt = time.Now()
+
+	filter := bson.D{
+		{Key: "account", Value: pos.Account},
+		{Key: "token", Value: pos.Token},
+	}
+	update := bson.D{{Key: "$set", Value: pos}}
+
+	_, err := collection.UpdateOne(context.Background(), filter, update, options.Update().SetUpsert(true))
+	if err != nil {
+		utils.Errorf("[ SaveDealPosition ] failed. account: %v, token: %v, err: %v", pos.Account, pos.Token, err)
+	}
+
+	return err
+}
+
+// 持仓清空且没有未结束的deal时删除
+func DeleteDealPosition(account, token string, mongodb *mongo.Client) error {
+	collection := mongodb.Database(config.DatabaseName).Collection(config.DealPositionTableName)
+
+	filter := bson.D{
+		{Key: "account", Value: account},
+		{Key: "token", Value: token},
+	}
+
+	_, err := collection.DeleteOne(context.Background(), filter)
+	if err != nil {
+		utils.Errorf("[ DeleteDealPosition ] failed. account: %v, token: %v, err: %v", account, token, err)
+	}
+
+	return err
+}
+
+// 不存在时返回 nil
+func GetMovedLots(txHash, token, to string, mongodb *mongo.Client) (*schema.MovedLots, error) {
+	collection := mongodb.Database(config.DatabaseName).Collection(config.MovedLotsTableName)
+
+	filter := bson.D{
+		{Key: "txHash", Value: txHash},
+		{Key: "token", Value: token},
+		{Key: "to", Value: to},
+	}
+
+	var result schema.MovedLots
+	err := collection.FindOne(context.Background(), filter).Decode(&result)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// 转出方重复统计同一笔 transfer 时结果相同, 直接覆盖
+func SaveMovedLots(moved *schema.MovedLots, mongodb *mongo.Client) error {
+	collection := mongodb.Database(config.DatabaseName).Collection(config.MovedLotsTableName)
+
+	moved.CreatedAt = time.Now()
+
+	filter := bson.D{
+		{Key: "txHash", Value: moved.TxHash},
+		{Key: "token", Value: moved.Token},
+		{Key: "to", Value: moved.To},
+	}
+	update := bson.D{{Key: "$set", Value: moved}}
+
+	_, err := collection.UpdateOne(context.Background(), filter, update, options.Update().SetUpsert(true))
+	if err != nil {
+		utils.Errorf("[ SaveMovedLots ] failed. tx: %v, token: %v, err: %v", moved.TxHash, moved.Token, err)
+	}
+
+	return err
+}
@@ -42,7 +42,8 @@ func GetBiDeals(findOpt *options.FindOptions, filter *primitive.M, mongodb *mong
 	return result, totalCount, err
 }
 
-func GetAccountAllDeals(account string, mongodb *mongo.Client) ([]schema.BiDeal, error) {
+// since 之后卖出的所有deal
+func GetAccountAllDeals(account string, since time.Time, mongodb *mongo.Client) ([]schema.BiDeal, error) {
 	collection := mongodb.Database(config.DatabaseName).Collection(config.BiDealTableName)
 
 	var result []schema.BiDeal
@@ -50,6 +51,9 @@ func GetAccountAllDeals(account string, mongodb *mongo.Client) ([]schema.BiDeal,
 	defer cancel()
 
 	filter := bson.M{"account": account}
+	filter["sellTime"] = bson.M{
+		"$gte": since,
+	}
 
 	cursor, err := collection.Find(ctx, filter)
 	if err != nil {
@@ -82,6 +86,39 @@ func SaveDeal(deal *schema.BiDeal, mongodb *mongo.Client) {
 	}
 }
 
+// 删除某个未结束deal按当前价格结算保存的旧记录
+func DeleteDeal(sellTxHashWithToken string, mongodb *mongo.Client) error {
+	collection := mongodb.Database(config.DatabaseName).Collection(config.BiDealTableName)
+
+	filter := bson.D{{Key: "sellTxHashWithToken", Value: sellTxHashWithToken}}
+	_, err := collection.DeleteOne(context.Background(), filter)
+	if err != nil {
+		utils.Errorf("[ DeleteDeal ] failed. sell_hash: %v, err: %v", sellTxHashWithToken, err)
+	}
+
+	return err
+}
+
+// 删除地址在 blockNo 及之后买入或卖出的deal
+func DeleteDealsFrom(account string, blockNo uint64, mongodb *mongo.Client) error {
+	collection := mongodb.Database(config.DatabaseName).Collection(config.BiDealTableName)
+
+	filter := bson.M{
+		"account": account,
+		"$or": bson.A{
+			bson.M{"buyBlockNo": bson.M{"$gte": blockNo}},
+			bson.M{"sellBlockNo": bson.M{"$gte": blockNo}},
+		},
+	}
+
+	_, err := collection.DeleteMany(context.Background(), filter)
+	if err != nil {
+		utils.Errorf("[ DeleteDealsFrom ] failed. account: %v, err: %v", account, err)
+	}
+
+	return err
+}
+
 // 统计前重置collection
 func ResetDealCollection(mongodb *mongo.Client) error {
 	collection := mongodb.Database(config.DatabaseName).Collection(config.BiDealTableName)
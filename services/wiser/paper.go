@@ -0,0 +1,204 @@
+package wiser
+
+import (
+	"context"
+	"sfilter/config"
+	"sfilter/schema"
+	"sfilter/utils"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func GetPaperAccount(strategy string, mongodb *mongo.Client) (*schema.PaperAccount, error) {
+	collection := mongodb.Database(config.DatabaseName).Collection(config.PaperAccountTableName)
+
+	filter := bson.D{{Key: "strategy", Value: strategy}}
+
+	var result schema.PaperAccount
+	err := collection.FindOne(context.Background(), filter).Decode(&result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func GetPaperAccounts(filter *primitive.M, mongodb *mongo.Database) ([]schema.PaperAccount, error) {
+	collection := mongodb.Collection(config.PaperAccountTableName)
+
+	ctx, cancel := context.WithTimeout(context.Background(), config.MONGO_FIND_TIMEOUT*time.Second)
+	defer cancel()
+
+	options := options.Find().SetSort(bson.D{{Key: "strategy", Value: 1}})
+	cursor, err := collection.Find(ctx, filter, options)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var result []schema.PaperAccount
+	err = cursor.All(ctx, &result)
+	return result, err
+}
+
+// 不存在时插入
+func SavePaperAccount(account *schema.PaperAccount, mongodb *mongo.Client) error {
+	collection := mongodb.Database(config.DatabaseName).Collection(config.PaperAccountTableName)
+
+	account.UpdatedAt = time.Now()
+	if account.CreatedAt.IsZero() {
+		account.CreatedAt = account.UpdatedAt
+	}
+
+	filter := bson.D{{Key: "strategy", Value: account.Strategy}}
+	update := bson.D{{Key: "$set", Value: account}}
+
+	_, err := collection.UpdateOne(context.Background(), filter, update, options.Update().SetUpsert(true))
+	if err != nil {
+		utils.Errorf("[ SavePaperAccount ] failed. strategy: %v, err: %v", account.Strategy, err)
+	}
+
+	return err
+}
+
+func GetPaperPositions(findOpt *options.FindOptions, filter *primitive.M, mongodb *mongo.Database) ([]schema.PaperPosition, int64, error) {
+	collection := mongodb.Collection(config.PaperPositionTableName)
+
+	var result []schema.PaperPosition
+	ctx, cancel := context.WithTimeout(context.Background(), config.MONGO_FIND_TIMEOUT*time.Second)
+	defer cancel()
+
+	cursor, err := collection.Find(ctx, filter, findOpt)
+	if err != nil {
+		return result, 0, err
+	}
+	defer cursor.Close(ctx)
+
+	countOpts := &options.CountOptions{
+		Limit: &config.COUNT_UPPER_SIZE,
+	}
+	countOpts.SetMaxTime(config.MONGO_FIND_TIMEOUT * time.Second)
+
+	totalCount, err := collection.CountDocuments(ctx, filter, countOpts)
+	if err != nil {
+		utils.Warnf("[ GetPaperPositions ] Count error: %v\n", err)
+		return result, 0, err
+	}
+
+	err = cursor.All(ctx, &result)
+	return result, totalCount, err
+}
+
+// 某个策略所有未平仓的持仓
+func GetOpenPaperPositions(strategy string, mongodb *mongo.Client) ([]*schema.PaperPosition, error) {
+	collection := mongodb.Database(config.DatabaseName).Collection(config.PaperPositionTableName)
+
+	filter := bson.D{
+		{Key: "strategy", Value: strategy},
+		{Key: "status", Value: schema.PAPER_POSITION_OPEN},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), config.MONGO_FIND_TIMEOUT*time.Second)
+	defer cancel()
+
+	cursor, err := collection.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var result []*schema.PaperPosition
+	err = cursor.All(ctx, &result)
+	return result, err
+}
+
+func GetOpenPaperPosition(strategy, mainToken string, mongodb *mongo.Client) (*schema.PaperPosition, error) {
+	collection := mongodb.Database(config.DatabaseName).Collection(config.PaperPositionTableName)
+
+	filter := bson.D{
+		{Key: "strategy", Value: strategy},
+		{Key: "mainToken", Value: mainToken},
+		{Key: "status", Value: schema.PAPER_POSITION_OPEN},
+	}
+
+	var result schema.PaperPosition
+	err := collection.FindOne(context.Background(), filter).Decode(&result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func SavePaperPosition(pos *schema.PaperPosition, mongodb *mongo.Client) error {
+	collection := mongodb.Database(config.DatabaseName).Collection(config.PaperPositionTableName)
+
+	pos.UpdatedAt = time.Now()
+	pos.CreatedAt = time.Now()
+	res, err := collection.InsertOne(context.Background(), pos)
+	if err != nil {
+		utils.Errorf("[ SavePaperPosition ] failed. strategy: %v, pair: %v, err: %v", pos.Strategy, pos.PairName, err)
+		return err
+	}
+
+	if id, ok := res.InsertedID.(primitive.ObjectID); ok {
+		pos.Id = id
+	}
+
+	return nil
+}
+
+func UpdatePaperPosition(pos *schema.PaperPosition, mongodb *mongo.Client) error {
+	collection := mongodb.Database(config.DatabaseName).Collection(config.PaperPositionTableName)
+
+	pos.UpdatedAt = time.Now()
+
+	filter := bson.D{{Key: "_id", Value: pos.Id}}
+	update := bson.D{{Key: "$set", Value: pos}}
+
+	_, err := collection.UpdateOne(context.Background(), filter, update)
+	if err != nil {
+		utils.Errorf("[ UpdatePaperPosition ] failed. strategy: %v, pair: %v, err: %v", pos.Strategy, pos.PairName, err)
+	}
+
+	return err
+}
+
+func GetPaperEquities(findOpt *options.FindOptions, filter *primitive.M, mongodb *mongo.Database) ([]schema.PaperEquity, error) {
+	collection := mongodb.Collection(config.PaperEquityTableName)
+
+	ctx, cancel := context.WithTimeout(context.Background(), config.MONGO_FIND_TIMEOUT*time.Second)
+	defer cancel()
+
+	cursor, err := collection.Find(ctx, filter, findOpt)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var result []schema.PaperEquity
+	err = cursor.All(ctx, &result)
+	return result, err
+}
+
+// 同一时间点重复记录时覆盖
+func SavePaperEquity(point *schema.PaperEquity, mongodb *mongo.Client) error {
+	collection := mongodb.Database(config.DatabaseName).Collection(config.PaperEquityTableName)
+
+	filter := bson.D{
+		{Key: "strategy", Value: point.Strategy},
+		{Key: "time", Value: point.Time},
+	}
+	update := bson.D{{Key: "$set", Value: point}}
+
+	_, err := collection.UpdateOne(context.Background(), filter, update, options.Update().SetUpsert(true))
+	if err != nil {
+		utils.Errorf("[ SavePaperEquity ] failed. strategy: %v, err: %v", point.Strategy, err)
+	}
+
+	return err
+}
@@ -41,10 +41,11 @@ func GetBiTrades(findOpt *options.FindOptions, filter *primitive.M, mongodb *mon
 	return result, totalCount, err
 }
 
-func GetToBeSoldBiTrade(mainToken string, mongodb *mongo.Client) (*schema.BiTrade, error) {
+func GetToBeSoldBiTrade(strategy, mainToken string, mongodb *mongo.Client) (*schema.BiTrade, error) {
 	collection := mongodb.Database(config.DatabaseName).Collection(config.BiTradeTableName)
 
 	filter := bson.D{
+		{Key: "strategy", Value: strategy},
 		{Key: "mainToken", Value: mainToken},
 		{Key: "status", Value: 0},
 	}
@@ -59,6 +60,29 @@ func GetToBeSoldBiTrade(mainToken string, mongodb *mongo.Client) (*schema.BiTrad
 	return &result, nil
 }
 
+// 某个策略所有未卖出的 trade
+func GetUnSoldBiTrades(strategy string, mongodb *mongo.Client) ([]*schema.BiTrade, error) {
+	collection := mongodb.Database(config.DatabaseName).Collection(config.BiTradeTableName)
+
+	filter := bson.D{
+		{Key: "strategy", Value: strategy},
+		{Key: "status", Value: 0},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), config.MONGO_FIND_TIMEOUT*time.Second)
+	defer cancel()
+
+	cursor, err := collection.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var result []*schema.BiTrade
+	err = cursor.All(ctx, &result)
+	return result, err
+}
+
 func SaveBiTrade(trade *schema.BiTrade, mongodb *mongo.Client) {
 	collection := mongodb.Database(config.DatabaseName).Collection(config.BiTradeTableName)
 
@@ -74,6 +98,7 @@ func UpdateBiTrade(trade *schema.BiTrade, mongodb *mongo.Client) {
 	collection := mongodb.Database(config.DatabaseName).Collection(config.BiTradeTableName)
 
 	filter := bson.D{
+		{Key: "strategy", Value: trade.Strategy},
 		{Key: "pairAddress", Value: trade.PairAddress},
 		{Key: "status", Value: 0},
 	}
@@ -3,6 +3,7 @@ package wiser
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"sfilter/config"
 	"sfilter/schema"
 	"sfilter/utils"
@@ -15,16 +16,34 @@ import (
 
 // 用mongo的aggregate命令获取
 func GetActiveAccounts(seconds int, mongodb *mongo.Client) ([]string, error) {
+	match := bson.D{
+		{Key: "swapTime", Value: bson.D{
+			{Key: "$gte", Value: time.Now().Add(-time.Duration(seconds) * time.Second)},
+		}},
+	}
+
+	return getTraders(match, mongodb)
+}
+
+// (fromBlock, toBlock] 区块内有过swap的地址
+func GetActiveAccountsInBlocks(fromBlock, toBlock uint64, mongodb *mongo.Client) ([]string, error) {
+	match := bson.D{
+		{Key: "blockNo", Value: bson.D{
+			{Key: "$gt", Value: fromBlock},
+			{Key: "$lte", Value: toBlock},
+		}},
+	}
+
+	return getTraders(match, mongodb)
+}
+
+func getTraders(match bson.D, mongodb *mongo.Client) ([]string, error) {
 	collection := mongodb.Database(config.DatabaseName).Collection(config.SwapTableName)
 
 	var accounts []string
 	pipeline := mongo.Pipeline{
 		bson.D{
-			{Key: "$match", Value: bson.D{
-				{Key: "swapTime", Value: bson.D{
-					{Key: "$gte", Value: time.Now().Add(-time.Duration(seconds) * time.Second)},
-				}},
-			}},
+			{Key: "$match", Value: match},
 		},
 		bson.D{
 			{Key: "$group", Value: bson.D{
@@ -60,20 +79,38 @@ func GetActiveAccounts(seconds int, mongodb *mongo.Client) ([]string, error) {
 	return accounts, nil
 }
 
-func GetAccountSwaps(seconds int, pageSize int64, account string, mongodb *mongo.Client) (schema.AccountTrades, error) {
+// 交易的查询范围: fromBlock > 0 时取 fromBlock 之后的区块, 否则取最近 seconds 内的; toBlock > 0 时只取到 toBlock(含)
+func tradeRangeFilter(filter bson.M, timeKey string, seconds int, fromBlock, toBlock uint64) {
+	if fromBlock == 0 {
+		filter[timeKey] = bson.M{
+			"$gte": time.Now().Add(-time.Duration(seconds) * time.Second),
+		}
+	}
+
+	blockNo := bson.M{}
+	if fromBlock > 0 {
+		blockNo["$gt"] = fromBlock
+	}
+	if toBlock > 0 {
+		blockNo["$lte"] = toBlock
+	}
+	if len(blockNo) > 0 {
+		filter["blockNo"] = blockNo
+	}
+}
+
+func GetAccountSwaps(seconds int, fromBlock, toBlock uint64, pageSize int64, account string, mongodb *mongo.Client) (schema.AccountTrades, error) {
 	collection := mongodb.Database(config.DatabaseName).Collection(config.SwapTableName)
 
 	filter := bson.M{}
-	date := time.Now().Add(-time.Duration(seconds) * time.Second)
-	filter["swapTime"] = bson.M{
-		"$gte": date,
-	}
+	tradeRangeFilter(filter, "swapTime", seconds, fromBlock, toBlock)
 	filter["trader"] = account
 
 	// 升序排列
 	options := options.Find().SetSort(bson.D{{Key: "swapTime", Value: 1}})
 
 	trades := make(schema.AccountTrades)
+	gasTxs := make(map[string]bool) // 已经计算过 gas 的交易
 
 	page := int64(1)
 	skip := (page - 1) * pageSize
@@ -96,6 +133,13 @@ func GetAccountSwaps(seconds int, pageSize int64, account string, mongodb *mongo
 			//  针对某一笔swap, 处理出对应数据
 			if swap.AmountOfMainToken > 0 {
 				att := getAttFromSwap(swap)
+
+				// 一笔交易多个swap时, gas 只算在第一个上
+				if gasTxs[swap.TxHash] {
+					att.GasInUsd = 0
+				}
+				gasTxs[swap.TxHash] = true
+
 				trades[swap.MainToken] = append(trades[swap.MainToken], att)
 			}
 		}
@@ -115,14 +159,11 @@ func GetAccountSwaps(seconds int, pageSize int64, account string, mongodb *mongo
 	return trades, nil
 }
 
-func GetAccountTransfers(seconds int, pageSize int64, account string, mongodb *mongo.Client) (schema.AccountTrades, error) {
+func GetAccountTransfers(seconds int, fromBlock, toBlock uint64, pageSize int64, account string, mongodb *mongo.Client) (schema.AccountTrades, error) {
 	collection := mongodb.Database(config.DatabaseName).Collection(config.TransferTableName)
 
 	filter := bson.M{}
-	date := time.Now().Add(-time.Duration(seconds) * time.Second)
-	filter["timestamp"] = bson.M{
-		"$gte": date,
-	}
+	tradeRangeFilter(filter, "timestamp", seconds, fromBlock, toBlock)
 	filter["$or"] = []bson.M{
 		{"from": account},
 		{"to": account},
@@ -190,11 +231,26 @@ func getAttFromSwap(swap schema.Swap) schema.AccountTokenTrade {
 		Amount:     swap.AmountOfMainToken,
 		USDValue:   swap.VolumeInUsd,
 		PriceInUSD: swap.PriceInUsd,
+
+		GasInUsd: getGasInUsd(swap.GasInEth, swap.CurrentEthPrice),
 	}
 
 	return att
 }
 
+// gasInEth 为 wei 数量的字符串
+func getGasInUsd(gasInEth string, ethPrice float64) float64 {
+	gas, ok := new(big.Float).SetString(gasInEth)
+	if !ok {
+		return 0
+	}
+
+	gas = gas.Quo(gas, new(big.Float).SetInt(config.BaseFactor1e18))
+	gasF, _ := gas.Float64()
+
+	return gasF * ethPrice
+}
+
 func PrintWiser(wiser *schema.Wiser) {
 	utils.Infof("**** PrintWiser **** Address: %v", wiser.Address)
 
@@ -229,8 +285,10 @@ func getAttFromTransfer(transfer schema.Transfer, account string) schema.Account
 	// 方向, 如果to为account, 则为 receive, 否则反之
 	if transfer.To == account {
 		att.Direction = schema.DIRECTION_BUY_OR_ADD
+		att.Counterparty = transfer.From
 	} else {
 		att.Direction = schema.DIRECTION_SELL_OR_DECREASE
+		att.Counterparty = transfer.To
 	}
 
 	if att.Amount > 0 {
@@ -35,6 +35,19 @@ func SaveWiser(wiser *schema.Wiser, mongodb *mongo.Client) {
 	}
 }
 
+// 本epoch不再符合条件的地址, 删除之前保存的记录
+func DeleteWiser(addressWithEpoch string, mongodb *mongo.Client) error {
+	collection := mongodb.Database(config.DatabaseName).Collection(config.WiserTableName)
+
+	filter := bson.D{{Key: "addressWithEpoch", Value: addressWithEpoch}}
+	_, err := collection.DeleteOne(context.Background(), filter)
+	if err != nil {
+		utils.Errorf("[ DeleteWiser ] failed. addressWithEpoch: %v, err: %v", addressWithEpoch, err)
+	}
+
+	return err
+}
+
 func ResetWiserEpochData(mongodb *mongo.Client, epoch string) error {
 	collection := mongodb.Database(config.DatabaseName).Collection(config.WiserTableName)
 	deleteFilter := bson.D{{Key: "epoch", Value: epoch}}
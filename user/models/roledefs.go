@@ -44,6 +44,18 @@ const (
 	ROLE_ROOT_TRACK_SWAP_COUNT = 1000 * 1000 * 10
 )
 
+// 允许同时建立的 stream 连接数
+const (
+	ROLE_BASIC_STREAM_COUNT    = 1
+	ROLE_PREMIUM_STREAM_COUNT  = 2
+	ROLE_ELITE_STREAM_COUNT    = 5
+	ROLE_INVESTOR_STREAM_COUNT = 5
+	ROLE_PARTNER_STREAM_COUNT  = 20
+	// ...
+
+	ROLE_ROOT_STREAM_COUNT = 100
+)
+
 func IsValidRole(role int) bool {
 	if role == USER_ROLE_BASIC ||
 		role == USER_ROLE_LEVEL_PREMIUM ||
@@ -98,3 +110,24 @@ func GetRoleTrackSwapCount(roleStr string) int64 {
 
 	return ROLE_BASIC_TRACK_SWAP_COUNT
 }
+
+func GetRoleStreamCount(roleStr string) int {
+	role, err := strconv.Atoi(roleStr)
+	if err != nil {
+		return ROLE_BASIC_STREAM_COUNT
+	}
+
+	if role == USER_ROLE_LEVEL_PREMIUM {
+		return ROLE_PREMIUM_STREAM_COUNT
+	} else if role == USER_ROLE_LEVEL_ELITE {
+		return ROLE_ELITE_STREAM_COUNT
+	} else if role == USER_ROLE_LEVEL_INVESTOR {
+		return ROLE_INVESTOR_STREAM_COUNT
+	} else if role == USER_ROLE_LEVEL_PARTNER {
+		return ROLE_PARTNER_STREAM_COUNT
+	} else if role == USER_ROLE_LEVEL_ROOT {
+		return ROLE_ROOT_STREAM_COUNT
+	}
+
+	return ROLE_BASIC_STREAM_COUNT
+}
@@ -117,6 +117,7 @@ func AuthAPIKeyMiddleware() gin.HandlerFunc {
 
 		// 将用户信息存储在请求上下文中
 		c.Set("user", user.Username)
+		c.Set("role", fmt.Sprintf("%d", user.Role))
 
 		// 如果 API 密钥有效，允许请求继续处理
 		c.Next()
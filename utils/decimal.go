@@ -0,0 +1,251 @@
+package utils
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+)
+
+// 精确的十进制数, 以字符串形式保存, 避免 float64 丢失精度
+// 存入 mongo 时为 Decimal128(最多34位有效数字), json 中为字符串
+// 空字符串视为0
+type Decimal string
+
+const DecimalSignificantDigits = 34
+
+// token 数量(原始整数)转换为实际数量, 即 amount / 10^decimals
+func TokenAmountToDecimal(amount *big.Int, decimals uint8) Decimal {
+	if amount == nil {
+		return "0"
+	}
+
+	return NewDecimalFromRat(new(big.Rat).SetFrac(amount, pow10(decimals)))
+}
+
+// 以 quote 计价的 main 价格, 即 (amountQuote/10^decimalQuote) / (amountMain/10^decimalMain)
+func TokenPriceToDecimal(amountQuote *big.Int, decimalQuote uint8, amountMain *big.Int, decimalMain uint8) Decimal {
+	if amountQuote == nil || amountMain == nil || amountMain.Sign() == 0 {
+		return "0"
+	}
+
+	quote := new(big.Rat).SetFrac(amountQuote, pow10(decimalQuote))
+	main := new(big.Rat).SetFrac(amountMain, pow10(decimalMain))
+
+	return NewDecimalFromRat(quote.Quo(quote, main))
+}
+
+// uniswap v3 的 sqrtPriceX96 转换为 token0 以 token1 计价的价格, 即 (sqrtPriceX96/2^96)^2 * 10^decimal0 / 10^decimal1
+// inverse 为 true 时返回 token1 以 token0 计价的价格
+func SqrtPriceX96ToDecimal(sqrtPriceX96 *big.Int, decimal0, decimal1 uint8, inverse bool) Decimal {
+	if sqrtPriceX96 == nil || sqrtPriceX96.Sign() == 0 {
+		return "0"
+	}
+
+	num := new(big.Int).Mul(sqrtPriceX96, sqrtPriceX96)
+	num.Mul(num, pow10(decimal0))
+
+	denom := new(big.Int).Lsh(big.NewInt(1), 192)
+	denom.Mul(denom, pow10(decimal1))
+
+	price := new(big.Rat).SetFrac(num, denom)
+	if inverse {
+		price.Inv(price)
+	}
+
+	return NewDecimalFromRat(price)
+}
+
+// float 只用于本身就不精确的数据, 如 eth 的 usd 价格
+// 取最短的十进制表示, 避免出现 1834.119999... 的情况
+func NewDecimalFromFloat(f float64) Decimal {
+	r, ok := new(big.Rat).SetString(strconv.FormatFloat(f, 'g', -1, 64))
+	if !ok {
+		return "0"
+	}
+
+	return NewDecimalFromRat(r)
+}
+
+// 四舍五入到 DecimalSignificantDigits 位有效数字
+func NewDecimalFromRat(r *big.Rat) Decimal {
+	if r == nil || r.Sign() == 0 {
+		return "0"
+	}
+
+	significand, exp := roundRat(r)
+	return Decimal(formatDecimal(significand, exp))
+}
+
+func (d Decimal) Rat() *big.Rat {
+	r, ok := new(big.Rat).SetString(string(d))
+	if !ok {
+		return new(big.Rat)
+	}
+
+	return r
+}
+
+func (d Decimal) Float64() float64 {
+	f, _ := d.Rat().Float64()
+	return f
+}
+
+func (d Decimal) IsZero() bool {
+	return d.Rat().Sign() == 0
+}
+
+func (d Decimal) Cmp(o Decimal) int {
+	return d.Rat().Cmp(o.Rat())
+}
+
+func (d Decimal) Add(o Decimal) Decimal {
+	return NewDecimalFromRat(new(big.Rat).Add(d.Rat(), o.Rat()))
+}
+
+func (d Decimal) Mul(o Decimal) Decimal {
+	return NewDecimalFromRat(new(big.Rat).Mul(d.Rat(), o.Rat()))
+}
+
+func (d Decimal) String() string {
+	if d == "" {
+		return "0"
+	}
+
+	return string(d)
+}
+
+func (d Decimal) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	r := d.Rat()
+	if r.Sign() == 0 {
+		return bson.TypeDecimal128, bsoncore.AppendDecimal128(nil, primitive.NewDecimal128(0x3040000000000000, 0)), nil
+	}
+
+	significand, exp := roundRat(r)
+	dec, ok := primitive.ParseDecimal128FromBigInt(significand, exp)
+	if !ok {
+		return bson.TypeDecimal128, nil, fmt.Errorf("decimal out of range: %v", d)
+	}
+
+	return bson.TypeDecimal128, bsoncore.AppendDecimal128(nil, dec), nil
+}
+
+// 兼容老数据: 也可以从 double、string、int 中读取
+func (d *Decimal) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	raw := bson.RawValue{Type: t, Value: data}
+
+	switch t {
+	case bson.TypeDecimal128:
+		significand, exp, err := raw.Decimal128().BigInt()
+		if err != nil {
+			return err
+		}
+		*d = Decimal(formatDecimal(significand, exp))
+	case bson.TypeDouble:
+		*d = NewDecimalFromFloat(raw.Double())
+	case bson.TypeString:
+		*d = Decimal(raw.StringValue())
+	case bson.TypeInt32:
+		*d = Decimal(strconv.FormatInt(int64(raw.Int32()), 10))
+	case bson.TypeInt64:
+		*d = Decimal(strconv.FormatInt(raw.Int64(), 10))
+	case bson.TypeNull, bson.TypeUndefined:
+		*d = ""
+	default:
+		return fmt.Errorf("cannot decode %v into Decimal", t)
+	}
+
+	return nil
+}
+
+func pow10(n uint8) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// 返回 significand * 10^exp, significand 不超过 DecimalSignificantDigits 位
+func roundRat(r *big.Rat) (*big.Int, int) {
+	num := new(big.Int).Abs(r.Num())
+	denom := r.Denom()
+
+	// 估算数量级, 使整数部分恰好为 DecimalSignificantDigits 位
+	exp := len(num.String()) - len(denom.String()) - DecimalSignificantDigits
+
+	scaled := scaleRat(num, denom, exp)
+	if len(scaled.String()) > DecimalSignificantDigits {
+		exp++
+		scaled = scaleRat(num, denom, exp)
+	} else if len(scaled.String()) < DecimalSignificantDigits {
+		exp--
+		scaled = scaleRat(num, denom, exp)
+	}
+
+	// 去掉末尾的0
+	ten := big.NewInt(10)
+	mod := new(big.Int)
+	for scaled.Sign() != 0 {
+		q, m := new(big.Int).QuoRem(scaled, ten, mod)
+		if m.Sign() != 0 {
+			break
+		}
+		scaled = q
+		exp++
+	}
+
+	if r.Sign() < 0 {
+		scaled.Neg(scaled)
+	}
+
+	return scaled, exp
+}
+
+// 四舍五入计算 num / denom / 10^exp
+func scaleRat(num, denom *big.Int, exp int) *big.Int {
+	n := new(big.Int).Set(num)
+	dn := new(big.Int).Set(denom)
+
+	if exp > 0 {
+		dn.Mul(dn, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exp)), nil))
+	} else if exp < 0 {
+		n.Mul(n, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(-exp)), nil))
+	}
+
+	q, m := new(big.Int).QuoRem(n, dn, new(big.Int))
+	if m.Mul(m, big.NewInt(2)).Cmp(dn) >= 0 {
+		q.Add(q, big.NewInt(1))
+	}
+
+	return q
+}
+
+// 格式化为不带指数的十进制字符串
+func formatDecimal(significand *big.Int, exp int) string {
+	neg := significand.Sign() < 0
+	digits := new(big.Int).Abs(significand).String()
+
+	var s string
+	switch {
+	case digits == "0":
+		return "0"
+	case exp >= 0:
+		s = digits + strings.Repeat("0", exp)
+	case -exp >= len(digits):
+		s = "0." + strings.Repeat("0", -exp-len(digits)) + digits
+	default:
+		s = digits[:len(digits)+exp] + "." + digits[len(digits)+exp:]
+	}
+
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+
+	if neg {
+		s = "-" + s
+	}
+
+	return s
+}
@@ -0,0 +1,216 @@
+package utils
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func bigInt(s string) *big.Int {
+	n, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		panic("bad int: " + s)
+	}
+	return n
+}
+
+func TestTokenAmountToDecimal(t *testing.T) {
+	cases := []struct {
+		amount   *big.Int
+		decimals uint8
+		want     Decimal
+	}{
+		{nil, 18, "0"},
+		{big.NewInt(0), 18, "0"},
+		{bigInt("1500000000000000000"), 18, "1.5"},
+		{bigInt("1"), 18, "0.000000000000000001"},
+		{bigInt("123456789"), 6, "123.456789"},
+		{bigInt("-2500000"), 6, "-2.5"},
+		{bigInt("1000000"), 0, "1000000"},
+		// 超过34位有效数字时四舍五入
+		{bigInt("123456789012345678901234567890123456789"), 18, "123456789012345678901.2345678901235"},
+	}
+
+	for _, c := range cases {
+		if got := TokenAmountToDecimal(c.amount, c.decimals); got != c.want {
+			t.Errorf("TokenAmountToDecimal(%v, %v) = %v, want %v", c.amount, c.decimals, got, c.want)
+		}
+	}
+}
+
+func TestTokenPriceToDecimal(t *testing.T) {
+	cases := []struct {
+		amountQuote  *big.Int
+		decimalQuote uint8
+		amountMain   *big.Int
+		decimalMain  uint8
+		want         Decimal
+	}{
+		// 3000 usdc 买 1 eth
+		{bigInt("3000000000"), 6, bigInt("1000000000000000000"), 18, "3000"},
+		// 1 eth 买 3 个
+		{bigInt("1000000000000000000"), 18, bigInt("3000000000000000000"), 18, "0.3333333333333333333333333333333333"},
+		{bigInt("1"), 18, big.NewInt(0), 18, "0"},
+		{nil, 18, big.NewInt(1), 18, "0"},
+	}
+
+	for _, c := range cases {
+		got := TokenPriceToDecimal(c.amountQuote, c.decimalQuote, c.amountMain, c.decimalMain)
+		if got != c.want {
+			t.Errorf("TokenPriceToDecimal(%v, %v, %v, %v) = %v, want %v", c.amountQuote, c.decimalQuote, c.amountMain, c.decimalMain, got, c.want)
+		}
+	}
+}
+
+func TestSqrtPriceX96ToDecimal(t *testing.T) {
+	q96 := new(big.Int).Lsh(big.NewInt(1), 96)
+
+	cases := []struct {
+		sqrtPriceX96 *big.Int
+		decimal0     uint8
+		decimal1     uint8
+		inverse      bool
+		want         Decimal
+	}{
+		{q96, 18, 18, false, "1"},
+		{new(big.Int).Mul(q96, big.NewInt(2)), 18, 18, false, "4"},
+		{new(big.Int).Mul(q96, big.NewInt(2)), 18, 18, true, "0.25"},
+		// token0 为 6 位小数, token1 为 18 位小数时按 decimals 调整
+		{q96, 6, 18, false, "0.000000000001"},
+		{nil, 18, 18, false, "0"},
+		{big.NewInt(0), 18, 18, false, "0"},
+	}
+
+	for _, c := range cases {
+		got := SqrtPriceX96ToDecimal(c.sqrtPriceX96, c.decimal0, c.decimal1, c.inverse)
+		if got != c.want {
+			t.Errorf("SqrtPriceX96ToDecimal(%v, %v, %v, %v) = %v, want %v", c.sqrtPriceX96, c.decimal0, c.decimal1, c.inverse, got, c.want)
+		}
+	}
+}
+
+func TestNewDecimalFromFloat(t *testing.T) {
+	cases := []struct {
+		f    float64
+		want Decimal
+	}{
+		{0, "0"},
+		{1834.12, "1834.12"}, // 取最短表示, 不会出现 1834.119999...
+		{0.1, "0.1"},
+		{-2.5, "-2.5"},
+		{1e-20, "0.00000000000000000001"},
+		{1e21, "1000000000000000000000"},
+	}
+
+	for _, c := range cases {
+		if got := NewDecimalFromFloat(c.f); got != c.want {
+			t.Errorf("NewDecimalFromFloat(%v) = %v, want %v", c.f, got, c.want)
+		}
+	}
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	cases := []struct {
+		a, b     Decimal
+		sum, mul Decimal
+		cmp      int
+	}{
+		{"0.1", "0.2", "0.3", "0.02", -1},
+		{"", "1.5", "1.5", "0", -1}, // 空字符串视为0
+		{"-3", "3", "0", "-9", -1},
+		{"1000000000000000000", "0.000000000000000001", "1000000000000000000", "1", 1}, // 和超过34位有效数字
+		{"2.50", "2.5", "5", "6.25", 0},
+	}
+
+	for _, c := range cases {
+		if got := c.a.Add(c.b); got != c.sum {
+			t.Errorf("%q + %q = %v, want %v", c.a, c.b, got, c.sum)
+		}
+		if got := c.a.Mul(c.b); got != c.mul {
+			t.Errorf("%q * %q = %v, want %v", c.a, c.b, got, c.mul)
+		}
+		if got := c.a.Cmp(c.b); got != c.cmp {
+			t.Errorf("%q cmp %q = %v, want %v", c.a, c.b, got, c.cmp)
+		}
+	}
+
+	if !Decimal("").IsZero() || !Decimal("0.000").IsZero() || Decimal("0.001").IsZero() {
+		t.Errorf("IsZero is wrong")
+	}
+	if Decimal("").String() != "0" {
+		t.Errorf("empty decimal should be 0")
+	}
+	if Decimal("abc").Float64() != 0 {
+		t.Errorf("invalid decimal should be 0")
+	}
+}
+
+func TestDecimalBSON(t *testing.T) {
+	type doc struct {
+		Value Decimal `bson:"value"`
+	}
+
+	cases := []Decimal{
+		"0",
+		"1.5",
+		"-0.000000000000000001",
+		"12345678901234567890.12345678901234", // 34位有效数字
+		"1000000000000000000000000",
+	}
+
+	for _, d := range cases {
+		data, err := bson.Marshal(doc{Value: d})
+		if err != nil {
+			t.Fatalf("marshal %v error: %v", d, err)
+		}
+
+		if got := bson.Raw(data).Lookup("value").Type; got != bson.TypeDecimal128 {
+			t.Fatalf("marshal %v as %v, want decimal128", d, got)
+		}
+
+		var result doc
+		if err := bson.Unmarshal(data, &result); err != nil {
+			t.Fatalf("unmarshal %v error: %v", d, err)
+		}
+		if result.Value != d {
+			t.Errorf("bson round trip %v = %v", d, result.Value)
+		}
+	}
+
+	// 兼容老数据中的 double、string、int
+	old := []struct {
+		value interface{}
+		want  Decimal
+	}{
+		{1834.12, "1834.12"},
+		{"0.5", "0.5"},
+		{int32(7), "7"},
+		{int64(-8), "-8"},
+		{nil, ""},
+	}
+
+	for _, c := range old {
+		data, err := bson.Marshal(bson.M{"value": c.value})
+		if err != nil {
+			t.Fatalf("marshal %v error: %v", c.value, err)
+		}
+
+		var result doc
+		if err := bson.Unmarshal(data, &result); err != nil {
+			t.Fatalf("unmarshal %v error: %v", c.value, err)
+		}
+		if result.Value != c.want {
+			t.Errorf("unmarshal %v = %q, want %q", c.value, result.Value, c.want)
+		}
+	}
+
+	// 超出34位有效数字的在写入时四舍五入
+	data, _ := bson.Marshal(doc{Value: Decimal("1." + strings.Repeat("1", 40))})
+	var result doc
+	bson.Unmarshal(data, &result)
+	if want := Decimal("1." + strings.Repeat("1", 33)); result.Value != want {
+		t.Errorf("rounded = %v, want %v", result.Value, want)
+	}
+}